@@ -346,6 +346,8 @@ def _normalizeObjectScalePolicies(json_obj: dict) -> dict:
     4. Rename IamService_GetPolicyVersionResponse.GetPolicyVersionResult.[PolicyVersioin -> PolicyVersion]
     5. Use IamPolicyVersion as the common schema for IamService_ListPolicyVersionsResponse.ListPolicyVersionsResult.PolicyVersions
     6. Rename IamService_ListPolicyVersionsResponse.ListPolicyVersionsResult.[PolicyVersions -> Versions]
+    7. IamService_ListEntitiesForPolicyResponse.ListEntitiesForPolicyResult lists names only in the metadata,
+    while the array returns PolicyGroups, PolicyUsers and PolicyRoles with their names and IDs, and IsTruncated
     """
     common_policy_type = json_obj['components']['schemas']['IamService_GetPolicyResponse']\
         ['properties']['GetPolicyResult']\
@@ -411,7 +413,59 @@ def _normalizeObjectScalePolicies(json_obj: dict) -> dict:
         ['properties']['Versions'] = json_obj['components']['schemas']['IamService_ListPolicyVersionsResponse']\
         ['properties']['ListPolicyVersionsResult']\
         ['properties'].pop('PolicyVersions')
-        
+
+    # add the entities a policy is attached to
+    for entity, description in [('Group', 'group'), ('User', 'user'), ('Role', 'role')]:
+        json_obj['components']['schemas']['IamPolicy' + entity] = {
+            "type": "object",
+            "properties": {
+                entity + "Name": {
+                    "type": "string",
+                    "description": "The name of the " + description + "."
+                },
+                entity + "Id": {
+                    "type": "string",
+                    "description": "The stable and unique string identifying the " + description + "."
+                }
+            }
+        }
+    json_obj['components']['schemas']['IamService_ListEntitiesForPolicyResponse']\
+        ['properties']['ListEntitiesForPolicyResult'] = {
+        "type": "object",
+        "properties": {
+            "PolicyGroups": {
+                "type": "array",
+                "items": {
+                    "$ref": "#/components/schemas/IamPolicyGroup"
+                },
+                "description": "The groups that the policy is attached to."
+            },
+            "PolicyUsers": {
+                "type": "array",
+                "items": {
+                    "$ref": "#/components/schemas/IamPolicyUser"
+                },
+                "description": "The users that the policy is attached to."
+            },
+            "PolicyRoles": {
+                "type": "array",
+                "items": {
+                    "$ref": "#/components/schemas/IamPolicyRole"
+                },
+                "description": "The roles that the policy is attached to."
+            },
+            "IsTruncated": {
+                "type": "boolean",
+                "description": "A flag that indicates whether there are more items to return."
+            },
+            "Marker": {
+                "type": "string",
+                "description": "When IsTruncated is true, this element is present and contains the value to use for the Marker parameter in a subsequent pagination request."
+            }
+        },
+        "description": "Get ListEntitiesForPolicyResult"
+    }
+
     return json_obj
 
 def _normalizeObjectScaleIamTags(json_obj: dict) -> dict:
@@ -670,6 +724,312 @@ def _normalizeObjectScaleServiceProvider(json_obj: dict) -> dict:
     return json_obj
 
 
+def _normalizeObjectScaleBucketNotifications(json_obj: dict) -> dict:
+    """
+    BucketService_getBucketNotificationConfigResponse and BucketService_putBucketNotificationConfigRequest
+    describe TopicConfiguration as a string, while it is a list of topic configurations.
+    Both should be normalized to a list of BucketNotificationTopicConfiguration.
+    """
+    schemas = json_obj['components']['schemas']
+    schemas['BucketNotificationFilterRule'] = {
+        "type": "object",
+        "properties": {
+            "Name": {
+                "type": "string",
+                "description": "Part of the object key matched by the rule, prefix or suffix."
+            },
+            "Value": {
+                "type": "string",
+                "description": "Value matched against the object key."
+            }
+        }
+    }
+    schemas['BucketNotificationFilter'] = {
+        "type": "object",
+        "properties": {
+            "S3Key": {
+                "type": "object",
+                "properties": {
+                    "FilterRule": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/components/schemas/BucketNotificationFilterRule"
+                        }
+                    }
+                }
+            }
+        }
+    }
+    schemas['BucketNotificationTopicConfiguration'] = {
+        "type": "object",
+        "properties": {
+            "Id": {
+                "type": "string",
+                "description": "Identifier of the configuration."
+            },
+            "Topic": {
+                "type": "string",
+                "description": "ARN of the target receiving the notifications."
+            },
+            "Event": {
+                "type": "array",
+                "items": {
+                    "type": "string"
+                },
+                "description": "Events triggering a notification."
+            },
+            "Filter": {
+                "$ref": "#/components/schemas/BucketNotificationFilter"
+            }
+        }
+    }
+    for name in ['BucketService_getBucketNotificationConfigResponse', 'BucketService_putBucketNotificationConfigRequest']:
+        if name in schemas:
+            schemas[name]['properties']['TopicConfiguration'] = {
+                "type": "array",
+                "items": {
+                    "$ref": "#/components/schemas/BucketNotificationTopicConfiguration"
+                }
+            }
+    return json_obj
+
+
+def _normalizeObjectScaleObjectWebhookTargets(json_obj: dict) -> dict:
+    """
+    Normalize /rest/v1/object-webhook-targets endpoints:
+    - Define a common ObjectWebhookTarget model, based on the create request, used as create request body
+    - Define typed response schemas for Create/Get/Update and List
+    """
+    schemas = json_obj["components"]["schemas"]
+    create_request = "WebhookConfigurationService_createWebhookConfigurationRequest"
+    if create_request not in schemas:
+        return json_obj
+
+    schemas["ObjectWebhookTarget"] = schemas.pop(create_request)
+    json_obj["paths"]["/rest/v1/object-webhook-targets"]["post"]["requestBody"]["content"]["application/json"]["schema"] = {
+        "$ref": "#/components/schemas/ObjectWebhookTarget"
+    }
+    schemas["WebhookConfigurationService_getObjectWebhookTargetsResponse"] = {
+        "type": "object",
+        "properties": {
+            "object_webhook_targets": {
+                "type": "array",
+                "items": {"$ref": "#/components/schemas/ObjectWebhookTarget"},
+            },
+            "next_resume_token": {
+                "type": "string",
+                "description": "Reference to the last webhook target returned, to pass as resume-token to fetch the next ones.",
+            },
+        },
+    }
+
+    responses = [
+        ("/rest/v1/object-webhook-targets", "post", "ObjectWebhookTarget"),
+        ("/rest/v1/object-webhook-targets", "get", "WebhookConfigurationService_getObjectWebhookTargetsResponse"),
+        ("/rest/v1/object-webhook-targets/{id}", "get", "ObjectWebhookTarget"),
+        ("/rest/v1/object-webhook-targets/{id}", "patch", "ObjectWebhookTarget"),
+    ]
+    for path, method, schema in responses:
+        if method in json_obj["paths"].get(path, {}):
+            json_obj["paths"][path][method]["responses"]["200"]["content"]["application/json"]["schema"] = {
+                "$ref": "#/components/schemas/" + schema
+            }
+    return json_obj
+
+
+def _normalizeObjectScaleIamPolicySimulation(json_obj: dict) -> dict:
+    """
+    Normalize the IAM policy simulation and context keys APIs.
+    1. The list query parameters are sent as indexed members, like ActionNames.member.1;
+       they are renamed to <name>.member.N and marked with 'x-indexed-list'.
+    2. ContextEntries is a list of IamContextEntry, marked with 'x-indexed-context-entries'.
+    3. ResourceArns is missing from the metadata of the simulation APIs, while the array accepts it.
+    4. The responses get typed schemas. The result of SimulateCustomPolicy is named SimulateCustomerPolicyResult by the array.
+    """
+    schemas = json_obj['components']['schemas']
+    schemas['IamContextEntry'] = {
+        "type": "object",
+        "properties": {
+            "ContextKeyName": {
+                "type": "string",
+                "description": "The full name of a condition context key, including the service prefix."
+            },
+            "ContextKeyType": {
+                "type": "string",
+                "description": "The data type of the value of the context key."
+            },
+            "ContextKeyValues": {
+                "type": "array",
+                "items": {
+                    "type": "string"
+                },
+                "description": "The values of the context key."
+            }
+        }
+    }
+    schemas['IamStatement'] = {
+        "type": "object",
+        "properties": {
+            "SourcePolicyId": {
+                "type": "string",
+                "description": "The identifier of the policy that contains the statement."
+            },
+            "SourcePolicyType": {
+                "type": "string",
+                "description": "The type of the policy that contains the statement."
+            }
+        }
+    }
+    matched_statements = {
+        "type": "array",
+        "items": {
+            "$ref": "#/components/schemas/IamStatement"
+        },
+        "description": "The statements which determined the result of the simulation."
+    }
+    missing_context_values = {
+        "type": "array",
+        "items": {
+            "type": "string"
+        },
+        "description": "The context keys which are referenced by the policies but were not provided."
+    }
+    schemas['IamResourceSpecificResult'] = {
+        "type": "object",
+        "properties": {
+            "EvalResourceName": {
+                "type": "string",
+                "description": "The resource that was simulated."
+            },
+            "EvalResourceDecision": {
+                "type": "string",
+                "description": "The result of the simulation of the action on the resource: allowed, explicitDeny or implicitDeny."
+            },
+            "MatchedStatements": matched_statements,
+            "MissingContextValues": missing_context_values
+        }
+    }
+    schemas['IamEvaluationResult'] = {
+        "type": "object",
+        "properties": {
+            "EvalActionName": {
+                "type": "string",
+                "description": "The name of the API operation that was simulated."
+            },
+            "EvalResourceName": {
+                "type": "string",
+                "description": "The resource that was simulated."
+            },
+            "EvalDecision": {
+                "type": "string",
+                "description": "The result of the simulation: allowed, explicitDeny or implicitDeny."
+            },
+            "MatchedStatements": matched_statements,
+            "MissingContextValues": missing_context_values,
+            "ResourceSpecificResults": {
+                "type": "array",
+                "items": {
+                    "$ref": "#/components/schemas/IamResourceSpecificResult"
+                },
+                "description": "The results of the simulation for each resource, when several resources were simulated."
+            }
+        }
+    }
+    schemas['IamSimulatePolicyResult'] = {
+        "type": "object",
+        "properties": {
+            "EvaluationResults": {
+                "type": "array",
+                "items": {
+                    "$ref": "#/components/schemas/IamEvaluationResult"
+                },
+                "description": "The results of the simulation."
+            },
+            "IsTruncated": {
+                "type": "boolean",
+                "description": "A flag that indicates whether there are more items to return."
+            },
+            "Marker": {
+                "type": "string",
+                "description": "When IsTruncated is true, this element is present and contains the value to use for the Marker  parameter in a subsequent pagination request."
+            }
+        }
+    }
+    schemas['IamContextKeysResult'] = {
+        "type": "object",
+        "properties": {
+            "ContextKeyNames": {
+                "type": "array",
+                "items": {
+                    "type": "string"
+                },
+                "description": "The context keys referenced by the policies."
+            }
+        }
+    }
+
+    responses = [
+        ('SimulatePrincipalPolicy', 'SimulatePrincipalPolicyResult', 'IamSimulatePolicyResult'),
+        ('SimulateCustomPolicy', 'SimulateCustomerPolicyResult', 'IamSimulatePolicyResult'),
+        ('GetContextKeysForPrincipalPolicy', 'GetContextKeysForPrincipalPolicyResult', 'IamContextKeysResult'),
+        ('GetContextKeysForCustomPolicy', 'GetContextKeysForCustomPolicyResult', 'IamContextKeysResult'),
+    ]
+    for action, result, schema in responses:
+        path = json_obj['paths'].get('/iam?Action=' + action)
+        if path is None:
+            continue
+        params = path['post']['parameters']
+        for param in params:
+            if param['name'] in ['ActionNames', 'PolicyInputList', 'PermissionsBoundaryPolicyInputList']:
+                param['x-indexed-list'] = param['name']
+                param['name'] = param['name'] + '.member.N'
+                param['schema'] = {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            elif param['name'] == 'ContextEntries':
+                param['x-indexed-context-entries'] = 'true'
+                param['name'] = 'ContextEntries.member.N'
+                param['schema'] = {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/components/schemas/IamContextEntry"
+                    }
+                }
+        if action.startswith('Simulate'):
+            params.insert(2, {
+                "name": "ResourceArns.member.N",
+                "in": "query",
+                "required": False,
+                "schema": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": "A list of ARNs of the resources to include in the simulation. Defaults to all resources.",
+                "x-indexed-list": "ResourceArns"
+            })
+        response = 'IamService_' + action + 'Response'
+        schemas[response] = {
+            "type": "object",
+            "properties": {
+                "ResponseMetadata": {
+                    "$ref": "#/components/schemas/IamResponseMetadata"
+                },
+                result: {
+                    "$ref": "#/components/schemas/" + schema
+                }
+            }
+        }
+        path['post']['responses']['200']['content']['application/json']['schema'] = {
+            "$ref": "#/components/schemas/" + response
+        }
+    return json_obj
+
+
 def NormalizeObjectScaleModels(json_obj: dict) -> dict:
     """
     Normalize ObjectScale specific models.
@@ -686,4 +1046,7 @@ def NormalizeObjectScaleModels(json_obj: dict) -> dict:
     ret = _normalizeObjectScaleReplicationGroups(ret)
     ret = _normalizeObjectScaleIamSamlProviderResponses(ret)
     ret = _normalizeObjectScaleServiceProvider(ret)
+    ret = _normalizeObjectScaleBucketNotifications(ret)
+    ret = _normalizeObjectScaleObjectWebhookTargets(ret)
+    ret = _normalizeObjectScaleIamPolicySimulation(ret)
     return ret
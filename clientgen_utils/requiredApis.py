@@ -110,6 +110,10 @@ RequiredAPIs = [
     "/object/bucket/{bucketName}/set-local-object-metadata-reads",
     "/object/bucket/{bucketName}/versioning",
     "/object/bucket/{bucketName}/notification",
+    "/object/bucket/{bucketName}/copypolicy",
+    "/object/bucket/copypolicy",
+    "/rest/v1/object-webhook-targets",
+    "/rest/v1/object-webhook-targets/{id}",
     
     # Access Key API endpoints
     "/iam?Action=CreateAccessKey",
@@ -135,6 +139,13 @@ RequiredAPIs = [
     "/iam?Action=CreatePolicyVersion",
     "/iam?Action=DeletePolicyVersion",
     "/iam?Action=SetDefaultPolicyVersion",
+    "/iam?Action=ListEntitiesForPolicy",
+
+    # Policy simulation API endpoints
+    "/iam?Action=SimulatePrincipalPolicy",
+    "/iam?Action=SimulateCustomPolicy",
+    "/iam?Action=GetContextKeysForPrincipalPolicy",
+    "/iam?Action=GetContextKeysForCustomPolicy",
 
     # Service Provider API endpoints
     "/ecs-service-provider",
@@ -152,6 +163,7 @@ RequiredAPIs = [
     "/object/vdcs/vdcid/{vdcId}",
     "/object/vdcs/vdc/local",
     "/object/vdcs/vdc/list",
+    "/object/vdcs/vdc/{vdcId}/deactivate",
 
     # storage pools
     "/vdc/data-services/varrays/{id}",
@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Example: Get all the copy policies of the namespace of the current user
+data "objectscale_bucket_copy_policy" "all" {
+}
+
+# Example: Get all the copy policies of a namespace
+data "objectscale_bucket_copy_policy" "ns1" {
+  namespace = "ns1"
+}
+
+# Example: Get the copy policy of a bucket
+data "objectscale_bucket_copy_policy" "bucket1" {
+  namespace = "ns1"
+  bucket    = "bucket1"
+}
+
+output "bucket1_copy_target" {
+  value = data.objectscale_bucket_copy_policy.bucket1.copy_policies[0].target_bucket
+}
@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Render a policy document from statement blocks, instead of hand-writing its JSON.
+data "objectscale_iam_policy_document" "read_only" {
+  statement {
+    sid       = "ListBucket"
+    actions   = ["s3:ListBucket"]
+    resources = ["arn:aws:s3:::bucket1"]
+  }
+
+  statement {
+    sid       = "ReadObjects"
+    actions   = ["s3:GetObject", "s3:GetObjectVersion"]
+    resources = ["arn:aws:s3:::bucket1/*"]
+
+    condition {
+      test     = "IpAddress"
+      variable = "aws:SourceIp"
+      values   = ["10.0.0.0/8"]
+    }
+  }
+}
+
+resource "objectscale_iam_policy" "read_only" {
+  name            = "bucket1-read-only"
+  namespace       = "ns1"
+  policy_document = data.objectscale_iam_policy_document.read_only.json
+}
+
+# Trust policy of a role, allowing an IAM user to assume it.
+data "objectscale_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["urn:ecs:iam::ns1:user/sample_user_1"]
+    }
+  }
+}
+
+resource "objectscale_iam_role" "example" {
+  name                        = "example-role"
+  namespace                   = "ns1"
+  assume_role_policy_document = data.objectscale_iam_policy_document.assume_role.json
+}
+
+# Merge the statements of other policy documents. The override documents replace the statements with the same Sid.
+data "objectscale_iam_policy_document" "read_write" {
+  source_policy_documents = [data.objectscale_iam_policy_document.read_only.json]
+
+  override_policy_documents = [jsonencode({
+    Statement = [{
+      Sid      = "ReadObjects"
+      Effect   = "Allow"
+      Action   = ["s3:GetObject", "s3:PutObject"]
+      Resource = "arn:aws:s3:::bucket1/*"
+    }]
+  })]
+
+  statement {
+    sid       = "DenyDelete"
+    effect    = "Deny"
+    actions   = ["s3:DeleteObject"]
+    resources = ["arn:aws:s3:::bucket1/*"]
+  }
+}
+
+output "objectscale_iam_policy_document_json" {
+  value = data.objectscale_iam_policy_document.read_write.json
+}
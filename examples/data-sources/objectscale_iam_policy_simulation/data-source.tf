@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Simulate the policies of a role, including its attached managed policies and its permissions boundary.
+data "objectscale_iam_policy_simulation" "reader" {
+  namespace         = "ns1"
+  policy_source_arn = "urn:ecs:iam::ns1:role/bucket1-reader"
+  action_names      = ["s3:GetObject", "s3:ListBucket"]
+  resource_arns     = ["arn:aws:s3:::bucket1/*"]
+
+  # Values of the condition keys used by the policies.
+  context {
+    key    = "aws:SourceIp"
+    type   = "ip"
+    values = ["10.0.0.1"]
+  }
+}
+
+# Fail the plan when the role cannot read the bucket.
+check "reader_can_read_bucket1" {
+  assert {
+    condition     = data.objectscale_iam_policy_simulation.reader.all_allowed
+    error_message = "The role bucket1-reader cannot read bucket1."
+  }
+}
+
+# Simulate a policy document before creating the policy.
+data "objectscale_iam_policy_document" "read_only" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::bucket1/*"]
+  }
+}
+
+data "objectscale_iam_policy_simulation" "read_only" {
+  namespace        = "ns1"
+  policy_documents = [data.objectscale_iam_policy_document.read_only.json]
+  action_names     = ["s3:GetObject", "s3:DeleteObject"]
+  resource_arns    = ["arn:aws:s3:::bucket1/key"]
+}
+
+resource "objectscale_iam_policy" "read_only" {
+  name            = "bucket1-read-only"
+  namespace       = "ns1"
+  policy_document = data.objectscale_iam_policy_document.read_only.json
+
+  lifecycle {
+    precondition {
+      condition     = !data.objectscale_iam_policy_simulation.read_only.results[1].allowed
+      error_message = "The read-only policy must not allow deleting objects."
+    }
+  }
+}
+
+output "objectscale_iam_policy_simulation_results" {
+  value = data.objectscale_iam_policy_simulation.reader.results
+}
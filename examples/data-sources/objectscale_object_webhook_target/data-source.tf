@@ -0,0 +1,29 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Example: Get all the webhook targets of the namespace of the current user
+data "objectscale_object_webhook_target" "all" {
+}
+
+# Example: Get the webhook targets whose name starts with a prefix
+data "objectscale_object_webhook_target" "image" {
+  name_prefix = "image-"
+}
+
+output "image_webhook_targets" {
+  value = data.objectscale_object_webhook_target.image.webhook_targets
+}
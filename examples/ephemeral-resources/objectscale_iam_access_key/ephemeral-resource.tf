@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Open and Close
+# Each plan and apply creates a new access key for the IAM user set in `username`, which is deleted at the end of the run.
+# The key is never stored in the state or plan file, and can only be referenced from ephemeral contexts,
+# e.g. provider configurations and write-only arguments.
+
+ephemeral "objectscale_iam_access_key" "ci" {
+  username  = "sample_user_1"
+  namespace = "ns1"
+}
+
+# Configure an S3 client provider with the short-lived access key.
+provider "aws" {
+  access_key                  = ephemeral.objectscale_iam_access_key.ci.id
+  secret_key                  = ephemeral.objectscale_iam_access_key.ci.secret_access_key
+  region                      = "us-east-1"
+  skip_credentials_validation = true
+  skip_requesting_account_id  = true
+  s3_use_path_style           = true
+
+  endpoints {
+    s3 = "https://objectscale.example.com:9021"
+  }
+}
@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Open and Close
+# Each plan and apply creates a new secret key for the object user set in `username`.
+# The key is never stored in the state or plan file, and can only be referenced from ephemeral contexts,
+# e.g. provider configurations and write-only arguments.
+
+# The key is deleted at the end of the run by default.
+ephemeral "objectscale_object_user_secret_key" "run" {
+  username  = "sample_user_2"
+  namespace = "ns1"
+}
+
+# Keep the key to write it to a vault. Mind that a kept key is created on every plan and apply,
+# and that an object user has at most two secret keys: older keys must be deleted, or given an expiry
+# with `expiry_in_mins`, before the next run.
+ephemeral "objectscale_object_user_secret_key" "app" {
+  username        = "sample_user_2"
+  namespace       = "ns1"
+  expiry_in_mins  = "60"
+  revoke_on_close = false
+}
+
+resource "vault_kv_secret_v2" "app" {
+  mount = "secret"
+  name  = "objectscale/sample_user_2"
+  data_json_wo = jsonencode({
+    access_key = "sample_user_2"
+    secret_key = ephemeral.objectscale_object_user_secret_key.app.secret_key
+  })
+  # increment the version to write the key of the run to the vault
+  data_json_wo_version = 1
+}
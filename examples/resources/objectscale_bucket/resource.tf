@@ -40,6 +40,15 @@ resource "objectscale_bucket" "example_bucket" {
   # Optional: Enable filesystem interface
   # filesystem_enabled = true
 
+  # Optional: Delete all the objects of the bucket when it is destroyed.
+  # When false (default), destroying a bucket that is not empty fails.
+  # force_destroy = true
+
+  # Optional: Timeouts of the operations. Emptying a large bucket on destroy can take longer than the default of 1h.
+  # timeouts {
+  #   delete = "2h"
+  # }
+
   # Optional: Key-value tags for bucket
   tag = [
     {
@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Example 1: Copy the objects of a bucket to another bucket of the same ObjectScale
+resource "objectscale_bucket_copy_policy" "local" {
+  bucket         = "bucket1"
+  namespace      = "ns1"
+  target_bucket  = "bucket1-copy"
+  target_account = "ns1"
+  policy_type    = "copy_only"
+}
+
+# Example 2: Copy the objects tagged for backup to a bucket of an external S3 endpoint, one day after their last write
+# bucket, namespace, external_endpoint, target_bucket, target_account, target_region, target_role and policy_type cannot be updated.
+variable "target_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "objectscale_bucket_copy_policy" "external" {
+  bucket                = "bucket2"
+  namespace             = "ns1"
+  external_endpoint     = "http://10.10.10.10:9020/"
+  target_bucket         = "bucket2-backup"
+  target_access_key     = "backup_user"
+  target_secret_key     = var.target_secret_key
+  days_after_last_write = 1
+  tag_filter            = "backup"
+  detailed_log_enabled  = true
+  detailed_log_bucket   = "copy-logs"
+  detailed_log_prefix   = "bucket2/"
+}
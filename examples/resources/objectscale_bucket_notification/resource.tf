@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import
+# Running `terraform apply` will replace the S3 event notification configuration of the bucket
+# Running `terraform destroy` will clear the S3 event notification configuration of the bucket
+
+# Send a notification to a webhook target when a JPEG image is uploaded to the bucket,
+# and to another target when any object is deleted.
+# bucket and namespace cannot be updated.
+resource "objectscale_bucket_notification" "example" {
+  bucket    = "bucket1"
+  namespace = "ns1"
+
+  topic_configurations = [
+    {
+      id            = "image-uploads"
+      topic_arn     = "arn:aws:sns:::image-processor"
+      events        = ["s3:ObjectCreated:*"]
+      filter_prefix = "images/"
+      filter_suffix = ".jpg"
+    },
+    {
+      topic_arn = "arn:aws:sns:::audit"
+      events    = ["s3:ObjectRemoved:*"]
+    },
+  ]
+}
@@ -18,6 +18,11 @@ resource "objectscale_iam_policy" "testacc_policy" {
   name        = "testacc_policy"
   namespace   = "ns1"
   description = "An example policy"
+
+  # Optional: detach the policy from all its users, groups and roles, and delete its non-default versions, on destroy.
+  # When false (default), destroying a policy which is still attached fails.
+  # force_detach_on_destroy = true
+
   policy_document = jsonencode({
 
     "Version" : "2012-10-17",
@@ -61,6 +61,10 @@ resource "objectscale_namespace" "all" {
     block_size        = 124
   }
   root_user_password = "password1"
+
+  # Optional: delete the buckets (with all their objects), object users and IAM entities of the namespace on destroy.
+  # When false (default), destroying a namespace which still contains any of them fails.
+  # force_destroy = true
 }
 
 # After the execution of above resource block, namespace would have been created on the ObjectScale array. For more information, Please check the terraform state file. 
@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import
+# Running `terraform apply` will create or update the webhook target on ObjectScale
+# Running `terraform destroy` will delete the webhook target from ObjectScale
+
+# Create a webhook target receiving the S3 event notifications of the buckets.
+# name cannot be updated.
+resource "objectscale_object_webhook_target" "example" {
+  name                = "image-processor"
+  url                 = "https://webhook.example.com:8443/events"
+  auth_token          = var.webhook_token
+  ca_certificate      = file("webhook-ca.pem")
+  comment             = "Processes the uploaded images"
+  pre_backoff_retries = 3
+  backoff_retries     = 5
+  enabled             = true
+}
+
+# Use the webhook target in the notification configuration of a bucket.
+resource "objectscale_bucket_notification" "example" {
+  bucket    = "bucket1"
+  namespace = "ns1"
+
+  topic_configurations = [
+    {
+      topic_arn = objectscale_object_webhook_target.example.id
+      events    = ["s3:ObjectCreated:*"]
+    },
+  ]
+}
+
+variable "webhook_token" {
+  type      = string
+  sensitive = true
+}
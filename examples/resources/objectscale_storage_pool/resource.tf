@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Example 1: Storage Pool with the default erasure coding scheme
+resource "objectscale_storage_pool" "default" {
+  name        = "sp1"
+  description = "Storage pool created by Terraform"
+}
+
+# Example 2: Cold storage Storage Pool with custom alert thresholds
+# is_cold_storage_enabled, number_of_data_blocks, number_of_code_blocks, label and drive_technology cannot be updated after creation.
+resource "objectscale_storage_pool" "cold" {
+  name                    = "sp_cold"
+  description             = "Cold storage pool"
+  is_cold_storage_enabled = true
+  warning_alert_at        = 70
+  error_alert_at          = 80
+  critical_alert_at       = 90
+}
+
+# Example 3: Storage Pool used in a Replication Group
+data "objectscale_vdc" "local" {
+  local = true
+}
+
+resource "objectscale_replication_group" "rg" {
+  name = "rg_sp1"
+  zone_mappings = [
+    {
+      vdc          = data.objectscale_vdc.local.vdcs[0].id
+      storage_pool = objectscale_storage_pool.default.id
+    }
+  ]
+}
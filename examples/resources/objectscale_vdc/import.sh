@@ -0,0 +1,25 @@
+# Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+
+#     http://mozilla.org/MPL/2.0/
+
+
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+
+# The command is
+# terraform import objectscale_vdc.<resource_name> <ID or name of the VDC>
+# Examples:
+terraform import objectscale_vdc.vdc2 "vdc2"
+terraform import objectscale_vdc.vdc2 "urn:storageos:VirtualDataCenterData:00000000-0000-0000-0000-000000000000"
+
+# secret_key can be empty after import, so the first plan after the import may show an update of secret_key.
+# after running this command, populate the other parameters in the config file to start managing this resource.
+# Note: running "terraform show" after importing shows the current config/state of the resource. You can copy/paste that config to make it easier to manage the resource.
@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# The secret key of a VDC is the one returned by /object/vdcs/vdc/local/secretkey on that VDC.
+# name cannot be updated after creation.
+
+# Example 1: Register the local VDC, with the secret key of the local VDC
+variable "local_vdc_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "objectscale_vdc" "local" {
+  name                 = "vdc1"
+  inter_vdc_end_points = "10.0.0.1,10.0.0.2,10.0.0.3"
+  secret_key           = var.local_vdc_secret_key
+}
+
+# Example 2: Federate a remote VDC, with the secret key of the remote VDC
+variable "remote_vdc_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "objectscale_vdc" "remote" {
+  name                     = "vdc2"
+  inter_vdc_end_points     = "10.0.1.1,10.0.1.2,10.0.1.3"
+  inter_vdc_cmd_end_points = "10.0.1.1,10.0.1.2,10.0.1.3"
+  management_end_points    = "10.0.1.1,10.0.1.2,10.0.1.3"
+  secret_key               = var.remote_vdc_secret_key
+}
+
+# Example 3: Federated VDC used in a Replication Group
+data "objectscale_storage_pool" "remote" {
+  name   = "sp2"
+  vdc_id = objectscale_vdc.remote.id
+}
+
+resource "objectscale_replication_group" "rg" {
+  name = "rg_multi_site"
+  zone_mappings = [
+    {
+      vdc          = objectscale_vdc.remote.id
+      storage_pool = data.objectscale_storage_pool.remote.storage_pools[0].id
+    }
+  ]
+}
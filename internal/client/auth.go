@@ -0,0 +1,146 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const authTokenHeader = "X-SDS-AUTH-TOKEN"
+
+// authModeKey is the context key to change how the auth token is handled for a request.
+type authModeKey struct{}
+
+const (
+	// authModeNoReauth sends the auth token, but does not log in again on 401. Used for logout.
+	authModeNoReauth = iota + 1
+	// authModeNoToken sends no auth token at all. Used for login.
+	authModeNoToken
+)
+
+// withoutReauth returns a context whose requests do not log in again on 401.
+func withoutReauth(ctx context.Context) context.Context {
+	return context.WithValue(ctx, authModeKey{}, authModeNoReauth)
+}
+
+// withoutToken returns a context whose requests are sent without the auth token.
+func withoutToken(ctx context.Context) context.Context {
+	return context.WithValue(ctx, authModeKey{}, authModeNoToken)
+}
+
+// authTransport is a http.RoundTripper which sets the current auth token on every request.
+// When the array answers with 401, the token is considered expired: the transport logs in again
+// and retries the request once with the new token.
+type authTransport struct {
+	base  http.RoundTripper
+	login func(ctx context.Context) (string, error)
+
+	mu    sync.Mutex
+	token string
+}
+
+// getToken returns the current auth token.
+func (t *authTransport) getToken() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.token
+}
+
+// setToken sets the current auth token.
+func (t *authTransport) setToken(token string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.token = token
+}
+
+// refreshToken logs in again, unless another request already replaced the stale token.
+func (t *authTransport) refreshToken(ctx context.Context, stale string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token != stale {
+		return t.token, nil
+	}
+	tflog.Info(ctx, "auth token has expired, logging in again")
+	token, err := t.login(ctx)
+	if err != nil {
+		return "", err
+	}
+	t.token = token
+	return token, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Context().Value(authModeKey{}) {
+	case authModeNoToken:
+		out := req.Clone(req.Context())
+		out.Header.Del(authTokenHeader)
+		return t.base.RoundTrip(out)
+	case authModeNoReauth:
+		return t.base.RoundTrip(withToken(req, t.getToken()))
+	}
+
+	token := t.getToken()
+	resp, err := t.base.RoundTrip(withToken(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// the request body must be rewound to be sent again
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+
+	// buffer the 401 response and close it: the limiter holds the request slot until its body is closed,
+	// and the login needs a slot of its own
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	newToken, err := t.refreshToken(req.Context(), token)
+	if err != nil {
+		tflog.Error(req.Context(), "re-login after 401 failed: "+err.Error())
+		return resp, nil
+	}
+
+	retry := withToken(req, newToken)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+	return t.base.RoundTrip(retry)
+}
+
+// withToken returns a copy of the request with the auth token header set.
+func withToken(req *http.Request, token string) *http.Request {
+	if token == "" {
+		return req
+	}
+	out := req.Clone(req.Context())
+	out.Header.Set(authTokenHeader, token)
+	return out
+}
@@ -2,14 +2,13 @@ package client
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/cookiejar"
 	"strings"
+	"sync"
 	"terraform-provider-objectscale/internal/clientgen"
 	"time"
 
@@ -19,23 +18,123 @@ import (
 // Client type is to hold objectscale client.
 type Client struct {
 	GenClient *clientgen.APIClient
+
+	auth *authTransport
+	// limiter shared by all the requests to the array, including the keystore ones
+	limiter *limiter
+	// token passed in the provider configuration, owned by the caller and never logged out
+	preIssuedToken string
+	// whether username/password are configured to log in
+	hasCredentials bool
+}
+
+// Config holds the settings to connect to the array.
+type Config struct {
+	Endpoint string
+	Username string
+	Password string
+	// AuthToken is a pre-issued X-SDS-AUTH-TOKEN. When set, the client does not log in.
+	AuthToken string
+	Insecure  bool
+	// CACertificate is a PEM encoded CA bundle, or the path of a file containing it, trusted in addition to the system pool.
+	CACertificate string
+	// ClientCertificate and ClientKey are the PEM encoded client certificate and key for mutual TLS, or the paths of files containing them.
+	ClientCertificate string
+	ClientKey         string
+	// Timeout of the API requests in seconds, 0 means no timeout.
+	Timeout int64
+	// MaxRetries is the maximum number of retries of a request failing with a transient error, 0 disables the retries.
+	MaxRetries int
+	// RetryBackoff is the delay before the first retry, doubled after each retry.
+	RetryBackoff time.Duration
+	// MaxConcurrentRequests is the maximum number of requests in flight to the array, 0 means unlimited.
+	MaxConcurrentRequests int
+	// RequestsPerSecond is the maximum number of requests sent to the array per second, 0 means unlimited.
+	RequestsPerSecond int
+}
+
+// sessions holds the clients logged in by this process, so that they can be logged out on shutdown.
+var sessions struct {
+	sync.Mutex
+	clients []*Client
 }
 
 // NewClient returns the objectscale client.
 func NewClient(endpoint string, username string, password string, insecure bool, timeout int64) (*Client, error) {
-	genClient, err := newClientGen(context.Background(), endpoint, username, password, insecure, timeout)
+	return NewClientFromConfig(Config{
+		Endpoint: endpoint,
+		Username: username,
+		Password: password,
+		Insecure: insecure,
+		Timeout:  timeout,
+	})
+}
+
+// NewClientFromConfig returns the objectscale client for the given settings.
+func NewClientFromConfig(config Config) (*Client, error) {
+	client, err := newClient(context.Background(), config)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create client: %w", err)
 	}
 
-	var client = Client{
-		GenClient: genClient,
+	sessions.Lock()
+	sessions.clients = append(sessions.clients, client)
+	sessions.Unlock()
+	return client, nil
+}
+
+// Logout ends the login session of the client.
+func (c *Client) Logout(ctx context.Context) error {
+	token := ""
+	if c.auth != nil {
+		token = c.auth.getToken()
+	}
+	if token == "" || token == c.preIssuedToken {
+		return nil
+	}
+	_, _, err := c.GenClient.AuthenticationApi.AuthenticationResourceLogout(withoutReauth(ctx)).Execute()
+	if err != nil {
+		return fmt.Errorf("error during logout: %w", err)
+	}
+	c.auth.setToken("")
+	return nil
+}
+
+// LogoutAll ends the login sessions of all the clients created by this process.
+// It is called when the provider process stops, so that sessions do not count against the token limit of the user.
+func LogoutAll(ctx context.Context) {
+	sessions.Lock()
+	clients := sessions.clients
+	sessions.clients = nil
+	sessions.Unlock()
+
+	for _, c := range clients {
+		if err := c.Logout(ctx); err != nil {
+			tflog.Warn(ctx, err.Error())
+		}
+	}
+}
+
+// login gets a new auth token using the basic auth credentials.
+func (c *Client) login(ctx context.Context) (string, error) {
+	if !c.hasCredentials {
+		return "", errors.New("the auth token is no longer valid and no username/password is configured to log in again")
+	}
+	_, resp, err := c.GenClient.AuthenticationApi.AuthenticationResourceGetLoginToken(withoutToken(ctx)).Execute()
+	if err != nil {
+		return "", fmt.Errorf("error during login: %w", err)
+	}
+
+	// get the X-SDS-AUTH-TOKEN header from the response
+	token := resp.Header.Get(authTokenHeader)
+	if len(token) == 0 {
+		return "", errors.New("no token returned during login")
 	}
-	return &client, nil
+	return token, nil
 }
 
-// newClientGen returns the generated objectscale client.
-func newClientGen(ctx context.Context, endpoint string, username string, password string, insecure bool, timeout int64) (*clientgen.APIClient, error) {
+// newClient returns the objectscale client, logged in to the array.
+func newClient(ctx context.Context, config Config) (*Client, error) {
 
 	// Setup a User-Agent for your API client (replace the provider name for yours):
 	userAgent := "terraform-objectscale-provider/1.0.0"
@@ -44,43 +143,39 @@ func newClientGen(ctx context.Context, endpoint string, username string, passwor
 		tflog.Error(ctx, "Got error while creating cookie jar")
 	}
 
-	httpclient := &http.Client{
-		Timeout: (time.Duration(timeout) * time.Second),
-		Jar:     jar,
-	}
-	if insecure {
-		/* #nosec */
-		httpclient.Transport = &http.Transport{
-			TLSClientConfig: &tls.Config{
-				MinVersion:         tls.VersionTLS12,
-				InsecureSkipVerify: true,
-			},
-		}
-	} else {
-		// Loading system certs by default if insecure is set to false
-		pool, err := x509.SystemCertPool()
-		if err != nil {
-			errSysCerts := errors.New("unable to initialize cert pool from system")
-			return nil, errSysCerts
-		}
-		httpclient.Transport = &http.Transport{
-			TLSClientConfig: &tls.Config{
-				MinVersion:         tls.VersionTLS12,
-				RootCAs:            pool,
-				InsecureSkipVerify: false,
+	tlsConfig, err := newTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	transport := &http.Transport{
+		TLSClientConfig: tlsConfig,
+	}
+
+	// each attempt of a request, including the retries and re-logins, goes through the limiter and is logged
+	limiter := newLimiter(config.MaxConcurrentRequests, config.RequestsPerSecond)
+	auth := &authTransport{
+		base: &retryTransport{
+			base: &limitTransport{
+				base:    &logTransport{base: transport},
+				limiter: limiter,
 			},
-		}
+			maxRetries: config.MaxRetries,
+			backoff:    config.RetryBackoff,
+		},
+	}
+	httpclient := &http.Client{
+		Timeout:   (time.Duration(config.Timeout) * time.Second),
+		Jar:       jar,
+		Transport: auth,
 	}
 
-	url, _ := strings.CutSuffix(endpoint, "/")
-	basicAuthString := basicAuth(username, password)
+	url, _ := strings.CutSuffix(config.Endpoint, "/")
 
 	cfg := &clientgen.Configuration{
 		HTTPClient: httpclient,
 		// Host:          url,
 		DefaultHeader: make(map[string]string),
 		UserAgent:     userAgent,
-		Debug:         true,
 		Servers: clientgen.ServerConfigurations{
 			{
 				URL:         url,
@@ -89,26 +184,33 @@ func newClientGen(ctx context.Context, endpoint string, username string, passwor
 		},
 		OperationServers: map[string]clientgen.ServerConfigurations{},
 	}
-	cfg.AddDefaultHeader("Authorization", "Basic "+basicAuthString)
-
-	apiClient := clientgen.NewAPIClient(cfg)
+	if config.Username != "" {
+		cfg.AddDefaultHeader("Authorization", "Basic "+basicAuth(config.Username, config.Password))
+	}
 
-	_, resp, err := apiClient.AuthenticationApi.AuthenticationResourceGetLoginToken(ctx).Execute()
-	if err != nil {
-		return nil, fmt.Errorf("error during login: %w", err)
+	client := &Client{
+		GenClient:      clientgen.NewAPIClient(cfg),
+		auth:           auth,
+		limiter:        limiter,
+		preIssuedToken: config.AuthToken,
+		hasCredentials: config.Username != "" && config.Password != "",
 	}
+	// the transport logs in again through the same client when the token expires
+	auth.login = client.login
 
-	// get the X-SDS-AUTH-TOKEN header from the response
-	token := resp.Header.Get("X-SDS-AUTH-TOKEN")
-	if len(token) != 0 {
-		cfg.AddDefaultHeader("X-SDS-AUTH-TOKEN", token)
-		apiClient = clientgen.NewAPIClient(cfg)
-	} else {
-		return nil, errors.New("no token returned during login")
+	// a pre-issued token skips the login entirely
+	if config.AuthToken != "" {
+		auth.setToken(config.AuthToken)
+		return client, nil
 	}
 
-	return apiClient, nil
+	token, err := client.login(ctx)
+	if err != nil {
+		return nil, err
+	}
+	auth.setToken(token)
 
+	return client, nil
 }
 
 // Generate the base 64 Authorization string from username / password.
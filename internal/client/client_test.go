@@ -0,0 +1,343 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"terraform-provider-objectscale/internal/clientgen"
+	"testing"
+	"time"
+)
+
+// fakeArray is a minimal stand-in for the ObjectScale management API, issuing one token per login.
+type fakeArray struct {
+	mu        sync.Mutex
+	logins    int
+	logouts   []string
+	valid     map[string]bool
+	bodies    []string
+	failLogin bool
+}
+
+func newFakeArray(t *testing.T) (*fakeArray, *httptest.Server) {
+	fa := &fakeArray{valid: map[string]bool{}}
+	server := httptest.NewServer(http.HandlerFunc(fa.handle))
+	t.Cleanup(server.Close)
+	return fa, server
+}
+
+func (fa *fakeArray) handle(w http.ResponseWriter, r *http.Request) {
+	fa.mu.Lock()
+	defer fa.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+
+	switch r.URL.Path {
+	case "/login":
+		if fa.failLogin || r.Header.Get("Authorization") == "" || r.Header.Get(authTokenHeader) != "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"code": 401}`))
+			return
+		}
+		fa.logins++
+		token := fmt.Sprintf("token-%d", fa.logins)
+		fa.valid[token] = true
+		w.Header().Set(authTokenHeader, token)
+		_, _ = w.Write([]byte(`{"user": "root"}`))
+		return
+	}
+
+	token := r.Header.Get(authTokenHeader)
+	if !fa.valid[token] {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"code": 401}`))
+		return
+	}
+
+	switch r.URL.Path {
+	case "/logout":
+		fa.logouts = append(fa.logouts, token)
+		delete(fa.valid, token)
+		_, _ = w.Write([]byte(`{}`))
+	default:
+		body, _ := io.ReadAll(r.Body)
+		fa.bodies = append(fa.bodies, string(body))
+		_, _ = w.Write([]byte(`{}`))
+	}
+}
+
+// expire invalidates all the issued tokens, as the array does when a session times out.
+func (fa *fakeArray) expire() {
+	fa.mu.Lock()
+	defer fa.mu.Unlock()
+	fa.valid = map[string]bool{}
+}
+
+func TestNewClientLogin(t *testing.T) {
+	fa, server := newFakeArray(t)
+
+	c, err := NewClient(server.URL, "root", "password", true, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fa.logins != 1 {
+		t.Errorf("expected 1 login, got %d", fa.logins)
+	}
+	if c.auth.getToken() != "token-1" {
+		t.Errorf("expected token-1, got %s", c.auth.getToken())
+	}
+
+	_, _, err = c.GenClient.ZoneInfoApi.ZoneInfoServiceListAllVdc(context.Background()).Execute()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestNewClientLoginError(t *testing.T) {
+	fa, server := newFakeArray(t)
+	fa.failLogin = true
+
+	_, err := NewClient(server.URL, "root", "password", true, 10)
+	if err == nil || !strings.Contains(err.Error(), "error during login") {
+		t.Errorf("expected login error, got %v", err)
+	}
+}
+
+func TestReloginOnUnauthorized(t *testing.T) {
+	fa, server := newFakeArray(t)
+
+	c, err := NewClient(server.URL, "root", "password", true, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fa.expire()
+	name := "vdc1"
+	_, _, err = c.GenClient.ZoneInfoApi.ZoneInfoServiceInsertVdcInfo(context.Background(), name).
+		ZoneInfoServiceInsertVdcInfoRequest(clientgen.ZoneInfoServiceInsertVdcInfoRequest{VdcName: &name}).
+		Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fa.logins != 2 {
+		t.Errorf("expected 2 logins, got %d", fa.logins)
+	}
+	if c.auth.getToken() != "token-2" {
+		t.Errorf("expected token-2, got %s", c.auth.getToken())
+	}
+	// the request body is sent again on retry
+	if len(fa.bodies) != 1 || !strings.Contains(fa.bodies[0], `"vdcName":"vdc1"`) {
+		t.Errorf("unexpected request bodies %v", fa.bodies)
+	}
+}
+
+func TestReloginConcurrent(t *testing.T) {
+	fa, server := newFakeArray(t)
+
+	c, err := NewClient(server.URL, "root", "password", true, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fa.expire()
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := c.GenClient.ZoneInfoApi.ZoneInfoServiceListAllVdc(context.Background()).Execute(); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// all the requests failing with the same stale token share a single re-login
+	if fa.logins != 2 {
+		t.Errorf("expected 2 logins, got %d", fa.logins)
+	}
+}
+
+func TestReloginConcurrencyLimit(t *testing.T) {
+	fa, server := newFakeArray(t)
+
+	c, err := NewClientFromConfig(Config{Endpoint: server.URL, Username: "root", Password: "password", Insecure: true, MaxConcurrentRequests: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the 401 response must free its slot for the re-login, even with a single slot
+	fa.expire()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, _, err := c.GenClient.ZoneInfoApi.ZoneInfoServiceListAllVdc(ctx).Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fa.logins != 2 {
+		t.Errorf("expected 2 logins, got %d", fa.logins)
+	}
+	if len(c.limiter.sem) != 0 {
+		t.Errorf("expected all the slots to be released, got %d in use", len(c.limiter.sem))
+	}
+}
+
+func TestReloginFailure(t *testing.T) {
+	fa, server := newFakeArray(t)
+
+	c, err := NewClient(server.URL, "root", "password", true, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fa.expire()
+	fa.failLogin = true
+	_, resp, err := c.GenClient.ZoneInfoApi.ZoneInfoServiceListAllVdc(context.Background()).Execute()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected original 401 response, got %v", resp)
+	}
+}
+
+func TestLogout(t *testing.T) {
+	fa, server := newFakeArray(t)
+
+	c, err := NewClient(server.URL, "root", "password", true, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := c.Logout(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fa.logouts) != 1 || fa.logouts[0] != "token-1" {
+		t.Errorf("unexpected logouts %v", fa.logouts)
+	}
+
+	// logging out twice is a no-op
+	if err := c.Logout(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fa.logouts) != 1 {
+		t.Errorf("unexpected logouts %v", fa.logouts)
+	}
+}
+
+func TestLogoutExpired(t *testing.T) {
+	fa, server := newFakeArray(t)
+
+	c, err := NewClient(server.URL, "root", "password", true, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// an expired session is not logged in again just to be logged out
+	fa.expire()
+	if err := c.Logout(context.Background()); err == nil {
+		t.Error("expected error")
+	}
+	if fa.logins != 1 {
+		t.Errorf("expected 1 login, got %d", fa.logins)
+	}
+}
+
+func TestLogoutAll(t *testing.T) {
+	fa, server := newFakeArray(t)
+	LogoutAll(context.Background())
+
+	for i := 0; i < 2; i++ {
+		if _, err := NewClient(server.URL, "root", "password", true, 10); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	LogoutAll(context.Background())
+	if len(fa.logouts) != 2 {
+		t.Errorf("expected 2 logouts, got %v", fa.logouts)
+	}
+
+	// sessions are forgotten once logged out
+	LogoutAll(context.Background())
+	if len(fa.logouts) != 2 {
+		t.Errorf("expected 2 logouts, got %v", fa.logouts)
+	}
+}
+
+func TestNewClientAuthToken(t *testing.T) {
+	fa, server := newFakeArray(t)
+	fa.valid["pre-issued"] = true
+
+	c, err := NewClientFromConfig(Config{Endpoint: server.URL, AuthToken: "pre-issued"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fa.logins != 0 {
+		t.Errorf("expected no login, got %d", fa.logins)
+	}
+	if _, _, err := c.GenClient.ZoneInfoApi.ZoneInfoServiceListAllVdc(context.Background()).Execute(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// the pre-issued token belongs to the caller
+	if err := c.Logout(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fa.logouts) != 0 {
+		t.Errorf("expected no logout, got %v", fa.logouts)
+	}
+
+	// without credentials an expired token cannot be renewed
+	fa.expire()
+	if _, _, err := c.GenClient.ZoneInfoApi.ZoneInfoServiceListAllVdc(context.Background()).Execute(); err == nil {
+		t.Error("expected error")
+	}
+	if fa.logins != 0 {
+		t.Errorf("expected no login, got %d", fa.logins)
+	}
+}
+
+func TestNewClientAuthTokenRelogin(t *testing.T) {
+	fa, server := newFakeArray(t)
+	fa.valid["pre-issued"] = true
+
+	c, err := NewClientFromConfig(Config{Endpoint: server.URL, AuthToken: "pre-issued", Username: "root", Password: "password"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// with credentials an expired token is renewed, and the new token is logged out
+	fa.expire()
+	if _, _, err := c.GenClient.ZoneInfoApi.ZoneInfoServiceListAllVdc(context.Background()).Execute(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if fa.logins != 1 {
+		t.Errorf("expected 1 login, got %d", fa.logins)
+	}
+	if err := c.Logout(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fa.logouts) != 1 || fa.logouts[0] != "token-1" {
+		t.Errorf("unexpected logouts %v", fa.logouts)
+	}
+}
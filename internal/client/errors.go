@@ -0,0 +1,295 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"terraform-provider-objectscale/internal/clientgen"
+)
+
+// ErrorKind classifies the errors returned by the ObjectScale API, so that they can be handled uniformly.
+type ErrorKind int
+
+const (
+	// ErrorUnknown is an error which could not be classified.
+	ErrorUnknown ErrorKind = iota
+	// ErrorNotFound is returned when the object does not exist.
+	ErrorNotFound
+	// ErrorConflict is returned when the object already exists, is still in use, or a limit is reached.
+	ErrorConflict
+	// ErrorThrottled is returned when the array is busy or throttles the requests.
+	ErrorThrottled
+	// ErrorAuth is returned when the credentials are invalid or lack the permissions for the operation.
+	ErrorAuth
+	// ErrorValidation is returned when the request is invalid.
+	ErrorValidation
+	// ErrorServer is returned when the array failed to process a valid request.
+	ErrorServer
+)
+
+// String returns the name of the kind.
+func (k ErrorKind) String() string {
+	switch k {
+	case ErrorNotFound:
+		return "not found"
+	case ErrorConflict:
+		return "conflict"
+	case ErrorThrottled:
+		return "throttled"
+	case ErrorAuth:
+		return "auth"
+	case ErrorValidation:
+		return "validation"
+	case ErrorServer:
+		return "server"
+	default:
+		return "unknown"
+	}
+}
+
+// errorCodeKinds maps the error codes of the management and IAM APIs to their kind.
+// The error codes take precedence over the HTTP status, which the array does not always set consistently.
+var errorCodeKinds = map[string]ErrorKind{
+	// management API
+	"999":  ErrorAuth,
+	"1004": ErrorNotFound,
+	"1005": ErrorValidation,
+	"1008": ErrorValidation,
+	"1013": ErrorConflict,
+	// IAM API
+	"NoSuchEntity":            ErrorNotFound,
+	"EntityAlreadyExists":     ErrorConflict,
+	"DeleteConflict":          ErrorConflict,
+	"LimitExceeded":           ErrorConflict,
+	"ConcurrentModification":  ErrorConflict,
+	"Throttling":              ErrorThrottled,
+	"AccessDenied":            ErrorAuth,
+	"InvalidClientTokenId":    ErrorAuth,
+	"ValidationError":         ErrorValidation,
+	"InvalidInput":            ErrorValidation,
+	"MalformedPolicyDocument": ErrorValidation,
+}
+
+// errorCodeRemediations are the remediations of error codes which have a known cause, appended to the remediation of their kind.
+// These management API codes are not specific to the certificate keystores, whose errors are the most common cause.
+var errorCodeRemediations = map[string]string{
+	"999":  "The certificate keystores require the SECURITY_ADMIN role.",
+	"1008": "A certificate keystore requires a PEM private key in PKCS#1 format: convert a PKCS#8 key with `openssl rsa -in key.pem -out key-pkcs1.pem`.",
+	"1013": "For a certificate keystore, the certificate chain is already deployed and nothing was changed.",
+}
+
+// statusPrefix matches the HTTP status at the start of the message of a generated client error.
+var statusPrefix = regexp.MustCompile(`^([1-5]\d\d) `)
+
+// APIError is an error returned by the ObjectScale API, decoded from either the JSON body of the management API
+// ({"code": 1004, "description": ..., "details": ...}) or the XML ErrorResponse of the IAM API.
+type APIError struct {
+	// StatusCode is the HTTP status of the response, 0 when it is not known.
+	StatusCode int
+	Kind       ErrorKind
+	// Code is the numeric error code of the management API, or the error code of the IAM API, like NoSuchEntity.
+	Code        string
+	Description string
+	Details     string
+	Retryable   bool
+
+	err error
+}
+
+// managementError is the JSON error body of the management API.
+type managementError struct {
+	Code        json.Number `json:"code"`
+	Description string      `json:"description"`
+	Details     string      `json:"details"`
+	Retryable   bool        `json:"retryable"`
+}
+
+// iamErrorResponse is the XML error body of the IAM API.
+type iamErrorResponse struct {
+	Error struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	} `xml:"Error"`
+}
+
+// NewAPIError decodes the body of an error response of the API.
+// The wrapped error, if any, is returned by Unwrap.
+func NewAPIError(statusCode int, body []byte, err error) *APIError {
+	e := &APIError{StatusCode: statusCode, err: err}
+
+	var mgmt managementError
+	var iam iamErrorResponse
+	if json.Unmarshal(body, &mgmt) == nil && mgmt.Code != "" {
+		e.Code = mgmt.Code.String()
+		e.Description = mgmt.Description
+		e.Details = mgmt.Details
+		e.Retryable = mgmt.Retryable
+	} else if xml.Unmarshal(body, &iam) == nil && iam.Error.Code != "" {
+		e.Code = iam.Error.Code
+		e.Description = iam.Error.Message
+	}
+	e.Kind = classifyError(e.StatusCode, e.Code)
+	return e
+}
+
+// ParseError returns the APIError of an error returned by the generated client, or nil when it is not an API error,
+// like a connection or context error.
+func ParseError(err error) *APIError {
+	if err == nil {
+		return nil
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+	var genErr *clientgen.GenericOpenAPIError
+	if !errors.As(err, &genErr) {
+		return nil
+	}
+	status := 0
+	if m := statusPrefix.FindStringSubmatch(genErr.Error()); m != nil {
+		status, _ = strconv.Atoi(m[1])
+	}
+	return NewAPIError(status, genErr.Body(), err)
+}
+
+// KindOf returns the kind of an error, ErrorUnknown when it is not an API error.
+func KindOf(err error) ErrorKind {
+	if apiErr := ParseError(err); apiErr != nil {
+		return apiErr.Kind
+	}
+	return ErrorUnknown
+}
+
+// IsNotFound tells whether the error is returned by the API for an object which does not exist.
+func IsNotFound(err error) bool {
+	return KindOf(err) == ErrorNotFound
+}
+
+// classifyError returns the kind of an error from its code, or from its HTTP status when the code is not known.
+func classifyError(statusCode int, code string) ErrorKind {
+	if kind, ok := errorCodeKinds[code]; ok {
+		return kind
+	}
+	switch {
+	case statusCode == http.StatusNotFound:
+		return ErrorNotFound
+	case statusCode == http.StatusConflict:
+		return ErrorConflict
+	case statusCode == http.StatusTooManyRequests, statusCode == http.StatusServiceUnavailable:
+		return ErrorThrottled
+	case statusCode == http.StatusUnauthorized, statusCode == http.StatusForbidden:
+		return ErrorAuth
+	case statusCode >= 400 && statusCode < 500:
+		return ErrorValidation
+	case statusCode >= 500:
+		return ErrorServer
+	default:
+		return ErrorUnknown
+	}
+}
+
+// Error implements error.
+func (e *APIError) Error() string {
+	msg := "ObjectScale API error"
+	if e.StatusCode != 0 {
+		msg += fmt.Sprintf(" (HTTP %d)", e.StatusCode)
+	}
+	if e.Code == "" {
+		if e.err != nil {
+			return msg + ": " + e.err.Error()
+		}
+		return msg
+	}
+	msg += " " + e.Code
+	if e.Description != "" {
+		msg += ": " + e.Description
+	}
+	if e.Details != "" {
+		msg += ": " + e.Details
+	}
+	return msg
+}
+
+// Unwrap returns the error returned by the generated client.
+func (e *APIError) Unwrap() error {
+	return e.err
+}
+
+// HTTPStatus returns the HTTP status of the response.
+func (e *APIError) HTTPStatus() int {
+	return e.StatusCode
+}
+
+// Summary returns a short description of the kind of the error, for the summary of a diagnostic.
+func (e *APIError) Summary() string {
+	switch e.Kind {
+	case ErrorNotFound:
+		return "Object not found"
+	case ErrorConflict:
+		return "Conflict with an existing object"
+	case ErrorThrottled:
+		return "Request throttled"
+	case ErrorAuth:
+		return "Authentication or authorization failed"
+	case ErrorValidation:
+		return "Invalid request"
+	case ErrorServer:
+		return "ObjectScale server error"
+	default:
+		return "ObjectScale API error"
+	}
+}
+
+// Remediation returns what the user can do about the error.
+func (e *APIError) Remediation() string {
+	remediation := e.kindRemediation()
+	if hint, ok := errorCodeRemediations[e.Code]; ok {
+		remediation += " " + hint
+	}
+	return remediation
+}
+
+// kindRemediation returns what the user can do about an error of the kind of the error.
+func (e *APIError) kindRemediation() string {
+	switch e.Kind {
+	case ErrorNotFound:
+		return "The object does not exist on ObjectScale, it may have been deleted outside of Terraform. " +
+			"Check its name and namespace."
+	case ErrorConflict:
+		return "The object already exists, is still used by other objects, or a limit is reached. " +
+			"Import the existing object, or remove what depends on it first."
+	case ErrorThrottled:
+		return "ObjectScale is busy. Retry later, raise max_retries, or lower max_concurrent_requests and requests_per_second."
+	case ErrorAuth:
+		return "Check the username and password or the auth_token of the provider, " +
+			"and that the user has the management role needed for this operation."
+	case ErrorValidation:
+		return "Check the arguments of the resource against the constraints of ObjectScale."
+	case ErrorServer:
+		return "Check the health of ObjectScale, then retry."
+	default:
+		return ""
+	}
+}
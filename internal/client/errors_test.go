@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newErrorClient returns a client of an array answering every request with the given response.
+func newErrorClient(t *testing.T, status int, contentType, body string) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	c, err := NewClientFromConfig(Config{Endpoint: server.URL, AuthToken: "token"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return c
+}
+
+func TestParseErrorManagement(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		wantStatus int
+		kind       ErrorKind
+		code       string
+	}{
+		{"not found", http.StatusNotFound, `{"code":1004,"description":"Unable to find entity","details":"ns2 does not exist","retryable":false}`,
+			http.StatusNotFound, ErrorNotFound, "1004"},
+		{"bad request", http.StatusBadRequest, `{"code":1008,"description":"Invalid parameter","details":"bad name"}`,
+			http.StatusBadRequest, ErrorValidation, "1008"},
+		{"conflict", http.StatusConflict, `{"code":1013,"description":"Entity already exists"}`,
+			http.StatusConflict, ErrorConflict, "1013"},
+		{"conflict code", http.StatusBadRequest, `{"code":1013,"description":"Certificate chain already deployed"}`,
+			http.StatusBadRequest, ErrorConflict, "1013"},
+		{"unauthorized", http.StatusForbidden, `{"code":1030,"description":"Forbidden"}`,
+			http.StatusForbidden, ErrorAuth, "1030"},
+		{"server", http.StatusInternalServerError, `{"code":6000,"description":"Internal error","retryable":true}`,
+			http.StatusInternalServerError, ErrorServer, "6000"},
+		{"no body", http.StatusNotFound, ``, http.StatusNotFound, ErrorNotFound, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newErrorClient(t, tt.status, "application/json", tt.body)
+			_, _, err := c.GenClient.NamespaceApi.NamespaceServiceGetNamespace(context.Background(), "ns2").Execute()
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			apiErr := ParseError(fmt.Errorf("wrapped: %w", err))
+			if apiErr == nil {
+				t.Fatalf("expected an API error, got %v", err)
+			}
+			if apiErr.StatusCode != tt.wantStatus || apiErr.Kind != tt.kind || apiErr.Code != tt.code {
+				t.Errorf("expected %d/%v/%q, got %d/%v/%q", tt.wantStatus, tt.kind, tt.code, apiErr.StatusCode, apiErr.Kind, apiErr.Code)
+			}
+			if apiErr.Remediation() == "" {
+				t.Errorf("expected a remediation for %v", apiErr.Kind)
+			}
+			if !errors.Is(apiErr, err) {
+				t.Errorf("expected the API error to wrap %v", err)
+			}
+		})
+	}
+}
+
+func TestParseErrorIAM(t *testing.T) {
+	body := `<ErrorResponse><Error><Type>Sender</Type><Code>NoSuchEntity</Code>` +
+		`<Message>The user with name user2 cannot be found.</Message></Error><RequestId>0a1b</RequestId></ErrorResponse>`
+	c := newErrorClient(t, http.StatusNotFound, "application/xml", body)
+	_, _, err := c.GenClient.IamApi.IamServiceGetUser(context.Background()).UserName("user2").Execute()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+	apiErr := ParseError(err)
+	if apiErr.Code != "NoSuchEntity" || apiErr.Description != "The user with name user2 cannot be found." {
+		t.Errorf("unexpected decoded error %+v", apiErr)
+	}
+}
+
+func TestParseErrorOther(t *testing.T) {
+	if ParseError(nil) != nil {
+		t.Error("expected no API error for nil")
+	}
+	if ParseError(errors.New("dial tcp: connection refused")) != nil {
+		t.Error("expected no API error for a connection error")
+	}
+	if IsNotFound(errors.New("404 not found")) {
+		t.Error("expected a plain error not to be classified")
+	}
+	if kind := KindOf(NewAPIError(http.StatusTooManyRequests, nil, nil)); kind != ErrorThrottled {
+		t.Errorf("expected throttled, got %v", kind)
+	}
+}
+
+func TestParseErrorIAMBadRequest(t *testing.T) {
+	// the generated client fails to decode the XML body of a 400 and loses the status, the code still classifies it
+	body := `<ErrorResponse><Error><Code>MalformedPolicyDocument</Code><Message>Syntax errors in policy.</Message></Error></ErrorResponse>`
+	c := newErrorClient(t, http.StatusBadRequest, "application/xml", body)
+	_, _, err := c.GenClient.IamApi.IamServiceGetUser(context.Background()).UserName("user2").Execute()
+	if kind := KindOf(err); kind != ErrorValidation {
+		t.Errorf("expected a validation error, got %v: %v", kind, err)
+	}
+}
+
+func TestRemediationErrorCode(t *testing.T) {
+	tests := map[string]string{
+		`{"code":999,"description":"Insufficient permissions"}`: "SECURITY_ADMIN",
+		`{"code":1008,"description":"Invalid format"}`:          "openssl rsa",
+		`{"code":1013,"description":"Already exists"}`:          "already deployed",
+	}
+	for body, want := range tests {
+		apiErr := NewAPIError(http.StatusBadRequest, []byte(body), nil)
+		if remediation := apiErr.Remediation(); !strings.Contains(remediation, want) {
+			t.Errorf("expected %q in the remediation of %s, got %q", want, body, remediation)
+		}
+	}
+	if remediation := NewAPIError(http.StatusBadRequest, []byte(`{"code":1005}`), nil).Remediation(); strings.Contains(remediation, "keystore") {
+		t.Errorf("unexpected keystore remediation %q", remediation)
+	}
+}
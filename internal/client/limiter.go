@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// limiter caps the number of concurrent requests and the rate of requests sent to the array.
+// It is shared by all the requests of a client, including the retries and re-logins.
+type limiter struct {
+	// sem holds one token per request in flight, nil when the concurrency is not limited
+	sem chan struct{}
+	// interval is the minimum delay between the start of two requests, 0 when the rate is not limited
+	interval time.Duration
+
+	mu sync.Mutex
+	// next is the earliest time the next request can start
+	next time.Time
+}
+
+// newLimiter returns a limiter, 0 meaning unlimited for both settings.
+func newLimiter(maxConcurrent int, perSecond int) *limiter {
+	l := &limiter{}
+	if maxConcurrent > 0 {
+		l.sem = make(chan struct{}, maxConcurrent)
+	}
+	if perSecond > 0 {
+		l.interval = time.Second / time.Duration(perSecond)
+	}
+	return l
+}
+
+// acquire waits until a request can be sent. release must be called once the request is done.
+func (l *limiter) acquire(ctx context.Context) error {
+	if l.sem != nil {
+		select {
+		case l.sem <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if wait := l.reserve(); wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			l.release()
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// reserve books the next start time slot, returning how long to wait for it.
+func (l *limiter) reserve() time.Duration {
+	if l.interval == 0 {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	return wait
+}
+
+// release frees the concurrency slot of a request.
+func (l *limiter) release() {
+	if l.sem != nil {
+		<-l.sem
+	}
+}
+
+// limitTransport is a http.RoundTripper which sends the requests through a limiter.
+type limitTransport struct {
+	base    http.RoundTripper
+	limiter *limiter
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.acquire(req.Context()); err != nil {
+		return nil, err
+	}
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		t.limiter.release()
+		return nil, err
+	}
+	// the request is in flight until its response is read
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: t.limiter.release}
+	return resp, nil
+}
+
+// releaseOnClose releases the concurrency slot of a request when its response body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+// Close implements io.Closer.
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(r.release)
+	return err
+}
@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLimiterConcurrency(t *testing.T) {
+	fa, server := newFakeArray(t)
+	fa.valid["pre-issued"] = true
+	var inFlight, maxInFlight int32
+	handler := server.Config.Handler
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		handler.ServeHTTP(w, r)
+	})
+
+	c, err := NewClientFromConfig(Config{Endpoint: server.URL, AuthToken: "pre-issued", MaxConcurrentRequests: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := c.GenClient.ZoneInfoApi.ZoneInfoServiceListAllVdc(context.Background()).Execute(); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	// requests built outside of the generated client, like the keystore ones, share the same limit
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, server.URL+"/vdc/keystore", nil)
+			resp, err := c.GenClient.GetConfig().HTTPClient.Do(req)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&maxInFlight); got != 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", got)
+	}
+	if len(c.limiter.sem) != 0 {
+		t.Errorf("expected all the slots to be released, got %d in use", len(c.limiter.sem))
+	}
+}
+
+func TestLimiterRate(t *testing.T) {
+	l := newLimiter(0, 20)
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := l.acquire(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		l.release()
+	}
+	// the first request starts right away, then one every 50ms
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("expected 5 requests to take at least 200ms, took %v", elapsed)
+	}
+}
+
+func TestLimiterUnlimited(t *testing.T) {
+	l := newLimiter(0, 0)
+	for i := 0; i < 100; i++ {
+		if err := l.acquire(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if l.sem != nil || l.interval != 0 {
+		t.Errorf("expected an unlimited limiter, got %+v", l)
+	}
+}
+
+func TestLimiterContextCanceled(t *testing.T) {
+	l := newLimiter(1, 0)
+	if err := l.acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := l.acquire(ctx); err == nil {
+		t.Error("expected error")
+	}
+
+	// a request waiting for its rate slot gives back its concurrency slot when canceled
+	l = newLimiter(1, 1)
+	if err := l.acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	l.release()
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := l.acquire(ctx); err == nil {
+		t.Error("expected error")
+	}
+	if len(l.sem) != 0 {
+		t.Errorf("expected the slot to be released, got %d in use", len(l.sem))
+	}
+}
@@ -0,0 +1,242 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// logSubsystem is the tflog subsystem of the HTTP logs.
+	logSubsystem = "http"
+	// maxLoggedBody is the maximum number of bytes of a request or response body written to the logs.
+	maxLoggedBody = 16 * 1024
+	// redacted replaces the sensitive values in the logs.
+	redacted = "***"
+)
+
+// sensitiveHeaders are the headers which are never logged.
+var sensitiveHeaders = []string{"Authorization", authTokenHeader, "Cookie", "Set-Cookie"}
+
+// sensitiveField matches the normalized names of the JSON fields, XML elements and query parameters
+// holding a secret, like secret_key, secret_key_1, SecretAccessKey, root_user_password, private_key,
+// auth_token or client_secret.
+var sensitiveField = regexp.MustCompile(`(password|privatekey|secret|secretaccesskey|secretkeys?\d*|token)$`)
+
+// xmlElement matches an XML element holding a text value.
+var xmlElement = regexp.MustCompile(`<([A-Za-z_][\w.-]*)>([^<]*)</([A-Za-z_][\w.-]*)>`)
+
+// logTransport is a http.RoundTripper which logs every request sent to the array, with its response.
+//
+// The logs are written at trace level to the "http" tflog subsystem, whose level is set
+// with the TF_LOG_PROVIDER_OBJECTSCALE_HTTP environment variable, and defaults to the level of the provider.
+// The credentials, auth tokens and secrets are masked in the headers, query parameters and bodies.
+// The bodies are only read and redacted when the trace logs are enabled.
+type logTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *logTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), logSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_OBJECTSCALE", "HTTP"))
+
+	// the request entry is formatted only when the trace logs are enabled, which tells whether to log the response
+	lazy := &lazyRequest{req: req}
+	tflog.SubsystemTrace(ctx, logSubsystem, fmt.Sprintf("%s %s", req.Method, req.URL.Path), map[string]interface{}{
+		"method":          req.Method,
+		"path":            req.URL.Path,
+		"query":           hclog.Fmt("%s", lazy),
+		"request_headers": redactHeaders(req.Header),
+		"request_body":    hclog.Fmt("%s", lazyBody{lazy}),
+	})
+	if lazy.err != nil {
+		return nil, lazy.err
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	if !lazy.traced {
+		return resp, err
+	}
+
+	fields := map[string]interface{}{
+		"method":     req.Method,
+		"path":       req.URL.Path,
+		"latency_ms": time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemTrace(ctx, logSubsystem, fmt.Sprintf("%s %s failed", req.Method, req.URL.Path), fields)
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	fields["response_headers"] = redactHeaders(resp.Header)
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		fields["error"] = err.Error()
+	} else if len(body) > 0 {
+		fields["response_body"] = redactBody(req.URL.Path, resp.Header.Get("Content-Type"), body)
+	}
+	tflog.SubsystemTrace(ctx, logSubsystem, fmt.Sprintf("%s %s returned %s", req.Method, req.URL.Path, resp.Status), fields)
+	return resp, nil
+}
+
+// lazyRequest formats the query parameters and the body of a request for the logs, when they are written.
+// The body is then buffered, to be sent after being logged.
+type lazyRequest struct {
+	req *http.Request
+	// traced tells that the logs were written
+	traced bool
+	// err is the error reading the request body
+	err error
+}
+
+// String implements fmt.Stringer, returning the redacted query parameters.
+func (l *lazyRequest) String() string {
+	l.traced = true
+	return redactQuery(l.req.URL.Query())
+}
+
+// lazyBody formats the body of a lazyRequest.
+type lazyBody struct {
+	*lazyRequest
+}
+
+// String implements fmt.Stringer, returning the redacted body.
+func (l lazyBody) String() string {
+	l.traced = true
+	req := l.req
+	if req.Body == nil || req.Body == http.NoBody {
+		return ""
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		l.err = err
+		return ""
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return redactBody(req.URL.Path, req.Header.Get("Content-Type"), body)
+}
+
+// isSensitive tells whether a field, element or parameter holds a secret.
+func isSensitive(name string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+	return sensitiveField.MatchString(normalized)
+}
+
+// redactHeaders returns the headers with the sensitive values masked.
+func redactHeaders(header http.Header) map[string]string {
+	out := make(map[string]string, len(header))
+	for name, values := range header {
+		out[name] = strings.Join(values, ", ")
+	}
+	for _, name := range sensitiveHeaders {
+		if header.Get(name) != "" {
+			out[http.CanonicalHeaderKey(name)] = redacted
+		}
+	}
+	return out
+}
+
+// redactQuery returns the query parameters with the sensitive values masked.
+func redactQuery(query url.Values) string {
+	for name := range query {
+		if isSensitive(name) {
+			query[name] = []string{redacted}
+		}
+	}
+	return query.Encode()
+}
+
+// redactBody returns the body to log, with the sensitive values masked.
+// Only JSON and XML bodies are logged, the other ones are replaced by their size.
+func redactBody(path, contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	// the secret key of the VDC is the whole response
+	if strings.HasSuffix(path, "/secretkey") {
+		return redacted
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	var out string
+	switch {
+	case strings.HasSuffix(mediaType, "json"):
+		var v interface{}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return fmt.Sprintf("<%d bytes of invalid JSON>", len(body))
+		}
+		masked, _ := json.Marshal(redactJSON(v))
+		out = string(masked)
+	case strings.HasSuffix(mediaType, "xml"):
+		out = redactXML(string(body))
+	default:
+		return fmt.Sprintf("<%d bytes of %s>", len(body), mediaType)
+	}
+	if len(out) > maxLoggedBody {
+		return out[:maxLoggedBody] + "...(truncated)"
+	}
+	return out
+}
+
+// redactJSON masks the string values of the sensitive fields of a decoded JSON document.
+func redactJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, field := range v {
+			if _, ok := field.(string); ok && isSensitive(k) {
+				v[k] = redacted
+			} else {
+				v[k] = redactJSON(field)
+			}
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactJSON(v[i])
+		}
+	}
+	return v
+}
+
+// redactXML masks the text of the sensitive elements of an XML document.
+func redactXML(body string) string {
+	return xmlElement.ReplaceAllStringFunc(body, func(element string) string {
+		m := xmlElement.FindStringSubmatch(element)
+		if m[1] != m[3] || !isSensitive(m[1]) {
+			return element
+		}
+		return "<" + m[1] + ">" + redacted + "</" + m[3] + ">"
+	})
+}
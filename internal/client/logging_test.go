@@ -0,0 +1,194 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"terraform-provider-objectscale/internal/clientgen"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLogTransport(t *testing.T) {
+	fa, server := newFakeArray(t)
+	fa.valid["pre-issued"] = true
+
+	c, err := NewClientFromConfig(Config{Endpoint: server.URL, AuthToken: "pre-issued"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	ns, secret := "ns1", "topsecretvalue"
+	_, _, err = c.GenClient.UserSecretKeyApi.UserSecretKeyServiceCreateNewKeyForUser(ctx, "user1").
+		UserSecretKeyServiceCreateNewKeyForUserRequest(clientgen.UserSecretKeyServiceCreateNewKeyForUserRequest{
+			Namespace: &ns,
+			Secretkey: &secret,
+		}).
+		Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the request body must still reach the array
+	if len(fa.bodies) != 1 || !strings.Contains(fa.bodies[0], secret) {
+		t.Errorf("expected the request body to be sent, got %v", fa.bodies)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 log entries, got %d: %s", len(entries), output.String())
+	}
+	request, response := entries[0], entries[1]
+	for _, entry := range entries {
+		if entry["@level"] != "trace" || entry["@module"] != "provider.http" {
+			t.Errorf("expected a trace entry of the http subsystem, got %v", entry)
+		}
+		if entry["method"] != "POST" || entry["path"] != "/object/user-secret-keys/user1" {
+			t.Errorf("unexpected request fields %v", entry)
+		}
+	}
+	if body, _ := request["request_body"].(string); !strings.Contains(body, `"secretkey":"***"`) {
+		t.Errorf("expected the masked request body, got %v", request)
+	}
+	if response["status"] != float64(200) {
+		t.Errorf("unexpected response fields %v", response)
+	}
+	if _, ok := response["latency_ms"]; !ok {
+		t.Errorf("expected the latency to be logged")
+	}
+	logged := output.String()
+	if strings.Contains(logged, secret) || strings.Contains(logged, "pre-issued") {
+		t.Errorf("expected the secret key and the auth token to be masked, got %s", logged)
+	}
+}
+
+// recordTransport records the bodies of the request it sends, and returns a fixed response.
+type recordTransport struct {
+	requestBody  io.ReadCloser
+	responseBody io.ReadCloser
+}
+
+func (r *recordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.requestBody = req.Body
+	return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: r.responseBody}, nil
+}
+
+func TestLogTransportNotTraced(t *testing.T) {
+	base := &recordTransport{responseBody: io.NopCloser(strings.NewReader(`{}`))}
+	transport := &logTransport{base: base}
+
+	// without trace logs, the bodies are neither read nor buffered
+	requestBody := io.NopCloser(strings.NewReader(`{"password":"pw"}`))
+	req, _ := http.NewRequest(http.MethodPost, "https://array/login", requestBody)
+	req.Body = requestBody
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if base.requestBody != requestBody {
+		t.Errorf("expected the request body to be sent as is")
+	}
+	if resp.Body != base.responseBody {
+		t.Errorf("expected the response body to be returned as is")
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		path        string
+		contentType string
+		body        string
+		want        string
+	}{
+		{
+			name:        "json",
+			path:        "/object/user-secret-keys/user1",
+			contentType: "application/json",
+			body:        `{"secret_key_1":"abc","secret_key_1_exist":true,"secret_key_1_id":"id1","user":"u1"}`,
+			want:        `{"secret_key_1":"***","secret_key_1_exist":true,"secret_key_1_id":"id1","user":"u1"}`,
+		},
+		{
+			name:        "nested json",
+			path:        "/vdc/keystore",
+			contentType: "application/json; charset=utf-8",
+			body:        `{"key_and_certificate":{"certificate_chain":"chain","private_key":"key"}}`,
+			want:        `{"key_and_certificate":{"certificate_chain":"chain","private_key":"***"}}`,
+		},
+		{
+			name:        "json array",
+			path:        "/object/namespaces/namespace",
+			contentType: "application/json",
+			body:        `[{"root_user_password":"pw"}]`,
+			want:        `[{"root_user_password":"***"}]`,
+		},
+		{
+			name:        "webhook target",
+			path:        "/rest/v1/object-webhook-targets",
+			contentType: "application/json",
+			body:        `{"auth_token":"jwt","client_secret":"cs","name":"hook","url":"https://hook"}`,
+			want:        `{"auth_token":"***","client_secret":"***","name":"hook","url":"https://hook"}`,
+		},
+		{
+			name:        "xml",
+			path:        "/iam",
+			contentType: "application/xml",
+			body:        `<AccessKey><AccessKeyId>AKIA1</AccessKeyId><SecretAccessKey>s3cr3t</SecretAccessKey></AccessKey>`,
+			want:        `<AccessKey><AccessKeyId>AKIA1</AccessKeyId><SecretAccessKey>***</SecretAccessKey></AccessKey>`,
+		},
+		{
+			name:        "vdc secret key",
+			path:        "/object/vdcs/vdc/local/secretkey",
+			contentType: "application/json",
+			body:        `{"key":"s3cr3t"}`,
+			want:        `***`,
+		},
+		{
+			name:        "binary",
+			path:        "/object/bucket",
+			contentType: "application/octet-stream",
+			body:        "raw",
+			want:        "<3 bytes of application/octet-stream>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactBody(tt.path, tt.contentType, []byte(tt.body)); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRedactQuery(t *testing.T) {
+	query := url.Values{"Action": {"CreateUser"}, "Password": {"pw"}, "UserName": {"u1"}}
+	if got, want := redactQuery(query), "Action=CreateUser&Password=%2A%2A%2A&UserName=u1"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxRetryBackoff caps the exponential backoff between two retries.
+const maxRetryBackoff = 30 * time.Second
+
+// retryTransport is a http.RoundTripper which retries the requests failing with transient errors.
+//
+// A request is retried when:
+//   - the array is throttling or busy (HTTP 429 or 503), as the request was not processed;
+//   - the array flags the error as retryable in the error body;
+//   - the connection failed or a server error (HTTP 5xx) is returned, only for idempotent methods.
+//
+// The delay between retries starts at backoff and doubles after each retry, unless the array sends Retry-After.
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	backoff    time.Duration
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		out := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			out = req.Clone(req.Context())
+			out.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(out)
+		if attempt >= t.maxRetries || !t.canRewind(req) {
+			return resp, err
+		}
+		retry, wait := shouldRetry(req, resp, err)
+		if !retry {
+			return resp, err
+		}
+		if wait == 0 {
+			wait = t.backoffFor(attempt)
+		}
+
+		reason := ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = resp.Status
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		tflog.Warn(req.Context(), fmt.Sprintf("%s %s failed with %s, retrying in %v (attempt %d/%d)",
+			req.Method, req.URL.Path, reason, wait, attempt+1, t.maxRetries))
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// canRewind tells whether the request body can be sent again.
+func (t *retryTransport) canRewind(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// backoffFor returns the delay before the given retry.
+func (t *retryTransport) backoffFor(attempt int) time.Duration {
+	wait := t.backoff
+	for i := 0; i < attempt && wait < maxRetryBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, maxRetryBackoff)
+}
+
+// shouldRetry tells whether a request must be retried, and how long the array asked to wait, if it did.
+func shouldRetry(req *http.Request, resp *http.Response, err error) (bool, time.Duration) {
+	if err != nil {
+		// the context error is not transient
+		if req.Context().Err() != nil {
+			return false, 0
+		}
+		return isIdempotent(req.Method), 0
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable:
+		return true, retryAfter(resp)
+	case resp.StatusCode >= 500 && isIdempotent(req.Method):
+		return true, 0
+	case resp.StatusCode >= 500:
+		return isRetryableError(resp), 0
+	}
+	return false, 0
+}
+
+// isIdempotent tells whether a request with the given method can be sent twice safely.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryAfter returns the delay asked by the array in the Retry-After header, in seconds.
+func retryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return min(time.Duration(seconds)*time.Second, maxRetryBackoff)
+}
+
+// retryableError is the retryable flag of the ObjectScale error body, in JSON or XML.
+type retryableError struct {
+	Retryable bool `json:"retryable" xml:"retryable"`
+}
+
+// isRetryableError tells whether the array flagged the error as retryable.
+// The response body is read and replaced, so that it can still be decoded by the caller.
+func isRetryableError(resp *http.Response) bool {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	var errResp retryableError
+	if json.Unmarshal(body, &errResp) != nil && xml.Unmarshal(body, &errResp) != nil {
+		return false
+	}
+	return errResp.Retryable
+}
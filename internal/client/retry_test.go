@@ -0,0 +1,274 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newFlakyServer returns a server answering the given failures in order, then 200 with the received body.
+func newFlakyServer(t *testing.T, failures ...func(w http.ResponseWriter)) (*httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(atomic.AddInt32(&calls, 1))
+		if call <= len(failures) {
+			failures[call-1](w)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func status(code int, body string) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.WriteHeader(code)
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+// dropConnection closes the connection without answering.
+func dropConnection(w http.ResponseWriter) {
+	conn, _, err := w.(http.Hijacker).Hijack()
+	if err == nil {
+		conn.Close()
+	}
+}
+
+func newRetryClient(maxRetries int) *http.Client {
+	return &http.Client{
+		Transport: &retryTransport{
+			base:       http.DefaultTransport,
+			maxRetries: maxRetries,
+			backoff:    time.Millisecond,
+		},
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		failures   []func(w http.ResponseWriter)
+		wantStatus int
+		wantCalls  int32
+		wantErr    bool
+	}{
+		{
+			name:       "busy then success",
+			method:     http.MethodGet,
+			failures:   []func(w http.ResponseWriter){status(503, ""), status(429, "")},
+			wantStatus: 200,
+			wantCalls:  3,
+		},
+		{
+			name:       "busy on create",
+			method:     http.MethodPost,
+			failures:   []func(w http.ResponseWriter){status(503, "")},
+			wantStatus: 200,
+			wantCalls:  2,
+		},
+		{
+			name:       "gateway error on update",
+			method:     http.MethodPut,
+			failures:   []func(w http.ResponseWriter){status(502, ""), status(504, "")},
+			wantStatus: 200,
+			wantCalls:  3,
+		},
+		{
+			name:       "server error on update",
+			method:     http.MethodPut,
+			failures:   []func(w http.ResponseWriter){status(500, "")},
+			wantStatus: 200,
+			wantCalls:  2,
+		},
+		{
+			name:       "gateway error on create",
+			method:     http.MethodPost,
+			failures:   []func(w http.ResponseWriter){status(502, "")},
+			wantStatus: 502,
+			wantCalls:  1,
+		},
+		{
+			name:       "retryable JSON error",
+			method:     http.MethodPost,
+			failures:   []func(w http.ResponseWriter){status(500, `{"code": 6503, "retryable": true}`)},
+			wantStatus: 200,
+			wantCalls:  2,
+		},
+		{
+			name:       "retryable XML error",
+			method:     http.MethodPost,
+			failures:   []func(w http.ResponseWriter){status(500, `<error><code>6503</code><retryable>true</retryable></error>`)},
+			wantStatus: 200,
+			wantCalls:  2,
+		},
+		{
+			name:       "non retryable error",
+			method:     http.MethodPost,
+			failures:   []func(w http.ResponseWriter){status(500, `{"code": 1013, "retryable": false}`)},
+			wantStatus: 500,
+			wantCalls:  1,
+		},
+		{
+			name:       "client error",
+			method:     http.MethodGet,
+			failures:   []func(w http.ResponseWriter){status(400, "")},
+			wantStatus: 400,
+			wantCalls:  1,
+		},
+		{
+			name:       "retries exhausted",
+			method:     http.MethodGet,
+			failures:   []func(w http.ResponseWriter){status(503, ""), status(503, ""), status(503, ""), status(503, "")},
+			wantStatus: 503,
+			wantCalls:  3,
+		},
+		{
+			name:       "connection reset on read",
+			method:     http.MethodGet,
+			failures:   []func(w http.ResponseWriter){dropConnection},
+			wantStatus: 200,
+			wantCalls:  2,
+		},
+		{
+			name:      "connection reset on create",
+			method:    http.MethodPost,
+			failures:  []func(w http.ResponseWriter){dropConnection},
+			wantErr:   true,
+			wantCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := newFlakyServer(t, tt.failures...)
+			req, err := http.NewRequest(tt.method, server.URL, strings.NewReader(`{"name": "bucket1"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			// POST requests are not retried by net/http itself on a dropped connection
+			req.Close = true
+
+			resp, err := newRetryClient(2).Do(req)
+			if got := atomic.LoadInt32(calls); got != tt.wantCalls {
+				t.Errorf("expected %d calls, got %d", tt.wantCalls, got)
+			}
+			if tt.wantErr {
+				if err == nil {
+					resp.Body.Close()
+					t.Error("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+			body, _ := io.ReadAll(resp.Body)
+			// the body of the request is sent again, and the error body is still readable
+			if tt.wantStatus == 200 && string(body) != `{"name": "bucket1"}` {
+				t.Errorf("unexpected response body %q", body)
+			}
+			if tt.wantStatus == 500 && !strings.Contains(string(body), "1013") {
+				t.Errorf("unexpected response body %q", body)
+			}
+		})
+	}
+}
+
+func TestRetryTransportDisabled(t *testing.T) {
+	server, calls := newFlakyServer(t, status(503, ""))
+	resp, err := newRetryClient(0).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != 503 || atomic.LoadInt32(calls) != 1 {
+		t.Errorf("expected a single 503, got %d after %d calls", resp.StatusCode, atomic.LoadInt32(calls))
+	}
+}
+
+func TestRetryTransportContextCanceled(t *testing.T) {
+	server, calls := newFlakyServer(t, status(503, ""), status(503, ""))
+	client := &http.Client{Transport: &retryTransport{base: http.DefaultTransport, maxRetries: 2, backoff: time.Hour}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	_, err := client.Do(req)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if atomic.LoadInt32(calls) != 1 {
+		t.Errorf("expected 1 call, got %d", atomic.LoadInt32(calls))
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	rt := &retryTransport{backoff: time.Second}
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second, 30 * time.Second} {
+		if got := rt.backoffFor(attempt); got != want {
+			t.Errorf("attempt %d: expected %v, got %v", attempt, want, got)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "5")
+	if got := retryAfter(resp); got != 5*time.Second {
+		t.Errorf("expected 5s, got %v", got)
+	}
+	resp.Header.Set("Retry-After", "Wed, 21 Oct 2015 07:28:00 GMT")
+	if got := retryAfter(resp); got != 0 {
+		t.Errorf("expected no delay, got %v", got)
+	}
+}
+
+func TestNewClientRetries(t *testing.T) {
+	fa, server := newFakeArray(t)
+	fa.valid["pre-issued"] = true
+	busy := true
+	handler := server.Config.Handler
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if busy && r.URL.Path != "/login" {
+			busy = false
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+
+	c, err := NewClientFromConfig(Config{Endpoint: server.URL, AuthToken: "pre-issued", MaxRetries: 1, RetryBackoff: time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := c.GenClient.ZoneInfoApi.ZoneInfoServiceListAllVdc(context.Background()).Execute(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
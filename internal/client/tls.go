@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// newTLSConfig builds the TLS configuration of the client from the provider settings.
+func newTLSConfig(config Config) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if config.Insecure {
+		/* #nosec */
+		tlsConfig.InsecureSkipVerify = true
+	} else {
+		// Loading system certs by default if insecure is set to false
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, errors.New("unable to initialize cert pool from system")
+		}
+		if config.CACertificate != "" {
+			caPEM, err := readPEM(config.CACertificate)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read CA certificate: %w", err)
+			}
+			if !pool.AppendCertsFromPEM(caPEM) {
+				return nil, errors.New("no valid PEM certificate found in CA certificate")
+			}
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCertificate != "" || config.ClientKey != "" {
+		if config.ClientCertificate == "" || config.ClientKey == "" {
+			return nil, errors.New("both client certificate and client key are required for mutual TLS")
+		}
+		certPEM, err := readPEM(config.ClientCertificate)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client certificate: %w", err)
+		}
+		keyPEM, err := readPEM(config.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client key: %w", err)
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// readPEM returns PEM encoded content given either inline or as the path of a file.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
@@ -1493,6 +1493,166 @@ func (a *BucketApiService) BucketServiceDeleteBucketTagsExecute(r ApiBucketServi
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiBucketServiceDeleteCopyPolicyRequest struct {
+	ctx        context.Context
+	ApiService *BucketApiService
+	bucketName string
+	account    *string
+}
+
+// Namespace for which DM policies should be deleted.
+func (r ApiBucketServiceDeleteCopyPolicyRequest) Account(account string) ApiBucketServiceDeleteCopyPolicyRequest {
+	r.account = &account
+	return r
+}
+
+func (r ApiBucketServiceDeleteCopyPolicyRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.BucketServiceDeleteCopyPolicyExecute(r)
+}
+
+/*
+BucketServiceDeleteCopyPolicy Deletes DM policy for the specified bucket
+
+Deletes DM policy for the specified bucket.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param bucketName Bucket name for which DM policy should be deleted.
+	@return ApiBucketServiceDeleteCopyPolicyRequest
+*/
+func (a *BucketApiService) BucketServiceDeleteCopyPolicy(ctx context.Context, bucketName string) ApiBucketServiceDeleteCopyPolicyRequest {
+	return ApiBucketServiceDeleteCopyPolicyRequest{
+		ApiService: a,
+		ctx:        ctx,
+		bucketName: bucketName,
+	}
+}
+
+// Execute executes the request
+//
+//	@return map[string]interface{}
+func (a *BucketApiService) BucketServiceDeleteCopyPolicyExecute(r ApiBucketServiceDeleteCopyPolicyRequest) (map[string]interface{}, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodDelete
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue map[string]interface{}
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BucketApiService.BucketServiceDeleteCopyPolicy")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/object/bucket/{bucketName}/copypolicy"
+	localVarPath = strings.Replace(localVarPath, "{"+"bucketName"+"}", url.PathEscape(parameterValueToString(r.bucketName, "bucketName")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.account != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "account", r.account, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["AuthToken"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["X-SDS-AUTH-TOKEN"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiBucketServiceEnableObjectLockWithAdoAllowedForExistingBucketRequest struct {
 	ctx        context.Context
 	ApiService *BucketApiService
@@ -3458,38 +3618,34 @@ func (a *BucketApiService) BucketServiceGetBucketsExecute(r ApiBucketServiceGetB
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiBucketServiceGetEmptyBucketStatusRequest struct {
+type ApiBucketServiceGetCopyPolicyRequest struct {
 	ctx        context.Context
 	ApiService *BucketApiService
 	bucketName string
-	namespace  *string
+	account    *string
 }
 
-// Namespace associated with the bucket. If not present the user&#39;s namespace is used.
-func (r ApiBucketServiceGetEmptyBucketStatusRequest) Namespace(namespace string) ApiBucketServiceGetEmptyBucketStatusRequest {
-	r.namespace = &namespace
+// Namespace for which DM policy should be returned.
+func (r ApiBucketServiceGetCopyPolicyRequest) Account(account string) ApiBucketServiceGetCopyPolicyRequest {
+	r.account = &account
 	return r
 }
 
-func (r ApiBucketServiceGetEmptyBucketStatusRequest) Execute() (*BucketServiceGetEmptyBucketStatusResponse, *http.Response, error) {
-	return r.ApiService.BucketServiceGetEmptyBucketStatusExecute(r)
+func (r ApiBucketServiceGetCopyPolicyRequest) Execute() (*BucketServiceGetCopyPolicyResponse, *http.Response, error) {
+	return r.ApiService.BucketServiceGetCopyPolicyExecute(r)
 }
 
 /*
-BucketServiceGetEmptyBucketStatus Get empty bucket status
-
-Gets empty bucket status for the specified bucket.
+BucketServiceGetCopyPolicy Returns DM policy for the specified bucket
 
-	During bucket delete the empty bucket status will be available until the bucket is deleted.
-	Should the delete fail the empty bucket delete status will still be available for some time
-	and will show how many objects failed to be deleted.
+Returns DM policy for the specified bucket.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param bucketName Name of the bucket for which lock information is to be retrieved
-	@return ApiBucketServiceGetEmptyBucketStatusRequest
+	@param bucketName Bucket name for which DM policy should be returned.
+	@return ApiBucketServiceGetCopyPolicyRequest
 */
-func (a *BucketApiService) BucketServiceGetEmptyBucketStatus(ctx context.Context, bucketName string) ApiBucketServiceGetEmptyBucketStatusRequest {
-	return ApiBucketServiceGetEmptyBucketStatusRequest{
+func (a *BucketApiService) BucketServiceGetCopyPolicy(ctx context.Context, bucketName string) ApiBucketServiceGetCopyPolicyRequest {
+	return ApiBucketServiceGetCopyPolicyRequest{
 		ApiService: a,
 		ctx:        ctx,
 		bucketName: bucketName,
@@ -3498,29 +3654,29 @@ func (a *BucketApiService) BucketServiceGetEmptyBucketStatus(ctx context.Context
 
 // Execute executes the request
 //
-//	@return BucketServiceGetEmptyBucketStatusResponse
-func (a *BucketApiService) BucketServiceGetEmptyBucketStatusExecute(r ApiBucketServiceGetEmptyBucketStatusRequest) (*BucketServiceGetEmptyBucketStatusResponse, *http.Response, error) {
+//	@return BucketServiceGetCopyPolicyResponse
+func (a *BucketApiService) BucketServiceGetCopyPolicyExecute(r ApiBucketServiceGetCopyPolicyRequest) (*BucketServiceGetCopyPolicyResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *BucketServiceGetEmptyBucketStatusResponse
+		localVarReturnValue *BucketServiceGetCopyPolicyResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BucketApiService.BucketServiceGetEmptyBucketStatus")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BucketApiService.BucketServiceGetCopyPolicy")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/object/bucket/{bucketName}/empty-bucket-status"
+	localVarPath := localBasePath + "/object/bucket/{bucketName}/copypolicy"
 	localVarPath = strings.Replace(localVarPath, "{"+"bucketName"+"}", url.PathEscape(parameterValueToString(r.bucketName, "bucketName")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.namespace != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "namespace", r.namespace, "")
+	if r.account != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "account", r.account, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -3622,52 +3778,70 @@ func (a *BucketApiService) BucketServiceGetEmptyBucketStatusExecute(r ApiBucketS
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiBucketServiceGetGroupsRequest struct {
+type ApiBucketServiceGetEmptyBucketStatusRequest struct {
 	ctx        context.Context
 	ApiService *BucketApiService
+	bucketName string
+	namespace  *string
 }
 
-func (r ApiBucketServiceGetGroupsRequest) Execute() (*BucketServiceGetGroupsResponse, *http.Response, error) {
-	return r.ApiService.BucketServiceGetGroupsExecute(r)
+// Namespace associated with the bucket. If not present the user&#39;s namespace is used.
+func (r ApiBucketServiceGetEmptyBucketStatusRequest) Namespace(namespace string) ApiBucketServiceGetEmptyBucketStatusRequest {
+	r.namespace = &namespace
+	return r
+}
+
+func (r ApiBucketServiceGetEmptyBucketStatusRequest) Execute() (*BucketServiceGetEmptyBucketStatusResponse, *http.Response, error) {
+	return r.ApiService.BucketServiceGetEmptyBucketStatusExecute(r)
 }
 
 /*
-BucketServiceGetGroups Gets all ACL groups
+BucketServiceGetEmptyBucketStatus Get empty bucket status
 
-Gets all ACL groups.
+Gets empty bucket status for the specified bucket.
+
+	During bucket delete the empty bucket status will be available until the bucket is deleted.
+	Should the delete fail the empty bucket delete status will still be available for some time
+	and will show how many objects failed to be deleted.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiBucketServiceGetGroupsRequest
+	@param bucketName Name of the bucket for which lock information is to be retrieved
+	@return ApiBucketServiceGetEmptyBucketStatusRequest
 */
-func (a *BucketApiService) BucketServiceGetGroups(ctx context.Context) ApiBucketServiceGetGroupsRequest {
-	return ApiBucketServiceGetGroupsRequest{
+func (a *BucketApiService) BucketServiceGetEmptyBucketStatus(ctx context.Context, bucketName string) ApiBucketServiceGetEmptyBucketStatusRequest {
+	return ApiBucketServiceGetEmptyBucketStatusRequest{
 		ApiService: a,
 		ctx:        ctx,
+		bucketName: bucketName,
 	}
 }
 
 // Execute executes the request
 //
-//	@return BucketServiceGetGroupsResponse
-func (a *BucketApiService) BucketServiceGetGroupsExecute(r ApiBucketServiceGetGroupsRequest) (*BucketServiceGetGroupsResponse, *http.Response, error) {
+//	@return BucketServiceGetEmptyBucketStatusResponse
+func (a *BucketApiService) BucketServiceGetEmptyBucketStatusExecute(r ApiBucketServiceGetEmptyBucketStatusRequest) (*BucketServiceGetEmptyBucketStatusResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *BucketServiceGetGroupsResponse
+		localVarReturnValue *BucketServiceGetEmptyBucketStatusResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BucketApiService.BucketServiceGetGroups")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BucketApiService.BucketServiceGetEmptyBucketStatus")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/object/bucket/acl/groups"
+	localVarPath := localBasePath + "/object/bucket/{bucketName}/empty-bucket-status"
+	localVarPath = strings.Replace(localVarPath, "{"+"bucketName"+"}", url.PathEscape(parameterValueToString(r.bucketName, "bucketName")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.namespace != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "namespace", r.namespace, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -3768,25 +3942,25 @@ func (a *BucketApiService) BucketServiceGetGroupsExecute(r ApiBucketServiceGetGr
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiBucketServiceGetPermissionsRequest struct {
+type ApiBucketServiceGetGroupsRequest struct {
 	ctx        context.Context
 	ApiService *BucketApiService
 }
 
-func (r ApiBucketServiceGetPermissionsRequest) Execute() (*BucketServiceGetPermissionsResponse, *http.Response, error) {
-	return r.ApiService.BucketServiceGetPermissionsExecute(r)
+func (r ApiBucketServiceGetGroupsRequest) Execute() (*BucketServiceGetGroupsResponse, *http.Response, error) {
+	return r.ApiService.BucketServiceGetGroupsExecute(r)
 }
 
 /*
-BucketServiceGetPermissions Gets all ACL permissions
+BucketServiceGetGroups Gets all ACL groups
 
-Gets all ACL permissions.
+Gets all ACL groups.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiBucketServiceGetPermissionsRequest
+	@return ApiBucketServiceGetGroupsRequest
 */
-func (a *BucketApiService) BucketServiceGetPermissions(ctx context.Context) ApiBucketServiceGetPermissionsRequest {
-	return ApiBucketServiceGetPermissionsRequest{
+func (a *BucketApiService) BucketServiceGetGroups(ctx context.Context) ApiBucketServiceGetGroupsRequest {
+	return ApiBucketServiceGetGroupsRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -3794,21 +3968,21 @@ func (a *BucketApiService) BucketServiceGetPermissions(ctx context.Context) ApiB
 
 // Execute executes the request
 //
-//	@return BucketServiceGetPermissionsResponse
-func (a *BucketApiService) BucketServiceGetPermissionsExecute(r ApiBucketServiceGetPermissionsRequest) (*BucketServiceGetPermissionsResponse, *http.Response, error) {
+//	@return BucketServiceGetGroupsResponse
+func (a *BucketApiService) BucketServiceGetGroupsExecute(r ApiBucketServiceGetGroupsRequest) (*BucketServiceGetGroupsResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *BucketServiceGetPermissionsResponse
+		localVarReturnValue *BucketServiceGetGroupsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BucketApiService.BucketServiceGetPermissions")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BucketApiService.BucketServiceGetGroups")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/object/bucket/acl/permissions"
+	localVarPath := localBasePath + "/object/bucket/acl/groups"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -3914,54 +4088,525 @@ func (a *BucketApiService) BucketServiceGetPermissionsExecute(r ApiBucketService
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiBucketServiceGetSearchMetaDataRequest struct {
+type ApiBucketServiceGetPermissionsRequest struct {
 	ctx        context.Context
 	ApiService *BucketApiService
 }
 
-func (r ApiBucketServiceGetSearchMetaDataRequest) Execute() (*BucketServiceGetSearchMetaDataResponse, *http.Response, error) {
-	return r.ApiService.BucketServiceGetSearchMetaDataExecute(r)
+func (r ApiBucketServiceGetPermissionsRequest) Execute() (*BucketServiceGetPermissionsResponse, *http.Response, error) {
+	return r.ApiService.BucketServiceGetPermissionsExecute(r)
 }
 
 /*
-BucketServiceGetSearchMetaData Lists the system metadata keys available.
+BucketServiceGetPermissions Gets all ACL permissions
 
-Lists the system metadata keys available.
+Gets all ACL permissions.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiBucketServiceGetSearchMetaDataRequest
+	@return ApiBucketServiceGetPermissionsRequest
 */
-func (a *BucketApiService) BucketServiceGetSearchMetaData(ctx context.Context) ApiBucketServiceGetSearchMetaDataRequest {
-	return ApiBucketServiceGetSearchMetaDataRequest{
+func (a *BucketApiService) BucketServiceGetPermissions(ctx context.Context) ApiBucketServiceGetPermissionsRequest {
+	return ApiBucketServiceGetPermissionsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return BucketServiceGetPermissionsResponse
+func (a *BucketApiService) BucketServiceGetPermissionsExecute(r ApiBucketServiceGetPermissionsRequest) (*BucketServiceGetPermissionsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *BucketServiceGetPermissionsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BucketApiService.BucketServiceGetPermissions")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/object/bucket/acl/permissions"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["AuthToken"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["X-SDS-AUTH-TOKEN"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiBucketServiceGetSearchMetaDataRequest struct {
+	ctx        context.Context
+	ApiService *BucketApiService
+}
+
+func (r ApiBucketServiceGetSearchMetaDataRequest) Execute() (*BucketServiceGetSearchMetaDataResponse, *http.Response, error) {
+	return r.ApiService.BucketServiceGetSearchMetaDataExecute(r)
+}
+
+/*
+BucketServiceGetSearchMetaData Lists the system metadata keys available.
+
+Lists the system metadata keys available.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiBucketServiceGetSearchMetaDataRequest
+*/
+func (a *BucketApiService) BucketServiceGetSearchMetaData(ctx context.Context) ApiBucketServiceGetSearchMetaDataRequest {
+	return ApiBucketServiceGetSearchMetaDataRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return BucketServiceGetSearchMetaDataResponse
+func (a *BucketApiService) BucketServiceGetSearchMetaDataExecute(r ApiBucketServiceGetSearchMetaDataRequest) (*BucketServiceGetSearchMetaDataResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *BucketServiceGetSearchMetaDataResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BucketApiService.BucketServiceGetSearchMetaData")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/object/bucket/searchmetadata"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["AuthToken"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["X-SDS-AUTH-TOKEN"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiBucketServiceListCopyPoliciesRequest struct {
+	ctx        context.Context
+	ApiService *BucketApiService
+	account    *string
+}
+
+// Namespace for which DM policies should be listed.
+func (r ApiBucketServiceListCopyPoliciesRequest) Account(account string) ApiBucketServiceListCopyPoliciesRequest {
+	r.account = &account
+	return r
+}
+
+func (r ApiBucketServiceListCopyPoliciesRequest) Execute() (*BucketServiceListCopyPoliciesResponse, *http.Response, error) {
+	return r.ApiService.BucketServiceListCopyPoliciesExecute(r)
+}
+
+/*
+BucketServiceListCopyPolicies Returns a list of all DM policies for the specified namespace
+
+Returns a list of all DM policies for the specified namespace.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiBucketServiceListCopyPoliciesRequest
+*/
+func (a *BucketApiService) BucketServiceListCopyPolicies(ctx context.Context) ApiBucketServiceListCopyPoliciesRequest {
+	return ApiBucketServiceListCopyPoliciesRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return BucketServiceListCopyPoliciesResponse
+func (a *BucketApiService) BucketServiceListCopyPoliciesExecute(r ApiBucketServiceListCopyPoliciesRequest) (*BucketServiceListCopyPoliciesResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *BucketServiceListCopyPoliciesResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BucketApiService.BucketServiceListCopyPolicies")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/object/bucket/copypolicy"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.account != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "account", r.account, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["AuthToken"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["X-SDS-AUTH-TOKEN"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiBucketServicePostCopyPolicyRequest struct {
+	ctx                                context.Context
+	ApiService                         *BucketApiService
+	bucketName                         string
+	account                            *string
+	bucketServicePostCopyPolicyRequest *BucketServicePostCopyPolicyRequest
+}
+
+func (r ApiBucketServicePostCopyPolicyRequest) BucketServicePostCopyPolicyRequest(bucketServicePostCopyPolicyRequest BucketServicePostCopyPolicyRequest) ApiBucketServicePostCopyPolicyRequest {
+	r.bucketServicePostCopyPolicyRequest = &bucketServicePostCopyPolicyRequest
+	return r
+}
+
+// Namespace for which data movement policy should be set.
+func (r ApiBucketServicePostCopyPolicyRequest) Account(account string) ApiBucketServicePostCopyPolicyRequest {
+	r.account = &account
+	return r
+}
+
+func (r ApiBucketServicePostCopyPolicyRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.BucketServicePostCopyPolicyExecute(r)
+}
+
+/*
+BucketServicePostCopyPolicy Set a data movement policy for the specified bucket
+
+Set a data movement policy for the specified bucket.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param bucketName Bucket name for which data movement policy should be set.
+	@return ApiBucketServicePostCopyPolicyRequest
+*/
+func (a *BucketApiService) BucketServicePostCopyPolicy(ctx context.Context, bucketName string) ApiBucketServicePostCopyPolicyRequest {
+	return ApiBucketServicePostCopyPolicyRequest{
 		ApiService: a,
 		ctx:        ctx,
+		bucketName: bucketName,
 	}
 }
 
 // Execute executes the request
 //
-//	@return BucketServiceGetSearchMetaDataResponse
-func (a *BucketApiService) BucketServiceGetSearchMetaDataExecute(r ApiBucketServiceGetSearchMetaDataRequest) (*BucketServiceGetSearchMetaDataResponse, *http.Response, error) {
+//	@return map[string]interface{}
+func (a *BucketApiService) BucketServicePostCopyPolicyExecute(r ApiBucketServicePostCopyPolicyRequest) (map[string]interface{}, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
+		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *BucketServiceGetSearchMetaDataResponse
+		localVarReturnValue map[string]interface{}
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BucketApiService.BucketServiceGetSearchMetaData")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BucketApiService.BucketServicePostCopyPolicy")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/object/bucket/searchmetadata"
+	localVarPath := localBasePath + "/object/bucket/{bucketName}/copypolicy"
+	localVarPath = strings.Replace(localVarPath, "{"+"bucketName"+"}", url.PathEscape(parameterValueToString(r.bucketName, "bucketName")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.bucketServicePostCopyPolicyRequest == nil {
+		return localVarReturnValue, nil, reportError("bucketServicePostCopyPolicyRequest is required and must be specified")
+	}
 
+	if r.account != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "account", r.account, "")
+	}
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -3977,6 +4622,8 @@ func (a *BucketApiService) BucketServiceGetSearchMetaDataExecute(r ApiBucketServ
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.bucketServicePostCopyPolicyRequest
 	if r.ctx != nil {
 		// API Key Authentication
 		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
@@ -4412,6 +5059,177 @@ func (a *BucketApiService) BucketServicePutBucketNotificationConfigExecute(r Api
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiBucketServicePutCopyPolicyRequest struct {
+	ctx                               context.Context
+	ApiService                        *BucketApiService
+	bucketName                        string
+	account                           *string
+	bucketServicePutCopyPolicyRequest *BucketServicePutCopyPolicyRequest
+}
+
+func (r ApiBucketServicePutCopyPolicyRequest) BucketServicePutCopyPolicyRequest(bucketServicePutCopyPolicyRequest BucketServicePutCopyPolicyRequest) ApiBucketServicePutCopyPolicyRequest {
+	r.bucketServicePutCopyPolicyRequest = &bucketServicePutCopyPolicyRequest
+	return r
+}
+
+// Namespace for which DM policies should be updated.
+func (r ApiBucketServicePutCopyPolicyRequest) Account(account string) ApiBucketServicePutCopyPolicyRequest {
+	r.account = &account
+	return r
+}
+
+func (r ApiBucketServicePutCopyPolicyRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.BucketServicePutCopyPolicyExecute(r)
+}
+
+/*
+BucketServicePutCopyPolicy Updates DM policy for the specified bucket
+
+Updates DM policy for the specified bucket.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param bucketName Bucket name for which DM policy should be updated.
+	@return ApiBucketServicePutCopyPolicyRequest
+*/
+func (a *BucketApiService) BucketServicePutCopyPolicy(ctx context.Context, bucketName string) ApiBucketServicePutCopyPolicyRequest {
+	return ApiBucketServicePutCopyPolicyRequest{
+		ApiService: a,
+		ctx:        ctx,
+		bucketName: bucketName,
+	}
+}
+
+// Execute executes the request
+//
+//	@return map[string]interface{}
+func (a *BucketApiService) BucketServicePutCopyPolicyExecute(r ApiBucketServicePutCopyPolicyRequest) (map[string]interface{}, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPut
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue map[string]interface{}
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BucketApiService.BucketServicePutCopyPolicy")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/object/bucket/{bucketName}/copypolicy"
+	localVarPath = strings.Replace(localVarPath, "{"+"bucketName"+"}", url.PathEscape(parameterValueToString(r.bucketName, "bucketName")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.bucketServicePutCopyPolicyRequest == nil {
+		return localVarReturnValue, nil, reportError("bucketServicePutCopyPolicyRequest is required and must be specified")
+	}
+
+	if r.account != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "account", r.account, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.bucketServicePutCopyPolicyRequest
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["AuthToken"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["X-SDS-AUTH-TOKEN"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiBucketServiceRemoveBucketQuotaRequest struct {
 	ctx        context.Context
 	ApiService *BucketApiService
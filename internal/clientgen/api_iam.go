@@ -4626,53 +4626,39 @@ func (a *IamApiService) IamServiceDetachUserPolicyExecute(r ApiIamServiceDetachU
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceGetGroupRequest struct {
-	ctx           context.Context
-	ApiService    *IamApiService
-	groupName     *string
-	marker        *string
-	maxItems      *int32
-	xEmcNamespace *string
-}
-
-// The name of the group.
-func (r ApiIamServiceGetGroupRequest) GroupName(groupName string) ApiIamServiceGetGroupRequest {
-	r.groupName = &groupName
-	return r
-}
-
-// Marker is obtained from paginated response from the previous query. Use this only if the response indicates it is truncated.
-func (r ApiIamServiceGetGroupRequest) Marker(marker string) ApiIamServiceGetGroupRequest {
-	r.marker = &marker
-	return r
+type ApiIamServiceGetContextKeysForCustomPolicyRequest struct {
+	ctx                    context.Context
+	ApiService             *IamApiService
+	policyInputListMemberN *[]string
+	xEmcNamespace          *string
 }
 
-// Indicates the maximum number of elements to be returned in the response.
-func (r ApiIamServiceGetGroupRequest) MaxItems(maxItems int32) ApiIamServiceGetGroupRequest {
-	r.maxItems = &maxItems
+// A list of policies for which you want the list of context keys referenced in those policies.
+func (r ApiIamServiceGetContextKeysForCustomPolicyRequest) PolicyInputListMemberN(policyInputListMemberN []string) ApiIamServiceGetContextKeysForCustomPolicyRequest {
+	r.policyInputListMemberN = &policyInputListMemberN
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceGetGroupRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetGroupRequest {
+func (r ApiIamServiceGetContextKeysForCustomPolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetContextKeysForCustomPolicyRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceGetGroupRequest) Execute() (*IamServiceGetGroupResponse, *http.Response, error) {
-	return r.ApiService.IamServiceGetGroupExecute(r)
+func (r ApiIamServiceGetContextKeysForCustomPolicyRequest) Execute() (*IamServiceGetContextKeysForCustomPolicyResponse, *http.Response, error) {
+	return r.ApiService.IamServiceGetContextKeysForCustomPolicyExecute(r)
 }
 
 /*
-IamServiceGetGroup Retrieve list of users in IAM group.
+IamServiceGetContextKeysForCustomPolicy Get list of all Context keys in the given policies.
 
-Retrieve list of users in IAM group.
+Get list of all Context keys in the given policies.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceGetGroupRequest
+	@return ApiIamServiceGetContextKeysForCustomPolicyRequest
 */
-func (a *IamApiService) IamServiceGetGroup(ctx context.Context) ApiIamServiceGetGroupRequest {
-	return ApiIamServiceGetGroupRequest{
+func (a *IamApiService) IamServiceGetContextKeysForCustomPolicy(ctx context.Context) ApiIamServiceGetContextKeysForCustomPolicyRequest {
+	return ApiIamServiceGetContextKeysForCustomPolicyRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -4680,34 +4666,30 @@ func (a *IamApiService) IamServiceGetGroup(ctx context.Context) ApiIamServiceGet
 
 // Execute executes the request
 //
-//	@return IamServiceGetGroupResponse
-func (a *IamApiService) IamServiceGetGroupExecute(r ApiIamServiceGetGroupRequest) (*IamServiceGetGroupResponse, *http.Response, error) {
+//	@return IamServiceGetContextKeysForCustomPolicyResponse
+func (a *IamApiService) IamServiceGetContextKeysForCustomPolicyExecute(r ApiIamServiceGetContextKeysForCustomPolicyRequest) (*IamServiceGetContextKeysForCustomPolicyResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceGetGroupResponse
+		localVarReturnValue *IamServiceGetContextKeysForCustomPolicyResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetGroup")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetContextKeysForCustomPolicy")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=GetGroup"
+	localVarPath := localBasePath + "/iam?Action=GetContextKeysForCustomPolicy"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.groupName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "GroupName", r.groupName, "")
-	}
-	if r.marker != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "Marker", r.marker, "")
-	}
-	if r.maxItems != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
+	if r.policyInputListMemberN != nil {
+		for i, item := range *r.policyInputListMemberN {
+			parameterAddToHeaderOrQuery(localVarQueryParams, fmt.Sprintf("PolicyInputList.member.%d", i+1), item, "")
+		}
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -4812,46 +4794,46 @@ func (a *IamApiService) IamServiceGetGroupExecute(r ApiIamServiceGetGroupRequest
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceGetGroupPolicyRequest struct {
-	ctx           context.Context
-	ApiService    *IamApiService
-	groupName     *string
-	policyName    *string
-	xEmcNamespace *string
+type ApiIamServiceGetContextKeysForPrincipalPolicyRequest struct {
+	ctx                    context.Context
+	ApiService             *IamApiService
+	policySourceArn        *string
+	policyInputListMemberN *[]string
+	xEmcNamespace          *string
 }
 
-// Name of the group to retrieve the inline policy.
-func (r ApiIamServiceGetGroupPolicyRequest) GroupName(groupName string) ApiIamServiceGetGroupPolicyRequest {
-	r.groupName = &groupName
+// The ARN of a user, group, or role whose policies contain the context keys that you want listed. If you specify  a user, the list includes context keys that are found in all policies that are attached to the user.  The list also includes all groups that the user is a member of.
+func (r ApiIamServiceGetContextKeysForPrincipalPolicyRequest) PolicySourceArn(policySourceArn string) ApiIamServiceGetContextKeysForPrincipalPolicyRequest {
+	r.policySourceArn = &policySourceArn
 	return r
 }
 
-// Name of the policy whose Policy Document needs to be retrieved.
-func (r ApiIamServiceGetGroupPolicyRequest) PolicyName(policyName string) ApiIamServiceGetGroupPolicyRequest {
-	r.policyName = &policyName
+// A list of policies for which you want the list of context keys referenced in those policies.
+func (r ApiIamServiceGetContextKeysForPrincipalPolicyRequest) PolicyInputListMemberN(policyInputListMemberN []string) ApiIamServiceGetContextKeysForPrincipalPolicyRequest {
+	r.policyInputListMemberN = &policyInputListMemberN
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceGetGroupPolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetGroupPolicyRequest {
+func (r ApiIamServiceGetContextKeysForPrincipalPolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetContextKeysForPrincipalPolicyRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceGetGroupPolicyRequest) Execute() (*IamServiceGetGroupPolicyResponse, *http.Response, error) {
-	return r.ApiService.IamServiceGetGroupPolicyExecute(r)
+func (r ApiIamServiceGetContextKeysForPrincipalPolicyRequest) Execute() (*IamServiceGetContextKeysForPrincipalPolicyResponse, *http.Response, error) {
+	return r.ApiService.IamServiceGetContextKeysForPrincipalPolicyExecute(r)
 }
 
 /*
-IamServiceGetGroupPolicy Get specific inlinePolicy for IAM Group.
+IamServiceGetContextKeysForPrincipalPolicy Get list of all Context keys in policies attached to the entity.
 
-Get specific inlinePolicy for IAM Group.
+Get list of all Context keys in policies attached to the entity.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceGetGroupPolicyRequest
+	@return ApiIamServiceGetContextKeysForPrincipalPolicyRequest
 */
-func (a *IamApiService) IamServiceGetGroupPolicy(ctx context.Context) ApiIamServiceGetGroupPolicyRequest {
-	return ApiIamServiceGetGroupPolicyRequest{
+func (a *IamApiService) IamServiceGetContextKeysForPrincipalPolicy(ctx context.Context) ApiIamServiceGetContextKeysForPrincipalPolicyRequest {
+	return ApiIamServiceGetContextKeysForPrincipalPolicyRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -4859,31 +4841,33 @@ func (a *IamApiService) IamServiceGetGroupPolicy(ctx context.Context) ApiIamServ
 
 // Execute executes the request
 //
-//	@return IamServiceGetGroupPolicyResponse
-func (a *IamApiService) IamServiceGetGroupPolicyExecute(r ApiIamServiceGetGroupPolicyRequest) (*IamServiceGetGroupPolicyResponse, *http.Response, error) {
+//	@return IamServiceGetContextKeysForPrincipalPolicyResponse
+func (a *IamApiService) IamServiceGetContextKeysForPrincipalPolicyExecute(r ApiIamServiceGetContextKeysForPrincipalPolicyRequest) (*IamServiceGetContextKeysForPrincipalPolicyResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceGetGroupPolicyResponse
+		localVarReturnValue *IamServiceGetContextKeysForPrincipalPolicyResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetGroupPolicy")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetContextKeysForPrincipalPolicy")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=GetGroupPolicy"
+	localVarPath := localBasePath + "/iam?Action=GetContextKeysForPrincipalPolicy"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.groupName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "GroupName", r.groupName, "")
+	if r.policySourceArn != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicySourceArn", r.policySourceArn, "")
 	}
-	if r.policyName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyName", r.policyName, "")
+	if r.policyInputListMemberN != nil {
+		for i, item := range *r.policyInputListMemberN {
+			parameterAddToHeaderOrQuery(localVarQueryParams, fmt.Sprintf("PolicyInputList.member.%d", i+1), item, "")
+		}
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -4988,39 +4972,53 @@ func (a *IamApiService) IamServiceGetGroupPolicyExecute(r ApiIamServiceGetGroupP
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceGetPolicyRequest struct {
+type ApiIamServiceGetGroupRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
-	policyArn     *string
+	groupName     *string
+	marker        *string
+	maxItems      *int32
 	xEmcNamespace *string
 }
 
-// Arn of the policy to retrieve.
-func (r ApiIamServiceGetPolicyRequest) PolicyArn(policyArn string) ApiIamServiceGetPolicyRequest {
-	r.policyArn = &policyArn
+// The name of the group.
+func (r ApiIamServiceGetGroupRequest) GroupName(groupName string) ApiIamServiceGetGroupRequest {
+	r.groupName = &groupName
+	return r
+}
+
+// Marker is obtained from paginated response from the previous query. Use this only if the response indicates it is truncated.
+func (r ApiIamServiceGetGroupRequest) Marker(marker string) ApiIamServiceGetGroupRequest {
+	r.marker = &marker
+	return r
+}
+
+// Indicates the maximum number of elements to be returned in the response.
+func (r ApiIamServiceGetGroupRequest) MaxItems(maxItems int32) ApiIamServiceGetGroupRequest {
+	r.maxItems = &maxItems
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceGetPolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetPolicyRequest {
+func (r ApiIamServiceGetGroupRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetGroupRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceGetPolicyRequest) Execute() (*IamServiceGetPolicyResponse, *http.Response, error) {
-	return r.ApiService.IamServiceGetPolicyExecute(r)
+func (r ApiIamServiceGetGroupRequest) Execute() (*IamServiceGetGroupResponse, *http.Response, error) {
+	return r.ApiService.IamServiceGetGroupExecute(r)
 }
 
 /*
-IamServiceGetPolicy Retrieve Managed Policy
+IamServiceGetGroup Retrieve list of users in IAM group.
 
-Retrieve Managed Policy
+Retrieve list of users in IAM group.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceGetPolicyRequest
+	@return ApiIamServiceGetGroupRequest
 */
-func (a *IamApiService) IamServiceGetPolicy(ctx context.Context) ApiIamServiceGetPolicyRequest {
-	return ApiIamServiceGetPolicyRequest{
+func (a *IamApiService) IamServiceGetGroup(ctx context.Context) ApiIamServiceGetGroupRequest {
+	return ApiIamServiceGetGroupRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -5028,28 +5026,34 @@ func (a *IamApiService) IamServiceGetPolicy(ctx context.Context) ApiIamServiceGe
 
 // Execute executes the request
 //
-//	@return IamServiceGetPolicyResponse
-func (a *IamApiService) IamServiceGetPolicyExecute(r ApiIamServiceGetPolicyRequest) (*IamServiceGetPolicyResponse, *http.Response, error) {
+//	@return IamServiceGetGroupResponse
+func (a *IamApiService) IamServiceGetGroupExecute(r ApiIamServiceGetGroupRequest) (*IamServiceGetGroupResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceGetPolicyResponse
+		localVarReturnValue *IamServiceGetGroupResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetPolicy")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetGroup")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=GetPolicy"
+	localVarPath := localBasePath + "/iam?Action=GetGroup"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.policyArn != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyArn", r.policyArn, "")
+	if r.groupName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "GroupName", r.groupName, "")
+	}
+	if r.marker != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "Marker", r.marker, "")
+	}
+	if r.maxItems != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -5154,46 +5158,46 @@ func (a *IamApiService) IamServiceGetPolicyExecute(r ApiIamServiceGetPolicyReque
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceGetPolicyVersionRequest struct {
+type ApiIamServiceGetGroupPolicyRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
-	policyArn     *string
-	versionId     *string
+	groupName     *string
+	policyName    *string
 	xEmcNamespace *string
 }
 
-// ARN of the IAM Managed policy to retrieve.
-func (r ApiIamServiceGetPolicyVersionRequest) PolicyArn(policyArn string) ApiIamServiceGetPolicyVersionRequest {
-	r.policyArn = &policyArn
+// Name of the group to retrieve the inline policy.
+func (r ApiIamServiceGetGroupPolicyRequest) GroupName(groupName string) ApiIamServiceGetGroupPolicyRequest {
+	r.groupName = &groupName
 	return r
 }
 
-// Policy Version to retrieve.
-func (r ApiIamServiceGetPolicyVersionRequest) VersionId(versionId string) ApiIamServiceGetPolicyVersionRequest {
-	r.versionId = &versionId
+// Name of the policy whose Policy Document needs to be retrieved.
+func (r ApiIamServiceGetGroupPolicyRequest) PolicyName(policyName string) ApiIamServiceGetGroupPolicyRequest {
+	r.policyName = &policyName
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceGetPolicyVersionRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetPolicyVersionRequest {
+func (r ApiIamServiceGetGroupPolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetGroupPolicyRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceGetPolicyVersionRequest) Execute() (*IamServiceGetPolicyVersionResponse, *http.Response, error) {
-	return r.ApiService.IamServiceGetPolicyVersionExecute(r)
+func (r ApiIamServiceGetGroupPolicyRequest) Execute() (*IamServiceGetGroupPolicyResponse, *http.Response, error) {
+	return r.ApiService.IamServiceGetGroupPolicyExecute(r)
 }
 
 /*
-IamServiceGetPolicyVersion Retrieve version of Managed Policy.
+IamServiceGetGroupPolicy Get specific inlinePolicy for IAM Group.
 
-Retrieve version of Managed Policy.
+Get specific inlinePolicy for IAM Group.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceGetPolicyVersionRequest
+	@return ApiIamServiceGetGroupPolicyRequest
 */
-func (a *IamApiService) IamServiceGetPolicyVersion(ctx context.Context) ApiIamServiceGetPolicyVersionRequest {
-	return ApiIamServiceGetPolicyVersionRequest{
+func (a *IamApiService) IamServiceGetGroupPolicy(ctx context.Context) ApiIamServiceGetGroupPolicyRequest {
+	return ApiIamServiceGetGroupPolicyRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -5201,31 +5205,31 @@ func (a *IamApiService) IamServiceGetPolicyVersion(ctx context.Context) ApiIamSe
 
 // Execute executes the request
 //
-//	@return IamServiceGetPolicyVersionResponse
-func (a *IamApiService) IamServiceGetPolicyVersionExecute(r ApiIamServiceGetPolicyVersionRequest) (*IamServiceGetPolicyVersionResponse, *http.Response, error) {
+//	@return IamServiceGetGroupPolicyResponse
+func (a *IamApiService) IamServiceGetGroupPolicyExecute(r ApiIamServiceGetGroupPolicyRequest) (*IamServiceGetGroupPolicyResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceGetPolicyVersionResponse
+		localVarReturnValue *IamServiceGetGroupPolicyResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetPolicyVersion")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetGroupPolicy")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=GetPolicyVersion"
+	localVarPath := localBasePath + "/iam?Action=GetGroupPolicy"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.policyArn != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyArn", r.policyArn, "")
+	if r.groupName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "GroupName", r.groupName, "")
 	}
-	if r.versionId != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "VersionId", r.versionId, "")
+	if r.policyName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyName", r.policyName, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -5330,39 +5334,39 @@ func (a *IamApiService) IamServiceGetPolicyVersionExecute(r ApiIamServiceGetPoli
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceGetRoleRequest struct {
+type ApiIamServiceGetPolicyRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
-	roleName      *string
+	policyArn     *string
 	xEmcNamespace *string
 }
 
-// Simple name identifying the role.
-func (r ApiIamServiceGetRoleRequest) RoleName(roleName string) ApiIamServiceGetRoleRequest {
-	r.roleName = &roleName
+// Arn of the policy to retrieve.
+func (r ApiIamServiceGetPolicyRequest) PolicyArn(policyArn string) ApiIamServiceGetPolicyRequest {
+	r.policyArn = &policyArn
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceGetRoleRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetRoleRequest {
+func (r ApiIamServiceGetPolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetPolicyRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceGetRoleRequest) Execute() (*IamServiceGetRoleResponse, *http.Response, error) {
-	return r.ApiService.IamServiceGetRoleExecute(r)
+func (r ApiIamServiceGetPolicyRequest) Execute() (*IamServiceGetPolicyResponse, *http.Response, error) {
+	return r.ApiService.IamServiceGetPolicyExecute(r)
 }
 
 /*
-IamServiceGetRole Gets information about the specified IAM role.
+IamServiceGetPolicy Retrieve Managed Policy
 
-Gets information about the specified IAM role.
+Retrieve Managed Policy
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceGetRoleRequest
+	@return ApiIamServiceGetPolicyRequest
 */
-func (a *IamApiService) IamServiceGetRole(ctx context.Context) ApiIamServiceGetRoleRequest {
-	return ApiIamServiceGetRoleRequest{
+func (a *IamApiService) IamServiceGetPolicy(ctx context.Context) ApiIamServiceGetPolicyRequest {
+	return ApiIamServiceGetPolicyRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -5370,28 +5374,28 @@ func (a *IamApiService) IamServiceGetRole(ctx context.Context) ApiIamServiceGetR
 
 // Execute executes the request
 //
-//	@return IamServiceGetRoleResponse
-func (a *IamApiService) IamServiceGetRoleExecute(r ApiIamServiceGetRoleRequest) (*IamServiceGetRoleResponse, *http.Response, error) {
+//	@return IamServiceGetPolicyResponse
+func (a *IamApiService) IamServiceGetPolicyExecute(r ApiIamServiceGetPolicyRequest) (*IamServiceGetPolicyResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceGetRoleResponse
+		localVarReturnValue *IamServiceGetPolicyResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetRole")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetPolicy")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=GetRole"
+	localVarPath := localBasePath + "/iam?Action=GetPolicy"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.roleName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "RoleName", r.roleName, "")
+	if r.policyArn != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyArn", r.policyArn, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -5496,46 +5500,46 @@ func (a *IamApiService) IamServiceGetRoleExecute(r ApiIamServiceGetRoleRequest)
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceGetRolePolicyRequest struct {
+type ApiIamServiceGetPolicyVersionRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
-	roleName      *string
-	policyName    *string
+	policyArn     *string
+	versionId     *string
 	xEmcNamespace *string
 }
 
-// Simple name identifying the role.
-func (r ApiIamServiceGetRolePolicyRequest) RoleName(roleName string) ApiIamServiceGetRolePolicyRequest {
-	r.roleName = &roleName
+// ARN of the IAM Managed policy to retrieve.
+func (r ApiIamServiceGetPolicyVersionRequest) PolicyArn(policyArn string) ApiIamServiceGetPolicyVersionRequest {
+	r.policyArn = &policyArn
 	return r
 }
 
-// Simple name identifying the policy.
-func (r ApiIamServiceGetRolePolicyRequest) PolicyName(policyName string) ApiIamServiceGetRolePolicyRequest {
-	r.policyName = &policyName
+// Policy Version to retrieve.
+func (r ApiIamServiceGetPolicyVersionRequest) VersionId(versionId string) ApiIamServiceGetPolicyVersionRequest {
+	r.versionId = &versionId
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceGetRolePolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetRolePolicyRequest {
+func (r ApiIamServiceGetPolicyVersionRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetPolicyVersionRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceGetRolePolicyRequest) Execute() (*IamServiceGetRolePolicyResponse, *http.Response, error) {
-	return r.ApiService.IamServiceGetRolePolicyExecute(r)
+func (r ApiIamServiceGetPolicyVersionRequest) Execute() (*IamServiceGetPolicyVersionResponse, *http.Response, error) {
+	return r.ApiService.IamServiceGetPolicyVersionExecute(r)
 }
 
 /*
-IamServiceGetRolePolicy Gets tthe specified inline policy document that is embedded with the specified IAM role.
+IamServiceGetPolicyVersion Retrieve version of Managed Policy.
 
-Gets tthe specified inline policy document that is embedded with the specified IAM role.
+Retrieve version of Managed Policy.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceGetRolePolicyRequest
+	@return ApiIamServiceGetPolicyVersionRequest
 */
-func (a *IamApiService) IamServiceGetRolePolicy(ctx context.Context) ApiIamServiceGetRolePolicyRequest {
-	return ApiIamServiceGetRolePolicyRequest{
+func (a *IamApiService) IamServiceGetPolicyVersion(ctx context.Context) ApiIamServiceGetPolicyVersionRequest {
+	return ApiIamServiceGetPolicyVersionRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -5543,31 +5547,31 @@ func (a *IamApiService) IamServiceGetRolePolicy(ctx context.Context) ApiIamServi
 
 // Execute executes the request
 //
-//	@return IamServiceGetRolePolicyResponse
-func (a *IamApiService) IamServiceGetRolePolicyExecute(r ApiIamServiceGetRolePolicyRequest) (*IamServiceGetRolePolicyResponse, *http.Response, error) {
+//	@return IamServiceGetPolicyVersionResponse
+func (a *IamApiService) IamServiceGetPolicyVersionExecute(r ApiIamServiceGetPolicyVersionRequest) (*IamServiceGetPolicyVersionResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceGetRolePolicyResponse
+		localVarReturnValue *IamServiceGetPolicyVersionResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetRolePolicy")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetPolicyVersion")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=GetRolePolicy"
+	localVarPath := localBasePath + "/iam?Action=GetPolicyVersion"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.roleName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "RoleName", r.roleName, "")
+	if r.policyArn != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyArn", r.policyArn, "")
 	}
-	if r.policyName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyName", r.policyName, "")
+	if r.versionId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "VersionId", r.versionId, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -5672,39 +5676,39 @@ func (a *IamApiService) IamServiceGetRolePolicyExecute(r ApiIamServiceGetRolePol
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceGetSAMLProviderRequest struct {
-	ctx             context.Context
-	ApiService      *IamApiService
-	sAMLProviderArn *string
-	xEmcNamespace   *string
+type ApiIamServiceGetRoleRequest struct {
+	ctx           context.Context
+	ApiService    *IamApiService
+	roleName      *string
+	xEmcNamespace *string
 }
 
-// The name of the provider to retrieve.
-func (r ApiIamServiceGetSAMLProviderRequest) SAMLProviderArn(sAMLProviderArn string) ApiIamServiceGetSAMLProviderRequest {
-	r.sAMLProviderArn = &sAMLProviderArn
+// Simple name identifying the role.
+func (r ApiIamServiceGetRoleRequest) RoleName(roleName string) ApiIamServiceGetRoleRequest {
+	r.roleName = &roleName
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceGetSAMLProviderRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetSAMLProviderRequest {
+func (r ApiIamServiceGetRoleRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetRoleRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceGetSAMLProviderRequest) Execute() (*IamServiceGetSAMLProviderResponse, *http.Response, error) {
-	return r.ApiService.IamServiceGetSAMLProviderExecute(r)
+func (r ApiIamServiceGetRoleRequest) Execute() (*IamServiceGetRoleResponse, *http.Response, error) {
+	return r.ApiService.IamServiceGetRoleExecute(r)
 }
 
 /*
-IamServiceGetSAMLProvider Retrieve the SAML IdP document.
+IamServiceGetRole Gets information about the specified IAM role.
 
-Retrieve the SAML IdP document.
+Gets information about the specified IAM role.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceGetSAMLProviderRequest
+	@return ApiIamServiceGetRoleRequest
 */
-func (a *IamApiService) IamServiceGetSAMLProvider(ctx context.Context) ApiIamServiceGetSAMLProviderRequest {
-	return ApiIamServiceGetSAMLProviderRequest{
+func (a *IamApiService) IamServiceGetRole(ctx context.Context) ApiIamServiceGetRoleRequest {
+	return ApiIamServiceGetRoleRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -5712,28 +5716,28 @@ func (a *IamApiService) IamServiceGetSAMLProvider(ctx context.Context) ApiIamSer
 
 // Execute executes the request
 //
-//	@return IamServiceGetSAMLProviderResponse
-func (a *IamApiService) IamServiceGetSAMLProviderExecute(r ApiIamServiceGetSAMLProviderRequest) (*IamServiceGetSAMLProviderResponse, *http.Response, error) {
+//	@return IamServiceGetRoleResponse
+func (a *IamApiService) IamServiceGetRoleExecute(r ApiIamServiceGetRoleRequest) (*IamServiceGetRoleResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceGetSAMLProviderResponse
+		localVarReturnValue *IamServiceGetRoleResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetSAMLProvider")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetRole")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=GetSAMLProvider"
+	localVarPath := localBasePath + "/iam?Action=GetRole"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.sAMLProviderArn != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "SAMLProviderArn", r.sAMLProviderArn, "")
+	if r.roleName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "RoleName", r.roleName, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -5838,39 +5842,46 @@ func (a *IamApiService) IamServiceGetSAMLProviderExecute(r ApiIamServiceGetSAMLP
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceGetUserRequest struct {
+type ApiIamServiceGetRolePolicyRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
-	userName      *string
+	roleName      *string
+	policyName    *string
 	xEmcNamespace *string
 }
 
-// The name of the user to retrieve.
-func (r ApiIamServiceGetUserRequest) UserName(userName string) ApiIamServiceGetUserRequest {
-	r.userName = &userName
+// Simple name identifying the role.
+func (r ApiIamServiceGetRolePolicyRequest) RoleName(roleName string) ApiIamServiceGetRolePolicyRequest {
+	r.roleName = &roleName
+	return r
+}
+
+// Simple name identifying the policy.
+func (r ApiIamServiceGetRolePolicyRequest) PolicyName(policyName string) ApiIamServiceGetRolePolicyRequest {
+	r.policyName = &policyName
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceGetUserRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetUserRequest {
+func (r ApiIamServiceGetRolePolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetRolePolicyRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceGetUserRequest) Execute() (*IamServiceGetUserResponse, *http.Response, error) {
-	return r.ApiService.IamServiceGetUserExecute(r)
+func (r ApiIamServiceGetRolePolicyRequest) Execute() (*IamServiceGetRolePolicyResponse, *http.Response, error) {
+	return r.ApiService.IamServiceGetRolePolicyExecute(r)
 }
 
 /*
-IamServiceGetUser Retrieve IAM user.
+IamServiceGetRolePolicy Gets tthe specified inline policy document that is embedded with the specified IAM role.
 
-Retrieve IAM user.
+Gets tthe specified inline policy document that is embedded with the specified IAM role.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceGetUserRequest
+	@return ApiIamServiceGetRolePolicyRequest
 */
-func (a *IamApiService) IamServiceGetUser(ctx context.Context) ApiIamServiceGetUserRequest {
-	return ApiIamServiceGetUserRequest{
+func (a *IamApiService) IamServiceGetRolePolicy(ctx context.Context) ApiIamServiceGetRolePolicyRequest {
+	return ApiIamServiceGetRolePolicyRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -5878,28 +5889,31 @@ func (a *IamApiService) IamServiceGetUser(ctx context.Context) ApiIamServiceGetU
 
 // Execute executes the request
 //
-//	@return IamServiceGetUserResponse
-func (a *IamApiService) IamServiceGetUserExecute(r ApiIamServiceGetUserRequest) (*IamServiceGetUserResponse, *http.Response, error) {
+//	@return IamServiceGetRolePolicyResponse
+func (a *IamApiService) IamServiceGetRolePolicyExecute(r ApiIamServiceGetRolePolicyRequest) (*IamServiceGetRolePolicyResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceGetUserResponse
+		localVarReturnValue *IamServiceGetRolePolicyResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetUser")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetRolePolicy")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=GetUser"
+	localVarPath := localBasePath + "/iam?Action=GetRolePolicy"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.userName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
+	if r.roleName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "RoleName", r.roleName, "")
+	}
+	if r.policyName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyName", r.policyName, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -6004,46 +6018,39 @@ func (a *IamApiService) IamServiceGetUserExecute(r ApiIamServiceGetUserRequest)
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceGetUserPolicyRequest struct {
-	ctx           context.Context
-	ApiService    *IamApiService
-	userName      *string
-	policyName    *string
-	xEmcNamespace *string
-}
-
-// Name of the user to retrieve the inline policy.
-func (r ApiIamServiceGetUserPolicyRequest) UserName(userName string) ApiIamServiceGetUserPolicyRequest {
-	r.userName = &userName
-	return r
+type ApiIamServiceGetSAMLProviderRequest struct {
+	ctx             context.Context
+	ApiService      *IamApiService
+	sAMLProviderArn *string
+	xEmcNamespace   *string
 }
 
-// Name of the policy whose Policy Document needs to be retrieved.
-func (r ApiIamServiceGetUserPolicyRequest) PolicyName(policyName string) ApiIamServiceGetUserPolicyRequest {
-	r.policyName = &policyName
+// The name of the provider to retrieve.
+func (r ApiIamServiceGetSAMLProviderRequest) SAMLProviderArn(sAMLProviderArn string) ApiIamServiceGetSAMLProviderRequest {
+	r.sAMLProviderArn = &sAMLProviderArn
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceGetUserPolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetUserPolicyRequest {
+func (r ApiIamServiceGetSAMLProviderRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetSAMLProviderRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceGetUserPolicyRequest) Execute() (*IamServiceGetUserPolicyResponse, *http.Response, error) {
-	return r.ApiService.IamServiceGetUserPolicyExecute(r)
+func (r ApiIamServiceGetSAMLProviderRequest) Execute() (*IamServiceGetSAMLProviderResponse, *http.Response, error) {
+	return r.ApiService.IamServiceGetSAMLProviderExecute(r)
 }
 
 /*
-IamServiceGetUserPolicy Get specific inlinePolicy for IAM User.
+IamServiceGetSAMLProvider Retrieve the SAML IdP document.
 
-Get specific inlinePolicy for IAM User.
+Retrieve the SAML IdP document.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceGetUserPolicyRequest
+	@return ApiIamServiceGetSAMLProviderRequest
 */
-func (a *IamApiService) IamServiceGetUserPolicy(ctx context.Context) ApiIamServiceGetUserPolicyRequest {
-	return ApiIamServiceGetUserPolicyRequest{
+func (a *IamApiService) IamServiceGetSAMLProvider(ctx context.Context) ApiIamServiceGetSAMLProviderRequest {
+	return ApiIamServiceGetSAMLProviderRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -6051,31 +6058,28 @@ func (a *IamApiService) IamServiceGetUserPolicy(ctx context.Context) ApiIamServi
 
 // Execute executes the request
 //
-//	@return IamServiceGetUserPolicyResponse
-func (a *IamApiService) IamServiceGetUserPolicyExecute(r ApiIamServiceGetUserPolicyRequest) (*IamServiceGetUserPolicyResponse, *http.Response, error) {
+//	@return IamServiceGetSAMLProviderResponse
+func (a *IamApiService) IamServiceGetSAMLProviderExecute(r ApiIamServiceGetSAMLProviderRequest) (*IamServiceGetSAMLProviderResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceGetUserPolicyResponse
+		localVarReturnValue *IamServiceGetSAMLProviderResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetUserPolicy")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetSAMLProvider")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=GetUserPolicy"
+	localVarPath := localBasePath + "/iam?Action=GetSAMLProvider"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.userName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
-	}
-	if r.policyName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyName", r.policyName, "")
+	if r.sAMLProviderArn != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "SAMLProviderArn", r.sAMLProviderArn, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -6180,60 +6184,39 @@ func (a *IamApiService) IamServiceGetUserPolicyExecute(r ApiIamServiceGetUserPol
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceListAccessKeysRequest struct {
+type ApiIamServiceGetUserRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
-	marker        *string
-	maxItems      *int32
-	pathPrefix    *string
 	userName      *string
 	xEmcNamespace *string
 }
 
-// Marker is obtained from paginated response from the previous query. Use this only if the response indicates it is truncated.
-func (r ApiIamServiceListAccessKeysRequest) Marker(marker string) ApiIamServiceListAccessKeysRequest {
-	r.marker = &marker
-	return r
-}
-
-// Indicates the maximum number of elements to be returned in the response.
-func (r ApiIamServiceListAccessKeysRequest) MaxItems(maxItems int32) ApiIamServiceListAccessKeysRequest {
-	r.maxItems = &maxItems
-	return r
-}
-
-// Path prefix for filtering the results. Optional, default to \&quot;/\&quot;. Only \&quot;/\&quot; is allowed.
-func (r ApiIamServiceListAccessKeysRequest) PathPrefix(pathPrefix string) ApiIamServiceListAccessKeysRequest {
-	r.pathPrefix = &pathPrefix
-	return r
-}
-
-// Name of the user to list accesskeys.
-func (r ApiIamServiceListAccessKeysRequest) UserName(userName string) ApiIamServiceListAccessKeysRequest {
+// The name of the user to retrieve.
+func (r ApiIamServiceGetUserRequest) UserName(userName string) ApiIamServiceGetUserRequest {
 	r.userName = &userName
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceListAccessKeysRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListAccessKeysRequest {
+func (r ApiIamServiceGetUserRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetUserRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceListAccessKeysRequest) Execute() (*IamServiceListAccessKeysResponse, *http.Response, error) {
-	return r.ApiService.IamServiceListAccessKeysExecute(r)
+func (r ApiIamServiceGetUserRequest) Execute() (*IamServiceGetUserResponse, *http.Response, error) {
+	return r.ApiService.IamServiceGetUserExecute(r)
 }
 
 /*
-IamServiceListAccessKeys List AccessKeys for a user.
+IamServiceGetUser Retrieve IAM user.
 
-List AccessKeys for a user.
+Retrieve IAM user.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceListAccessKeysRequest
+	@return ApiIamServiceGetUserRequest
 */
-func (a *IamApiService) IamServiceListAccessKeys(ctx context.Context) ApiIamServiceListAccessKeysRequest {
-	return ApiIamServiceListAccessKeysRequest{
+func (a *IamApiService) IamServiceGetUser(ctx context.Context) ApiIamServiceGetUserRequest {
+	return ApiIamServiceGetUserRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -6241,35 +6224,26 @@ func (a *IamApiService) IamServiceListAccessKeys(ctx context.Context) ApiIamServ
 
 // Execute executes the request
 //
-//	@return IamServiceListAccessKeysResponse
-func (a *IamApiService) IamServiceListAccessKeysExecute(r ApiIamServiceListAccessKeysRequest) (*IamServiceListAccessKeysResponse, *http.Response, error) {
+//	@return IamServiceGetUserResponse
+func (a *IamApiService) IamServiceGetUserExecute(r ApiIamServiceGetUserRequest) (*IamServiceGetUserResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceListAccessKeysResponse
+		localVarReturnValue *IamServiceGetUserResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListAccessKeys")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetUser")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=ListAccessKeys"
+	localVarPath := localBasePath + "/iam?Action=GetUser"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.marker != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "Marker", r.marker, "")
-	}
-	if r.maxItems != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
-	}
-	if r.pathPrefix != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PathPrefix", r.pathPrefix, "")
-	}
 	if r.userName != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
 	}
@@ -6376,60 +6350,46 @@ func (a *IamApiService) IamServiceListAccessKeysExecute(r ApiIamServiceListAcces
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceListAttachedGroupPoliciesRequest struct {
+type ApiIamServiceGetUserPolicyRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
-	groupName     *string
-	marker        *string
-	maxItems      *int32
-	pathPrefix    *string
+	userName      *string
+	policyName    *string
 	xEmcNamespace *string
 }
 
-// The name of the group to list attached policies for.
-func (r ApiIamServiceListAttachedGroupPoliciesRequest) GroupName(groupName string) ApiIamServiceListAttachedGroupPoliciesRequest {
-	r.groupName = &groupName
+// Name of the user to retrieve the inline policy.
+func (r ApiIamServiceGetUserPolicyRequest) UserName(userName string) ApiIamServiceGetUserPolicyRequest {
+	r.userName = &userName
 	return r
 }
 
-// Marker is obtained from paginated response from the previous query. Use this only if the response indicates it is truncated.
-func (r ApiIamServiceListAttachedGroupPoliciesRequest) Marker(marker string) ApiIamServiceListAttachedGroupPoliciesRequest {
-	r.marker = &marker
-	return r
-}
-
-// Indicates the maximum number of elements to be returned in the response.
-func (r ApiIamServiceListAttachedGroupPoliciesRequest) MaxItems(maxItems int32) ApiIamServiceListAttachedGroupPoliciesRequest {
-	r.maxItems = &maxItems
-	return r
-}
-
-// Path prefix for filtering the results. Optional, default to \&quot;/\&quot;. Only \&quot;/\&quot; is allowed.
-func (r ApiIamServiceListAttachedGroupPoliciesRequest) PathPrefix(pathPrefix string) ApiIamServiceListAttachedGroupPoliciesRequest {
-	r.pathPrefix = &pathPrefix
+// Name of the policy whose Policy Document needs to be retrieved.
+func (r ApiIamServiceGetUserPolicyRequest) PolicyName(policyName string) ApiIamServiceGetUserPolicyRequest {
+	r.policyName = &policyName
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceListAttachedGroupPoliciesRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListAttachedGroupPoliciesRequest {
+func (r ApiIamServiceGetUserPolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceGetUserPolicyRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceListAttachedGroupPoliciesRequest) Execute() (*IamServiceListAttachedGroupPoliciesResponse, *http.Response, error) {
-	return r.ApiService.IamServiceListAttachedGroupPoliciesExecute(r)
+func (r ApiIamServiceGetUserPolicyRequest) Execute() (*IamServiceGetUserPolicyResponse, *http.Response, error) {
+	return r.ApiService.IamServiceGetUserPolicyExecute(r)
 }
 
 /*
-IamServiceListAttachedGroupPolicies List Managed Policies for IAM Group.
+IamServiceGetUserPolicy Get specific inlinePolicy for IAM User.
 
-List Managed Policies for IAM Group.
+Get specific inlinePolicy for IAM User.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceListAttachedGroupPoliciesRequest
+	@return ApiIamServiceGetUserPolicyRequest
 */
-func (a *IamApiService) IamServiceListAttachedGroupPolicies(ctx context.Context) ApiIamServiceListAttachedGroupPoliciesRequest {
-	return ApiIamServiceListAttachedGroupPoliciesRequest{
+func (a *IamApiService) IamServiceGetUserPolicy(ctx context.Context) ApiIamServiceGetUserPolicyRequest {
+	return ApiIamServiceGetUserPolicyRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -6437,37 +6397,31 @@ func (a *IamApiService) IamServiceListAttachedGroupPolicies(ctx context.Context)
 
 // Execute executes the request
 //
-//	@return IamServiceListAttachedGroupPoliciesResponse
-func (a *IamApiService) IamServiceListAttachedGroupPoliciesExecute(r ApiIamServiceListAttachedGroupPoliciesRequest) (*IamServiceListAttachedGroupPoliciesResponse, *http.Response, error) {
+//	@return IamServiceGetUserPolicyResponse
+func (a *IamApiService) IamServiceGetUserPolicyExecute(r ApiIamServiceGetUserPolicyRequest) (*IamServiceGetUserPolicyResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceListAttachedGroupPoliciesResponse
+		localVarReturnValue *IamServiceGetUserPolicyResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListAttachedGroupPolicies")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceGetUserPolicy")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=ListAttachedGroupPolicies"
+	localVarPath := localBasePath + "/iam?Action=GetUserPolicy"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.groupName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "GroupName", r.groupName, "")
-	}
-	if r.marker != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "Marker", r.marker, "")
-	}
-	if r.maxItems != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
+	if r.userName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
 	}
-	if r.pathPrefix != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PathPrefix", r.pathPrefix, "")
+	if r.policyName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyName", r.policyName, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -6572,60 +6526,60 @@ func (a *IamApiService) IamServiceListAttachedGroupPoliciesExecute(r ApiIamServi
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceListAttachedRolePoliciesRequest struct {
+type ApiIamServiceListAccessKeysRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
 	marker        *string
 	maxItems      *int32
 	pathPrefix    *string
-	roleName      *string
+	userName      *string
 	xEmcNamespace *string
 }
 
-// For pagination, the value of the Marker element in the response that you received to indicate where the next call should start.
-func (r ApiIamServiceListAttachedRolePoliciesRequest) Marker(marker string) ApiIamServiceListAttachedRolePoliciesRequest {
+// Marker is obtained from paginated response from the previous query. Use this only if the response indicates it is truncated.
+func (r ApiIamServiceListAccessKeysRequest) Marker(marker string) ApiIamServiceListAccessKeysRequest {
 	r.marker = &marker
 	return r
 }
 
-// Use this only when paginating results to indicate the maximum number of items you want in the response.  If additional items exist beyond the maximum you specify, the IsTruncated response element is true and  Marker contains a value to include in the subsequent call that tells the service where to continue from.
-func (r ApiIamServiceListAttachedRolePoliciesRequest) MaxItems(maxItems int32) ApiIamServiceListAttachedRolePoliciesRequest {
+// Indicates the maximum number of elements to be returned in the response.
+func (r ApiIamServiceListAccessKeysRequest) MaxItems(maxItems int32) ApiIamServiceListAccessKeysRequest {
 	r.maxItems = &maxItems
 	return r
 }
 
-// The path to the IAM role.
-func (r ApiIamServiceListAttachedRolePoliciesRequest) PathPrefix(pathPrefix string) ApiIamServiceListAttachedRolePoliciesRequest {
+// Path prefix for filtering the results. Optional, default to \&quot;/\&quot;. Only \&quot;/\&quot; is allowed.
+func (r ApiIamServiceListAccessKeysRequest) PathPrefix(pathPrefix string) ApiIamServiceListAccessKeysRequest {
 	r.pathPrefix = &pathPrefix
 	return r
 }
 
-// Simple name identifying the role.
-func (r ApiIamServiceListAttachedRolePoliciesRequest) RoleName(roleName string) ApiIamServiceListAttachedRolePoliciesRequest {
-	r.roleName = &roleName
+// Name of the user to list accesskeys.
+func (r ApiIamServiceListAccessKeysRequest) UserName(userName string) ApiIamServiceListAccessKeysRequest {
+	r.userName = &userName
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceListAttachedRolePoliciesRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListAttachedRolePoliciesRequest {
+func (r ApiIamServiceListAccessKeysRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListAccessKeysRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceListAttachedRolePoliciesRequest) Execute() (*IamServiceListAttachedRolePoliciesResponse, *http.Response, error) {
-	return r.ApiService.IamServiceListAttachedRolePoliciesExecute(r)
+func (r ApiIamServiceListAccessKeysRequest) Execute() (*IamServiceListAccessKeysResponse, *http.Response, error) {
+	return r.ApiService.IamServiceListAccessKeysExecute(r)
 }
 
 /*
-IamServiceListAttachedRolePolicies Lists all managed policies that are attached to the specified IAM Role.
+IamServiceListAccessKeys List AccessKeys for a user.
 
-Lists all managed policies that are attached to the specified IAM Role.
+List AccessKeys for a user.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceListAttachedRolePoliciesRequest
+	@return ApiIamServiceListAccessKeysRequest
 */
-func (a *IamApiService) IamServiceListAttachedRolePolicies(ctx context.Context) ApiIamServiceListAttachedRolePoliciesRequest {
-	return ApiIamServiceListAttachedRolePoliciesRequest{
+func (a *IamApiService) IamServiceListAccessKeys(ctx context.Context) ApiIamServiceListAccessKeysRequest {
+	return ApiIamServiceListAccessKeysRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -6633,21 +6587,21 @@ func (a *IamApiService) IamServiceListAttachedRolePolicies(ctx context.Context)
 
 // Execute executes the request
 //
-//	@return IamServiceListAttachedRolePoliciesResponse
-func (a *IamApiService) IamServiceListAttachedRolePoliciesExecute(r ApiIamServiceListAttachedRolePoliciesRequest) (*IamServiceListAttachedRolePoliciesResponse, *http.Response, error) {
+//	@return IamServiceListAccessKeysResponse
+func (a *IamApiService) IamServiceListAccessKeysExecute(r ApiIamServiceListAccessKeysRequest) (*IamServiceListAccessKeysResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceListAttachedRolePoliciesResponse
+		localVarReturnValue *IamServiceListAccessKeysResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListAttachedRolePolicies")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListAccessKeys")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=ListAttachedRolePolicies"
+	localVarPath := localBasePath + "/iam?Action=ListAccessKeys"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -6662,8 +6616,8 @@ func (a *IamApiService) IamServiceListAttachedRolePoliciesExecute(r ApiIamServic
 	if r.pathPrefix != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "PathPrefix", r.pathPrefix, "")
 	}
-	if r.roleName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "RoleName", r.roleName, "")
+	if r.userName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -6768,60 +6722,60 @@ func (a *IamApiService) IamServiceListAttachedRolePoliciesExecute(r ApiIamServic
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceListAttachedUserPoliciesRequest struct {
+type ApiIamServiceListAttachedGroupPoliciesRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
-	userName      *string
+	groupName     *string
 	marker        *string
 	maxItems      *int32
 	pathPrefix    *string
 	xEmcNamespace *string
 }
 
-// The name of the user to list attached policies for.
-func (r ApiIamServiceListAttachedUserPoliciesRequest) UserName(userName string) ApiIamServiceListAttachedUserPoliciesRequest {
-	r.userName = &userName
+// The name of the group to list attached policies for.
+func (r ApiIamServiceListAttachedGroupPoliciesRequest) GroupName(groupName string) ApiIamServiceListAttachedGroupPoliciesRequest {
+	r.groupName = &groupName
 	return r
 }
 
 // Marker is obtained from paginated response from the previous query. Use this only if the response indicates it is truncated.
-func (r ApiIamServiceListAttachedUserPoliciesRequest) Marker(marker string) ApiIamServiceListAttachedUserPoliciesRequest {
+func (r ApiIamServiceListAttachedGroupPoliciesRequest) Marker(marker string) ApiIamServiceListAttachedGroupPoliciesRequest {
 	r.marker = &marker
 	return r
 }
 
 // Indicates the maximum number of elements to be returned in the response.
-func (r ApiIamServiceListAttachedUserPoliciesRequest) MaxItems(maxItems int32) ApiIamServiceListAttachedUserPoliciesRequest {
+func (r ApiIamServiceListAttachedGroupPoliciesRequest) MaxItems(maxItems int32) ApiIamServiceListAttachedGroupPoliciesRequest {
 	r.maxItems = &maxItems
 	return r
 }
 
 // Path prefix for filtering the results. Optional, default to \&quot;/\&quot;. Only \&quot;/\&quot; is allowed.
-func (r ApiIamServiceListAttachedUserPoliciesRequest) PathPrefix(pathPrefix string) ApiIamServiceListAttachedUserPoliciesRequest {
+func (r ApiIamServiceListAttachedGroupPoliciesRequest) PathPrefix(pathPrefix string) ApiIamServiceListAttachedGroupPoliciesRequest {
 	r.pathPrefix = &pathPrefix
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceListAttachedUserPoliciesRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListAttachedUserPoliciesRequest {
+func (r ApiIamServiceListAttachedGroupPoliciesRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListAttachedGroupPoliciesRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceListAttachedUserPoliciesRequest) Execute() (*IamServiceListAttachedUserPoliciesResponse, *http.Response, error) {
-	return r.ApiService.IamServiceListAttachedUserPoliciesExecute(r)
+func (r ApiIamServiceListAttachedGroupPoliciesRequest) Execute() (*IamServiceListAttachedGroupPoliciesResponse, *http.Response, error) {
+	return r.ApiService.IamServiceListAttachedGroupPoliciesExecute(r)
 }
 
 /*
-IamServiceListAttachedUserPolicies List Managed Policies for IAM User.
+IamServiceListAttachedGroupPolicies List Managed Policies for IAM Group.
 
-List Managed Policies for IAM User.
+List Managed Policies for IAM Group.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceListAttachedUserPoliciesRequest
+	@return ApiIamServiceListAttachedGroupPoliciesRequest
 */
-func (a *IamApiService) IamServiceListAttachedUserPolicies(ctx context.Context) ApiIamServiceListAttachedUserPoliciesRequest {
-	return ApiIamServiceListAttachedUserPoliciesRequest{
+func (a *IamApiService) IamServiceListAttachedGroupPolicies(ctx context.Context) ApiIamServiceListAttachedGroupPoliciesRequest {
+	return ApiIamServiceListAttachedGroupPoliciesRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -6829,28 +6783,28 @@ func (a *IamApiService) IamServiceListAttachedUserPolicies(ctx context.Context)
 
 // Execute executes the request
 //
-//	@return IamServiceListAttachedUserPoliciesResponse
-func (a *IamApiService) IamServiceListAttachedUserPoliciesExecute(r ApiIamServiceListAttachedUserPoliciesRequest) (*IamServiceListAttachedUserPoliciesResponse, *http.Response, error) {
+//	@return IamServiceListAttachedGroupPoliciesResponse
+func (a *IamApiService) IamServiceListAttachedGroupPoliciesExecute(r ApiIamServiceListAttachedGroupPoliciesRequest) (*IamServiceListAttachedGroupPoliciesResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceListAttachedUserPoliciesResponse
+		localVarReturnValue *IamServiceListAttachedGroupPoliciesResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListAttachedUserPolicies")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListAttachedGroupPolicies")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=ListAttachedUserPolicies"
+	localVarPath := localBasePath + "/iam?Action=ListAttachedGroupPolicies"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.userName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
+	if r.groupName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "GroupName", r.groupName, "")
 	}
 	if r.marker != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "Marker", r.marker, "")
@@ -6964,53 +6918,60 @@ func (a *IamApiService) IamServiceListAttachedUserPoliciesExecute(r ApiIamServic
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceListGroupPoliciesRequest struct {
+type ApiIamServiceListAttachedRolePoliciesRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
-	groupName     *string
 	marker        *string
 	maxItems      *int32
+	pathPrefix    *string
+	roleName      *string
 	xEmcNamespace *string
 }
 
-// The name of the group to list attached policies for.
-func (r ApiIamServiceListGroupPoliciesRequest) GroupName(groupName string) ApiIamServiceListGroupPoliciesRequest {
-	r.groupName = &groupName
+// For pagination, the value of the Marker element in the response that you received to indicate where the next call should start.
+func (r ApiIamServiceListAttachedRolePoliciesRequest) Marker(marker string) ApiIamServiceListAttachedRolePoliciesRequest {
+	r.marker = &marker
 	return r
 }
 
-// Marker is obtained from paginated response from the previous query. Use this only if the response indicates it is truncated.
-func (r ApiIamServiceListGroupPoliciesRequest) Marker(marker string) ApiIamServiceListGroupPoliciesRequest {
-	r.marker = &marker
+// Use this only when paginating results to indicate the maximum number of items you want in the response.  If additional items exist beyond the maximum you specify, the IsTruncated response element is true and  Marker contains a value to include in the subsequent call that tells the service where to continue from.
+func (r ApiIamServiceListAttachedRolePoliciesRequest) MaxItems(maxItems int32) ApiIamServiceListAttachedRolePoliciesRequest {
+	r.maxItems = &maxItems
 	return r
 }
 
-// Indicates the maximum number of elements to be returned in the response.
-func (r ApiIamServiceListGroupPoliciesRequest) MaxItems(maxItems int32) ApiIamServiceListGroupPoliciesRequest {
-	r.maxItems = &maxItems
+// The path to the IAM role.
+func (r ApiIamServiceListAttachedRolePoliciesRequest) PathPrefix(pathPrefix string) ApiIamServiceListAttachedRolePoliciesRequest {
+	r.pathPrefix = &pathPrefix
+	return r
+}
+
+// Simple name identifying the role.
+func (r ApiIamServiceListAttachedRolePoliciesRequest) RoleName(roleName string) ApiIamServiceListAttachedRolePoliciesRequest {
+	r.roleName = &roleName
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceListGroupPoliciesRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListGroupPoliciesRequest {
+func (r ApiIamServiceListAttachedRolePoliciesRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListAttachedRolePoliciesRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceListGroupPoliciesRequest) Execute() (*IamServiceListGroupPoliciesResponse, *http.Response, error) {
-	return r.ApiService.IamServiceListGroupPoliciesExecute(r)
+func (r ApiIamServiceListAttachedRolePoliciesRequest) Execute() (*IamServiceListAttachedRolePoliciesResponse, *http.Response, error) {
+	return r.ApiService.IamServiceListAttachedRolePoliciesExecute(r)
 }
 
 /*
-IamServiceListGroupPolicies List Inline Policies for IAM Group.
+IamServiceListAttachedRolePolicies Lists all managed policies that are attached to the specified IAM Role.
 
-List Inline Policies for IAM Group.
+Lists all managed policies that are attached to the specified IAM Role.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceListGroupPoliciesRequest
+	@return ApiIamServiceListAttachedRolePoliciesRequest
 */
-func (a *IamApiService) IamServiceListGroupPolicies(ctx context.Context) ApiIamServiceListGroupPoliciesRequest {
-	return ApiIamServiceListGroupPoliciesRequest{
+func (a *IamApiService) IamServiceListAttachedRolePolicies(ctx context.Context) ApiIamServiceListAttachedRolePoliciesRequest {
+	return ApiIamServiceListAttachedRolePoliciesRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -7018,35 +6979,38 @@ func (a *IamApiService) IamServiceListGroupPolicies(ctx context.Context) ApiIamS
 
 // Execute executes the request
 //
-//	@return IamServiceListGroupPoliciesResponse
-func (a *IamApiService) IamServiceListGroupPoliciesExecute(r ApiIamServiceListGroupPoliciesRequest) (*IamServiceListGroupPoliciesResponse, *http.Response, error) {
+//	@return IamServiceListAttachedRolePoliciesResponse
+func (a *IamApiService) IamServiceListAttachedRolePoliciesExecute(r ApiIamServiceListAttachedRolePoliciesRequest) (*IamServiceListAttachedRolePoliciesResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceListGroupPoliciesResponse
+		localVarReturnValue *IamServiceListAttachedRolePoliciesResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListGroupPolicies")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListAttachedRolePolicies")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=ListGroupPolicies"
+	localVarPath := localBasePath + "/iam?Action=ListAttachedRolePolicies"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.groupName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "GroupName", r.groupName, "")
-	}
 	if r.marker != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "Marker", r.marker, "")
 	}
 	if r.maxItems != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
 	}
+	if r.pathPrefix != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PathPrefix", r.pathPrefix, "")
+	}
+	if r.roleName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "RoleName", r.roleName, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -7150,53 +7114,60 @@ func (a *IamApiService) IamServiceListGroupPoliciesExecute(r ApiIamServiceListGr
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceListGroupsRequest struct {
+type ApiIamServiceListAttachedUserPoliciesRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
+	userName      *string
 	marker        *string
 	maxItems      *int32
 	pathPrefix    *string
 	xEmcNamespace *string
 }
 
+// The name of the user to list attached policies for.
+func (r ApiIamServiceListAttachedUserPoliciesRequest) UserName(userName string) ApiIamServiceListAttachedUserPoliciesRequest {
+	r.userName = &userName
+	return r
+}
+
 // Marker is obtained from paginated response from the previous query. Use this only if the response indicates it is truncated.
-func (r ApiIamServiceListGroupsRequest) Marker(marker string) ApiIamServiceListGroupsRequest {
+func (r ApiIamServiceListAttachedUserPoliciesRequest) Marker(marker string) ApiIamServiceListAttachedUserPoliciesRequest {
 	r.marker = &marker
 	return r
 }
 
 // Indicates the maximum number of elements to be returned in the response.
-func (r ApiIamServiceListGroupsRequest) MaxItems(maxItems int32) ApiIamServiceListGroupsRequest {
+func (r ApiIamServiceListAttachedUserPoliciesRequest) MaxItems(maxItems int32) ApiIamServiceListAttachedUserPoliciesRequest {
 	r.maxItems = &maxItems
 	return r
 }
 
 // Path prefix for filtering the results. Optional, default to \&quot;/\&quot;. Only \&quot;/\&quot; is allowed.
-func (r ApiIamServiceListGroupsRequest) PathPrefix(pathPrefix string) ApiIamServiceListGroupsRequest {
+func (r ApiIamServiceListAttachedUserPoliciesRequest) PathPrefix(pathPrefix string) ApiIamServiceListAttachedUserPoliciesRequest {
 	r.pathPrefix = &pathPrefix
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceListGroupsRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListGroupsRequest {
+func (r ApiIamServiceListAttachedUserPoliciesRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListAttachedUserPoliciesRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceListGroupsRequest) Execute() (*IamServiceListGroupsResponse, *http.Response, error) {
-	return r.ApiService.IamServiceListGroupsExecute(r)
+func (r ApiIamServiceListAttachedUserPoliciesRequest) Execute() (*IamServiceListAttachedUserPoliciesResponse, *http.Response, error) {
+	return r.ApiService.IamServiceListAttachedUserPoliciesExecute(r)
 }
 
 /*
-IamServiceListGroups Lists the IAM groups.
+IamServiceListAttachedUserPolicies List Managed Policies for IAM User.
 
-Lists the IAM groups.
+List Managed Policies for IAM User.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceListGroupsRequest
+	@return ApiIamServiceListAttachedUserPoliciesRequest
 */
-func (a *IamApiService) IamServiceListGroups(ctx context.Context) ApiIamServiceListGroupsRequest {
-	return ApiIamServiceListGroupsRequest{
+func (a *IamApiService) IamServiceListAttachedUserPolicies(ctx context.Context) ApiIamServiceListAttachedUserPoliciesRequest {
+	return ApiIamServiceListAttachedUserPoliciesRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -7204,27 +7175,30 @@ func (a *IamApiService) IamServiceListGroups(ctx context.Context) ApiIamServiceL
 
 // Execute executes the request
 //
-//	@return IamServiceListGroupsResponse
-func (a *IamApiService) IamServiceListGroupsExecute(r ApiIamServiceListGroupsRequest) (*IamServiceListGroupsResponse, *http.Response, error) {
+//	@return IamServiceListAttachedUserPoliciesResponse
+func (a *IamApiService) IamServiceListAttachedUserPoliciesExecute(r ApiIamServiceListAttachedUserPoliciesRequest) (*IamServiceListAttachedUserPoliciesResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceListGroupsResponse
+		localVarReturnValue *IamServiceListAttachedUserPoliciesResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListGroups")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListAttachedUserPolicies")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=ListGroups"
+	localVarPath := localBasePath + "/iam?Action=ListAttachedUserPolicies"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.marker != nil {
+	if r.userName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
+	}
+	if r.marker != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "Marker", r.marker, "")
 	}
 	if r.maxItems != nil {
@@ -7336,53 +7310,74 @@ func (a *IamApiService) IamServiceListGroupsExecute(r ApiIamServiceListGroupsReq
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceListGroupsForUserRequest struct {
-	ctx           context.Context
-	ApiService    *IamApiService
-	userName      *string
-	marker        *string
-	maxItems      *int32
-	xEmcNamespace *string
+type ApiIamServiceListEntitiesForPolicyRequest struct {
+	ctx               context.Context
+	ApiService        *IamApiService
+	policyArn         *string
+	marker            *string
+	maxItems          *int32
+	entityFilter      *string
+	pathPrefix        *string
+	policyUsageFilter *string
+	xEmcNamespace     *string
 }
 
-// Simple name identifying the user.
-func (r ApiIamServiceListGroupsForUserRequest) UserName(userName string) ApiIamServiceListGroupsForUserRequest {
-	r.userName = &userName
+// ARN of the IAM Managed policy to list.
+func (r ApiIamServiceListEntitiesForPolicyRequest) PolicyArn(policyArn string) ApiIamServiceListEntitiesForPolicyRequest {
+	r.policyArn = &policyArn
 	return r
 }
 
 // For pagination, the value of the Marker element in the response that you received to indicate where the next call should start.
-func (r ApiIamServiceListGroupsForUserRequest) Marker(marker string) ApiIamServiceListGroupsForUserRequest {
+func (r ApiIamServiceListEntitiesForPolicyRequest) Marker(marker string) ApiIamServiceListEntitiesForPolicyRequest {
 	r.marker = &marker
 	return r
 }
 
 // Use this only when paginating results to indicate the maximum number of items you want in the response.  If additional items exist beyond the maximum you specify, the IsTruncated response element is true and  Marker contains a value to include in the subsequent call that tells the service where to continue from.
-func (r ApiIamServiceListGroupsForUserRequest) MaxItems(maxItems int32) ApiIamServiceListGroupsForUserRequest {
+func (r ApiIamServiceListEntitiesForPolicyRequest) MaxItems(maxItems int32) ApiIamServiceListEntitiesForPolicyRequest {
 	r.maxItems = &maxItems
 	return r
 }
 
+// The entity type to use for filtering the results. Values {User, Role, Group, LocalManagedPolicy, AWSManagedPolicy}
+func (r ApiIamServiceListEntitiesForPolicyRequest) EntityFilter(entityFilter string) ApiIamServiceListEntitiesForPolicyRequest {
+	r.entityFilter = &entityFilter
+	return r
+}
+
+// Path prefix for filtering the results. Optional, default to \&quot;/\&quot;. Only \&quot;/\&quot; is allowed.
+func (r ApiIamServiceListEntitiesForPolicyRequest) PathPrefix(pathPrefix string) ApiIamServiceListEntitiesForPolicyRequest {
+	r.pathPrefix = &pathPrefix
+	return r
+}
+
+// The policy usage method to use for filtering the results. Values {PermissionsPolicy, PermissionsBoundary}
+func (r ApiIamServiceListEntitiesForPolicyRequest) PolicyUsageFilter(policyUsageFilter string) ApiIamServiceListEntitiesForPolicyRequest {
+	r.policyUsageFilter = &policyUsageFilter
+	return r
+}
+
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceListGroupsForUserRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListGroupsForUserRequest {
+func (r ApiIamServiceListEntitiesForPolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListEntitiesForPolicyRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceListGroupsForUserRequest) Execute() (*IamServiceListGroupsForUserResponse, *http.Response, error) {
-	return r.ApiService.IamServiceListGroupsForUserExecute(r)
+func (r ApiIamServiceListEntitiesForPolicyRequest) Execute() (*IamServiceListEntitiesForPolicyResponse, *http.Response, error) {
+	return r.ApiService.IamServiceListEntitiesForPolicyExecute(r)
 }
 
 /*
-IamServiceListGroupsForUser List Groups for IAM User
+IamServiceListEntitiesForPolicy List all IAM Entities that he Managed Policy is attached to.
 
-List Groups for IAM User
+List all IAM Entities that he Managed Policy is attached to.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceListGroupsForUserRequest
+	@return ApiIamServiceListEntitiesForPolicyRequest
 */
-func (a *IamApiService) IamServiceListGroupsForUser(ctx context.Context) ApiIamServiceListGroupsForUserRequest {
-	return ApiIamServiceListGroupsForUserRequest{
+func (a *IamApiService) IamServiceListEntitiesForPolicy(ctx context.Context) ApiIamServiceListEntitiesForPolicyRequest {
+	return ApiIamServiceListEntitiesForPolicyRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -7390,28 +7385,28 @@ func (a *IamApiService) IamServiceListGroupsForUser(ctx context.Context) ApiIamS
 
 // Execute executes the request
 //
-//	@return IamServiceListGroupsForUserResponse
-func (a *IamApiService) IamServiceListGroupsForUserExecute(r ApiIamServiceListGroupsForUserRequest) (*IamServiceListGroupsForUserResponse, *http.Response, error) {
+//	@return IamServiceListEntitiesForPolicyResponse
+func (a *IamApiService) IamServiceListEntitiesForPolicyExecute(r ApiIamServiceListEntitiesForPolicyRequest) (*IamServiceListEntitiesForPolicyResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceListGroupsForUserResponse
+		localVarReturnValue *IamServiceListEntitiesForPolicyResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListGroupsForUser")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListEntitiesForPolicy")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=ListGroupsForUser"
+	localVarPath := localBasePath + "/iam?Action=ListEntitiesForPolicy"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.userName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
+	if r.policyArn != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyArn", r.policyArn, "")
 	}
 	if r.marker != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "Marker", r.marker, "")
@@ -7419,6 +7414,15 @@ func (a *IamApiService) IamServiceListGroupsForUserExecute(r ApiIamServiceListGr
 	if r.maxItems != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
 	}
+	if r.entityFilter != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "EntityFilter", r.entityFilter, "")
+	}
+	if r.pathPrefix != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PathPrefix", r.pathPrefix, "")
+	}
+	if r.policyUsageFilter != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyUsageFilter", r.policyUsageFilter, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -7522,74 +7526,53 @@ func (a *IamApiService) IamServiceListGroupsForUserExecute(r ApiIamServiceListGr
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceListPoliciesRequest struct {
-	ctx               context.Context
-	ApiService        *IamApiService
-	marker            *string
-	maxItems          *int32
-	onlyAttached      *bool
-	pathPrefix        *string
-	policyUsageFilter *string
-	policyScope       *string
-	xEmcNamespace     *string
+type ApiIamServiceListGroupPoliciesRequest struct {
+	ctx           context.Context
+	ApiService    *IamApiService
+	groupName     *string
+	marker        *string
+	maxItems      *int32
+	xEmcNamespace *string
+}
+
+// The name of the group to list attached policies for.
+func (r ApiIamServiceListGroupPoliciesRequest) GroupName(groupName string) ApiIamServiceListGroupPoliciesRequest {
+	r.groupName = &groupName
+	return r
 }
 
 // Marker is obtained from paginated response from the previous query. Use this only if the response indicates it is truncated.
-func (r ApiIamServiceListPoliciesRequest) Marker(marker string) ApiIamServiceListPoliciesRequest {
+func (r ApiIamServiceListGroupPoliciesRequest) Marker(marker string) ApiIamServiceListGroupPoliciesRequest {
 	r.marker = &marker
 	return r
 }
 
 // Indicates the maximum number of elements to be returned in the response.
-func (r ApiIamServiceListPoliciesRequest) MaxItems(maxItems int32) ApiIamServiceListPoliciesRequest {
+func (r ApiIamServiceListGroupPoliciesRequest) MaxItems(maxItems int32) ApiIamServiceListGroupPoliciesRequest {
 	r.maxItems = &maxItems
 	return r
 }
 
-// A flag to filter the results to only the attached policies.
-func (r ApiIamServiceListPoliciesRequest) OnlyAttached(onlyAttached bool) ApiIamServiceListPoliciesRequest {
-	r.onlyAttached = &onlyAttached
-	return r
-}
-
-// Path prefix for filtering the results. Optional, default to \&quot;/\&quot;. Only \&quot;/\&quot; is allowed.
-func (r ApiIamServiceListPoliciesRequest) PathPrefix(pathPrefix string) ApiIamServiceListPoliciesRequest {
-	r.pathPrefix = &pathPrefix
-	return r
-}
-
-// The policy usage method to use for filtering the results. Values {PermissionsPolicy, PermissionsBoundary}
-func (r ApiIamServiceListPoliciesRequest) PolicyUsageFilter(policyUsageFilter string) ApiIamServiceListPoliciesRequest {
-	r.policyUsageFilter = &policyUsageFilter
-	return r
-}
-
-// The scope to use for filtering the results. One of {All, ECS, AWS, Local}
-func (r ApiIamServiceListPoliciesRequest) PolicyScope(policyScope string) ApiIamServiceListPoliciesRequest {
-	r.policyScope = &policyScope
-	return r
-}
-
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceListPoliciesRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListPoliciesRequest {
+func (r ApiIamServiceListGroupPoliciesRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListGroupPoliciesRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceListPoliciesRequest) Execute() (*IamServiceListPoliciesResponse, *http.Response, error) {
-	return r.ApiService.IamServiceListPoliciesExecute(r)
+func (r ApiIamServiceListGroupPoliciesRequest) Execute() (*IamServiceListGroupPoliciesResponse, *http.Response, error) {
+	return r.ApiService.IamServiceListGroupPoliciesExecute(r)
 }
 
 /*
-IamServiceListPolicies Lists the IAM users.
+IamServiceListGroupPolicies List Inline Policies for IAM Group.
 
-Lists the IAM users.
+List Inline Policies for IAM Group.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceListPoliciesRequest
+	@return ApiIamServiceListGroupPoliciesRequest
 */
-func (a *IamApiService) IamServiceListPolicies(ctx context.Context) ApiIamServiceListPoliciesRequest {
-	return ApiIamServiceListPoliciesRequest{
+func (a *IamApiService) IamServiceListGroupPolicies(ctx context.Context) ApiIamServiceListGroupPoliciesRequest {
+	return ApiIamServiceListGroupPoliciesRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -7597,44 +7580,35 @@ func (a *IamApiService) IamServiceListPolicies(ctx context.Context) ApiIamServic
 
 // Execute executes the request
 //
-//	@return IamServiceListPoliciesResponse
-func (a *IamApiService) IamServiceListPoliciesExecute(r ApiIamServiceListPoliciesRequest) (*IamServiceListPoliciesResponse, *http.Response, error) {
+//	@return IamServiceListGroupPoliciesResponse
+func (a *IamApiService) IamServiceListGroupPoliciesExecute(r ApiIamServiceListGroupPoliciesRequest) (*IamServiceListGroupPoliciesResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceListPoliciesResponse
+		localVarReturnValue *IamServiceListGroupPoliciesResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListPolicies")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListGroupPolicies")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=ListPolicies"
+	localVarPath := localBasePath + "/iam?Action=ListGroupPolicies"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.groupName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "GroupName", r.groupName, "")
+	}
 	if r.marker != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "Marker", r.marker, "")
 	}
 	if r.maxItems != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
 	}
-	if r.onlyAttached != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "OnlyAttached", r.onlyAttached, "")
-	}
-	if r.pathPrefix != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PathPrefix", r.pathPrefix, "")
-	}
-	if r.policyUsageFilter != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyUsageFilter", r.policyUsageFilter, "")
-	}
-	if r.policyScope != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyScope", r.policyScope, "")
-	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -7738,53 +7712,53 @@ func (a *IamApiService) IamServiceListPoliciesExecute(r ApiIamServiceListPolicie
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceListPolicyVersionsRequest struct {
+type ApiIamServiceListGroupsRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
 	marker        *string
 	maxItems      *int32
-	policyArn     *string
+	pathPrefix    *string
 	xEmcNamespace *string
 }
 
-// For pagination, the value of the Marker element in the response that you received to indicate where the next call should start.
-func (r ApiIamServiceListPolicyVersionsRequest) Marker(marker string) ApiIamServiceListPolicyVersionsRequest {
+// Marker is obtained from paginated response from the previous query. Use this only if the response indicates it is truncated.
+func (r ApiIamServiceListGroupsRequest) Marker(marker string) ApiIamServiceListGroupsRequest {
 	r.marker = &marker
 	return r
 }
 
-// Use this only when paginating results to indicate the maximum number of items you want in the response.  If additional items exist beyond the maximum you specify, the IsTruncated response element is true and  Marker contains a value to include in the subsequent call that tells the service where to continue from.
-func (r ApiIamServiceListPolicyVersionsRequest) MaxItems(maxItems int32) ApiIamServiceListPolicyVersionsRequest {
+// Indicates the maximum number of elements to be returned in the response.
+func (r ApiIamServiceListGroupsRequest) MaxItems(maxItems int32) ApiIamServiceListGroupsRequest {
 	r.maxItems = &maxItems
 	return r
 }
 
-// ARN of the IAM Managed policy to list.
-func (r ApiIamServiceListPolicyVersionsRequest) PolicyArn(policyArn string) ApiIamServiceListPolicyVersionsRequest {
-	r.policyArn = &policyArn
+// Path prefix for filtering the results. Optional, default to \&quot;/\&quot;. Only \&quot;/\&quot; is allowed.
+func (r ApiIamServiceListGroupsRequest) PathPrefix(pathPrefix string) ApiIamServiceListGroupsRequest {
+	r.pathPrefix = &pathPrefix
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceListPolicyVersionsRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListPolicyVersionsRequest {
+func (r ApiIamServiceListGroupsRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListGroupsRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceListPolicyVersionsRequest) Execute() (*IamServiceListPolicyVersionsResponse, *http.Response, error) {
-	return r.ApiService.IamServiceListPolicyVersionsExecute(r)
+func (r ApiIamServiceListGroupsRequest) Execute() (*IamServiceListGroupsResponse, *http.Response, error) {
+	return r.ApiService.IamServiceListGroupsExecute(r)
 }
 
 /*
-IamServiceListPolicyVersions List versions of IAM Managed Policy.
+IamServiceListGroups Lists the IAM groups.
 
-List versions of IAM Managed Policy.
+Lists the IAM groups.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceListPolicyVersionsRequest
+	@return ApiIamServiceListGroupsRequest
 */
-func (a *IamApiService) IamServiceListPolicyVersions(ctx context.Context) ApiIamServiceListPolicyVersionsRequest {
-	return ApiIamServiceListPolicyVersionsRequest{
+func (a *IamApiService) IamServiceListGroups(ctx context.Context) ApiIamServiceListGroupsRequest {
+	return ApiIamServiceListGroupsRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -7792,21 +7766,21 @@ func (a *IamApiService) IamServiceListPolicyVersions(ctx context.Context) ApiIam
 
 // Execute executes the request
 //
-//	@return IamServiceListPolicyVersionsResponse
-func (a *IamApiService) IamServiceListPolicyVersionsExecute(r ApiIamServiceListPolicyVersionsRequest) (*IamServiceListPolicyVersionsResponse, *http.Response, error) {
+//	@return IamServiceListGroupsResponse
+func (a *IamApiService) IamServiceListGroupsExecute(r ApiIamServiceListGroupsRequest) (*IamServiceListGroupsResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceListPolicyVersionsResponse
+		localVarReturnValue *IamServiceListGroupsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListPolicyVersions")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListGroups")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=ListPolicyVersions"
+	localVarPath := localBasePath + "/iam?Action=ListGroups"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -7818,8 +7792,8 @@ func (a *IamApiService) IamServiceListPolicyVersionsExecute(r ApiIamServiceListP
 	if r.maxItems != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
 	}
-	if r.policyArn != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyArn", r.policyArn, "")
+	if r.pathPrefix != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PathPrefix", r.pathPrefix, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -7924,53 +7898,53 @@ func (a *IamApiService) IamServiceListPolicyVersionsExecute(r ApiIamServiceListP
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceListRolePoliciesRequest struct {
+type ApiIamServiceListGroupsForUserRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
+	userName      *string
 	marker        *string
 	maxItems      *int32
-	roleName      *string
 	xEmcNamespace *string
 }
 
+// Simple name identifying the user.
+func (r ApiIamServiceListGroupsForUserRequest) UserName(userName string) ApiIamServiceListGroupsForUserRequest {
+	r.userName = &userName
+	return r
+}
+
 // For pagination, the value of the Marker element in the response that you received to indicate where the next call should start.
-func (r ApiIamServiceListRolePoliciesRequest) Marker(marker string) ApiIamServiceListRolePoliciesRequest {
+func (r ApiIamServiceListGroupsForUserRequest) Marker(marker string) ApiIamServiceListGroupsForUserRequest {
 	r.marker = &marker
 	return r
 }
 
 // Use this only when paginating results to indicate the maximum number of items you want in the response.  If additional items exist beyond the maximum you specify, the IsTruncated response element is true and  Marker contains a value to include in the subsequent call that tells the service where to continue from.
-func (r ApiIamServiceListRolePoliciesRequest) MaxItems(maxItems int32) ApiIamServiceListRolePoliciesRequest {
+func (r ApiIamServiceListGroupsForUserRequest) MaxItems(maxItems int32) ApiIamServiceListGroupsForUserRequest {
 	r.maxItems = &maxItems
 	return r
 }
 
-// Simple name identifying the role.
-func (r ApiIamServiceListRolePoliciesRequest) RoleName(roleName string) ApiIamServiceListRolePoliciesRequest {
-	r.roleName = &roleName
-	return r
-}
-
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceListRolePoliciesRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListRolePoliciesRequest {
+func (r ApiIamServiceListGroupsForUserRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListGroupsForUserRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceListRolePoliciesRequest) Execute() (*IamServiceListRolePoliciesResponse, *http.Response, error) {
-	return r.ApiService.IamServiceListRolePoliciesExecute(r)
+func (r ApiIamServiceListGroupsForUserRequest) Execute() (*IamServiceListGroupsForUserResponse, *http.Response, error) {
+	return r.ApiService.IamServiceListGroupsForUserExecute(r)
 }
 
 /*
-IamServiceListRolePolicies Lists the names of the inline policies that are embedded in the specified IAM role.
+IamServiceListGroupsForUser List Groups for IAM User
 
-Lists the names of the inline policies that are embedded in the specified IAM role.
+List Groups for IAM User
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceListRolePoliciesRequest
+	@return ApiIamServiceListGroupsForUserRequest
 */
-func (a *IamApiService) IamServiceListRolePolicies(ctx context.Context) ApiIamServiceListRolePoliciesRequest {
-	return ApiIamServiceListRolePoliciesRequest{
+func (a *IamApiService) IamServiceListGroupsForUser(ctx context.Context) ApiIamServiceListGroupsForUserRequest {
+	return ApiIamServiceListGroupsForUserRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -7978,35 +7952,35 @@ func (a *IamApiService) IamServiceListRolePolicies(ctx context.Context) ApiIamSe
 
 // Execute executes the request
 //
-//	@return IamServiceListRolePoliciesResponse
-func (a *IamApiService) IamServiceListRolePoliciesExecute(r ApiIamServiceListRolePoliciesRequest) (*IamServiceListRolePoliciesResponse, *http.Response, error) {
+//	@return IamServiceListGroupsForUserResponse
+func (a *IamApiService) IamServiceListGroupsForUserExecute(r ApiIamServiceListGroupsForUserRequest) (*IamServiceListGroupsForUserResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceListRolePoliciesResponse
+		localVarReturnValue *IamServiceListGroupsForUserResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListRolePolicies")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListGroupsForUser")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=ListRolePolicies"
+	localVarPath := localBasePath + "/iam?Action=ListGroupsForUser"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.userName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
+	}
 	if r.marker != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "Marker", r.marker, "")
 	}
 	if r.maxItems != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
 	}
-	if r.roleName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "RoleName", r.roleName, "")
-	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -8110,53 +8084,74 @@ func (a *IamApiService) IamServiceListRolePoliciesExecute(r ApiIamServiceListRol
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceListRoleTagsRequest struct {
-	ctx           context.Context
-	ApiService    *IamApiService
-	marker        *string
-	maxItems      *int32
-	roleName      *string
-	xEmcNamespace *string
+type ApiIamServiceListPoliciesRequest struct {
+	ctx               context.Context
+	ApiService        *IamApiService
+	marker            *string
+	maxItems          *int32
+	onlyAttached      *bool
+	pathPrefix        *string
+	policyUsageFilter *string
+	policyScope       *string
+	xEmcNamespace     *string
 }
 
-// For pagination, the value of the Marker element in the response that you received to indicate where the next call should start.
-func (r ApiIamServiceListRoleTagsRequest) Marker(marker string) ApiIamServiceListRoleTagsRequest {
+// Marker is obtained from paginated response from the previous query. Use this only if the response indicates it is truncated.
+func (r ApiIamServiceListPoliciesRequest) Marker(marker string) ApiIamServiceListPoliciesRequest {
 	r.marker = &marker
 	return r
 }
 
-// Use this only when paginating results to indicate the maximum number of items you want in the response.  If additional items exist beyond the maximum you specify, the IsTruncated response element is true and  Marker contains a value to include in the subsequent call that tells the service where to continue from.
-func (r ApiIamServiceListRoleTagsRequest) MaxItems(maxItems int32) ApiIamServiceListRoleTagsRequest {
+// Indicates the maximum number of elements to be returned in the response.
+func (r ApiIamServiceListPoliciesRequest) MaxItems(maxItems int32) ApiIamServiceListPoliciesRequest {
 	r.maxItems = &maxItems
 	return r
 }
 
-// Simple name identifying the role.
-func (r ApiIamServiceListRoleTagsRequest) RoleName(roleName string) ApiIamServiceListRoleTagsRequest {
-	r.roleName = &roleName
+// A flag to filter the results to only the attached policies.
+func (r ApiIamServiceListPoliciesRequest) OnlyAttached(onlyAttached bool) ApiIamServiceListPoliciesRequest {
+	r.onlyAttached = &onlyAttached
+	return r
+}
+
+// Path prefix for filtering the results. Optional, default to \&quot;/\&quot;. Only \&quot;/\&quot; is allowed.
+func (r ApiIamServiceListPoliciesRequest) PathPrefix(pathPrefix string) ApiIamServiceListPoliciesRequest {
+	r.pathPrefix = &pathPrefix
+	return r
+}
+
+// The policy usage method to use for filtering the results. Values {PermissionsPolicy, PermissionsBoundary}
+func (r ApiIamServiceListPoliciesRequest) PolicyUsageFilter(policyUsageFilter string) ApiIamServiceListPoliciesRequest {
+	r.policyUsageFilter = &policyUsageFilter
+	return r
+}
+
+// The scope to use for filtering the results. One of {All, ECS, AWS, Local}
+func (r ApiIamServiceListPoliciesRequest) PolicyScope(policyScope string) ApiIamServiceListPoliciesRequest {
+	r.policyScope = &policyScope
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceListRoleTagsRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListRoleTagsRequest {
+func (r ApiIamServiceListPoliciesRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListPoliciesRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceListRoleTagsRequest) Execute() (*IamServiceListRoleTagsResponse, *http.Response, error) {
-	return r.ApiService.IamServiceListRoleTagsExecute(r)
+func (r ApiIamServiceListPoliciesRequest) Execute() (*IamServiceListPoliciesResponse, *http.Response, error) {
+	return r.ApiService.IamServiceListPoliciesExecute(r)
 }
 
 /*
-IamServiceListRoleTags Lists the tags that are attached to the specified IAM role.
+IamServiceListPolicies Lists the IAM users.
 
-Lists the tags that are attached to the specified IAM role.
+Lists the IAM users.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceListRoleTagsRequest
+	@return ApiIamServiceListPoliciesRequest
 */
-func (a *IamApiService) IamServiceListRoleTags(ctx context.Context) ApiIamServiceListRoleTagsRequest {
-	return ApiIamServiceListRoleTagsRequest{
+func (a *IamApiService) IamServiceListPolicies(ctx context.Context) ApiIamServiceListPoliciesRequest {
+	return ApiIamServiceListPoliciesRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -8164,21 +8159,21 @@ func (a *IamApiService) IamServiceListRoleTags(ctx context.Context) ApiIamServic
 
 // Execute executes the request
 //
-//	@return IamServiceListRoleTagsResponse
-func (a *IamApiService) IamServiceListRoleTagsExecute(r ApiIamServiceListRoleTagsRequest) (*IamServiceListRoleTagsResponse, *http.Response, error) {
+//	@return IamServiceListPoliciesResponse
+func (a *IamApiService) IamServiceListPoliciesExecute(r ApiIamServiceListPoliciesRequest) (*IamServiceListPoliciesResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceListRoleTagsResponse
+		localVarReturnValue *IamServiceListPoliciesResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListRoleTags")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListPolicies")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=ListRoleTags"
+	localVarPath := localBasePath + "/iam?Action=ListPolicies"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -8190,8 +8185,17 @@ func (a *IamApiService) IamServiceListRoleTagsExecute(r ApiIamServiceListRoleTag
 	if r.maxItems != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
 	}
-	if r.roleName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "RoleName", r.roleName, "")
+	if r.onlyAttached != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "OnlyAttached", r.onlyAttached, "")
+	}
+	if r.pathPrefix != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PathPrefix", r.pathPrefix, "")
+	}
+	if r.policyUsageFilter != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyUsageFilter", r.policyUsageFilter, "")
+	}
+	if r.policyScope != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyScope", r.policyScope, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -8296,53 +8300,53 @@ func (a *IamApiService) IamServiceListRoleTagsExecute(r ApiIamServiceListRoleTag
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceListRolesRequest struct {
+type ApiIamServiceListPolicyVersionsRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
 	marker        *string
 	maxItems      *int32
-	pathPrefix    *string
+	policyArn     *string
 	xEmcNamespace *string
 }
 
 // For pagination, the value of the Marker element in the response that you received to indicate where the next call should start.
-func (r ApiIamServiceListRolesRequest) Marker(marker string) ApiIamServiceListRolesRequest {
+func (r ApiIamServiceListPolicyVersionsRequest) Marker(marker string) ApiIamServiceListPolicyVersionsRequest {
 	r.marker = &marker
 	return r
 }
 
 // Use this only when paginating results to indicate the maximum number of items you want in the response.  If additional items exist beyond the maximum you specify, the IsTruncated response element is true and  Marker contains a value to include in the subsequent call that tells the service where to continue from.
-func (r ApiIamServiceListRolesRequest) MaxItems(maxItems int32) ApiIamServiceListRolesRequest {
+func (r ApiIamServiceListPolicyVersionsRequest) MaxItems(maxItems int32) ApiIamServiceListPolicyVersionsRequest {
 	r.maxItems = &maxItems
 	return r
 }
 
-// The path to the roles.
-func (r ApiIamServiceListRolesRequest) PathPrefix(pathPrefix string) ApiIamServiceListRolesRequest {
-	r.pathPrefix = &pathPrefix
+// ARN of the IAM Managed policy to list.
+func (r ApiIamServiceListPolicyVersionsRequest) PolicyArn(policyArn string) ApiIamServiceListPolicyVersionsRequest {
+	r.policyArn = &policyArn
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceListRolesRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListRolesRequest {
+func (r ApiIamServiceListPolicyVersionsRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListPolicyVersionsRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceListRolesRequest) Execute() (*IamServiceListRolesResponse, *http.Response, error) {
-	return r.ApiService.IamServiceListRolesExecute(r)
+func (r ApiIamServiceListPolicyVersionsRequest) Execute() (*IamServiceListPolicyVersionsResponse, *http.Response, error) {
+	return r.ApiService.IamServiceListPolicyVersionsExecute(r)
 }
 
 /*
-IamServiceListRoles Lists the IAM roles.
+IamServiceListPolicyVersions List versions of IAM Managed Policy.
 
-Lists the IAM roles.
+List versions of IAM Managed Policy.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceListRolesRequest
+	@return ApiIamServiceListPolicyVersionsRequest
 */
-func (a *IamApiService) IamServiceListRoles(ctx context.Context) ApiIamServiceListRolesRequest {
-	return ApiIamServiceListRolesRequest{
+func (a *IamApiService) IamServiceListPolicyVersions(ctx context.Context) ApiIamServiceListPolicyVersionsRequest {
+	return ApiIamServiceListPolicyVersionsRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -8350,21 +8354,21 @@ func (a *IamApiService) IamServiceListRoles(ctx context.Context) ApiIamServiceLi
 
 // Execute executes the request
 //
-//	@return IamServiceListRolesResponse
-func (a *IamApiService) IamServiceListRolesExecute(r ApiIamServiceListRolesRequest) (*IamServiceListRolesResponse, *http.Response, error) {
+//	@return IamServiceListPolicyVersionsResponse
+func (a *IamApiService) IamServiceListPolicyVersionsExecute(r ApiIamServiceListPolicyVersionsRequest) (*IamServiceListPolicyVersionsResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceListRolesResponse
+		localVarReturnValue *IamServiceListPolicyVersionsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListRoles")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListPolicyVersions")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=ListRoles"
+	localVarPath := localBasePath + "/iam?Action=ListPolicyVersions"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -8376,8 +8380,8 @@ func (a *IamApiService) IamServiceListRolesExecute(r ApiIamServiceListRolesReque
 	if r.maxItems != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
 	}
-	if r.pathPrefix != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PathPrefix", r.pathPrefix, "")
+	if r.policyArn != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyArn", r.policyArn, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -8482,46 +8486,53 @@ func (a *IamApiService) IamServiceListRolesExecute(r ApiIamServiceListRolesReque
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceListSAMLProvidersRequest struct {
+type ApiIamServiceListRolePoliciesRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
 	marker        *string
 	maxItems      *int32
+	roleName      *string
 	xEmcNamespace *string
 }
 
 // For pagination, the value of the Marker element in the response that you received to indicate where the next call should start.
-func (r ApiIamServiceListSAMLProvidersRequest) Marker(marker string) ApiIamServiceListSAMLProvidersRequest {
+func (r ApiIamServiceListRolePoliciesRequest) Marker(marker string) ApiIamServiceListRolePoliciesRequest {
 	r.marker = &marker
 	return r
 }
 
 // Use this only when paginating results to indicate the maximum number of items you want in the response.  If additional items exist beyond the maximum you specify, the IsTruncated response element is true and  Marker contains a value to include in the subsequent call that tells the service where to continue from.
-func (r ApiIamServiceListSAMLProvidersRequest) MaxItems(maxItems int32) ApiIamServiceListSAMLProvidersRequest {
+func (r ApiIamServiceListRolePoliciesRequest) MaxItems(maxItems int32) ApiIamServiceListRolePoliciesRequest {
 	r.maxItems = &maxItems
 	return r
 }
 
+// Simple name identifying the role.
+func (r ApiIamServiceListRolePoliciesRequest) RoleName(roleName string) ApiIamServiceListRolePoliciesRequest {
+	r.roleName = &roleName
+	return r
+}
+
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceListSAMLProvidersRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListSAMLProvidersRequest {
+func (r ApiIamServiceListRolePoliciesRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListRolePoliciesRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceListSAMLProvidersRequest) Execute() (*IamServiceListSAMLProvidersResponse, *http.Response, error) {
-	return r.ApiService.IamServiceListSAMLProvidersExecute(r)
+func (r ApiIamServiceListRolePoliciesRequest) Execute() (*IamServiceListRolePoliciesResponse, *http.Response, error) {
+	return r.ApiService.IamServiceListRolePoliciesExecute(r)
 }
 
 /*
-IamServiceListSAMLProviders List the SAML Identity Providers.
+IamServiceListRolePolicies Lists the names of the inline policies that are embedded in the specified IAM role.
 
-List the SAML Identity Providers.
+Lists the names of the inline policies that are embedded in the specified IAM role.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceListSAMLProvidersRequest
+	@return ApiIamServiceListRolePoliciesRequest
 */
-func (a *IamApiService) IamServiceListSAMLProviders(ctx context.Context) ApiIamServiceListSAMLProvidersRequest {
-	return ApiIamServiceListSAMLProvidersRequest{
+func (a *IamApiService) IamServiceListRolePolicies(ctx context.Context) ApiIamServiceListRolePoliciesRequest {
+	return ApiIamServiceListRolePoliciesRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -8529,21 +8540,21 @@ func (a *IamApiService) IamServiceListSAMLProviders(ctx context.Context) ApiIamS
 
 // Execute executes the request
 //
-//	@return IamServiceListSAMLProvidersResponse
-func (a *IamApiService) IamServiceListSAMLProvidersExecute(r ApiIamServiceListSAMLProvidersRequest) (*IamServiceListSAMLProvidersResponse, *http.Response, error) {
+//	@return IamServiceListRolePoliciesResponse
+func (a *IamApiService) IamServiceListRolePoliciesExecute(r ApiIamServiceListRolePoliciesRequest) (*IamServiceListRolePoliciesResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceListSAMLProvidersResponse
+		localVarReturnValue *IamServiceListRolePoliciesResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListSAMLProviders")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListRolePolicies")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=ListSAMLProviders"
+	localVarPath := localBasePath + "/iam?Action=ListRolePolicies"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -8555,6 +8566,9 @@ func (a *IamApiService) IamServiceListSAMLProvidersExecute(r ApiIamServiceListSA
 	if r.maxItems != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
 	}
+	if r.roleName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "RoleName", r.roleName, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -8658,53 +8672,53 @@ func (a *IamApiService) IamServiceListSAMLProvidersExecute(r ApiIamServiceListSA
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceListUserPoliciesRequest struct {
+type ApiIamServiceListRoleTagsRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
-	userName      *string
 	marker        *string
 	maxItems      *int32
+	roleName      *string
 	xEmcNamespace *string
 }
 
-// The name of the user to list attached policies for.
-func (r ApiIamServiceListUserPoliciesRequest) UserName(userName string) ApiIamServiceListUserPoliciesRequest {
-	r.userName = &userName
+// For pagination, the value of the Marker element in the response that you received to indicate where the next call should start.
+func (r ApiIamServiceListRoleTagsRequest) Marker(marker string) ApiIamServiceListRoleTagsRequest {
+	r.marker = &marker
 	return r
 }
 
-// Marker is obtained from paginated response from the previous query. Use this only if the response indicates it is truncated.
-func (r ApiIamServiceListUserPoliciesRequest) Marker(marker string) ApiIamServiceListUserPoliciesRequest {
-	r.marker = &marker
+// Use this only when paginating results to indicate the maximum number of items you want in the response.  If additional items exist beyond the maximum you specify, the IsTruncated response element is true and  Marker contains a value to include in the subsequent call that tells the service where to continue from.
+func (r ApiIamServiceListRoleTagsRequest) MaxItems(maxItems int32) ApiIamServiceListRoleTagsRequest {
+	r.maxItems = &maxItems
 	return r
 }
 
-// Indicates the maximum number of elements to be returned in the response.
-func (r ApiIamServiceListUserPoliciesRequest) MaxItems(maxItems int32) ApiIamServiceListUserPoliciesRequest {
-	r.maxItems = &maxItems
+// Simple name identifying the role.
+func (r ApiIamServiceListRoleTagsRequest) RoleName(roleName string) ApiIamServiceListRoleTagsRequest {
+	r.roleName = &roleName
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceListUserPoliciesRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListUserPoliciesRequest {
+func (r ApiIamServiceListRoleTagsRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListRoleTagsRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceListUserPoliciesRequest) Execute() (*IamServiceListUserPoliciesResponse, *http.Response, error) {
-	return r.ApiService.IamServiceListUserPoliciesExecute(r)
+func (r ApiIamServiceListRoleTagsRequest) Execute() (*IamServiceListRoleTagsResponse, *http.Response, error) {
+	return r.ApiService.IamServiceListRoleTagsExecute(r)
 }
 
 /*
-IamServiceListUserPolicies List Inline Policies for IAM User.
+IamServiceListRoleTags Lists the tags that are attached to the specified IAM role.
 
-List Inline Policies for IAM User.
+Lists the tags that are attached to the specified IAM role.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceListUserPoliciesRequest
+	@return ApiIamServiceListRoleTagsRequest
 */
-func (a *IamApiService) IamServiceListUserPolicies(ctx context.Context) ApiIamServiceListUserPoliciesRequest {
-	return ApiIamServiceListUserPoliciesRequest{
+func (a *IamApiService) IamServiceListRoleTags(ctx context.Context) ApiIamServiceListRoleTagsRequest {
+	return ApiIamServiceListRoleTagsRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -8712,35 +8726,35 @@ func (a *IamApiService) IamServiceListUserPolicies(ctx context.Context) ApiIamSe
 
 // Execute executes the request
 //
-//	@return IamServiceListUserPoliciesResponse
-func (a *IamApiService) IamServiceListUserPoliciesExecute(r ApiIamServiceListUserPoliciesRequest) (*IamServiceListUserPoliciesResponse, *http.Response, error) {
+//	@return IamServiceListRoleTagsResponse
+func (a *IamApiService) IamServiceListRoleTagsExecute(r ApiIamServiceListRoleTagsRequest) (*IamServiceListRoleTagsResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceListUserPoliciesResponse
+		localVarReturnValue *IamServiceListRoleTagsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListUserPolicies")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListRoleTags")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=ListUserPolicies"
+	localVarPath := localBasePath + "/iam?Action=ListRoleTags"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.userName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
-	}
 	if r.marker != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "Marker", r.marker, "")
 	}
 	if r.maxItems != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
 	}
+	if r.roleName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "RoleName", r.roleName, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -8844,53 +8858,53 @@ func (a *IamApiService) IamServiceListUserPoliciesExecute(r ApiIamServiceListUse
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceListUserTagsRequest struct {
+type ApiIamServiceListRolesRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
-	userName      *string
 	marker        *string
 	maxItems      *int32
+	pathPrefix    *string
 	xEmcNamespace *string
 }
 
-// Simple name identifying the user.
-func (r ApiIamServiceListUserTagsRequest) UserName(userName string) ApiIamServiceListUserTagsRequest {
-	r.userName = &userName
-	return r
-}
-
 // For pagination, the value of the Marker element in the response that you received to indicate where the next call should start.
-func (r ApiIamServiceListUserTagsRequest) Marker(marker string) ApiIamServiceListUserTagsRequest {
+func (r ApiIamServiceListRolesRequest) Marker(marker string) ApiIamServiceListRolesRequest {
 	r.marker = &marker
 	return r
 }
 
 // Use this only when paginating results to indicate the maximum number of items you want in the response.  If additional items exist beyond the maximum you specify, the IsTruncated response element is true and  Marker contains a value to include in the subsequent call that tells the service where to continue from.
-func (r ApiIamServiceListUserTagsRequest) MaxItems(maxItems int32) ApiIamServiceListUserTagsRequest {
+func (r ApiIamServiceListRolesRequest) MaxItems(maxItems int32) ApiIamServiceListRolesRequest {
 	r.maxItems = &maxItems
 	return r
 }
 
+// The path to the roles.
+func (r ApiIamServiceListRolesRequest) PathPrefix(pathPrefix string) ApiIamServiceListRolesRequest {
+	r.pathPrefix = &pathPrefix
+	return r
+}
+
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceListUserTagsRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListUserTagsRequest {
+func (r ApiIamServiceListRolesRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListRolesRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceListUserTagsRequest) Execute() (*IamServiceListUserTagsResponse, *http.Response, error) {
-	return r.ApiService.IamServiceListUserTagsExecute(r)
+func (r ApiIamServiceListRolesRequest) Execute() (*IamServiceListRolesResponse, *http.Response, error) {
+	return r.ApiService.IamServiceListRolesExecute(r)
 }
 
 /*
-IamServiceListUserTags Lists the tags that are attached to the specified IAM User.
+IamServiceListRoles Lists the IAM roles.
 
-Lists the tags that are attached to the specified IAM User.
+Lists the IAM roles.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceListUserTagsRequest
+	@return ApiIamServiceListRolesRequest
 */
-func (a *IamApiService) IamServiceListUserTags(ctx context.Context) ApiIamServiceListUserTagsRequest {
-	return ApiIamServiceListUserTagsRequest{
+func (a *IamApiService) IamServiceListRoles(ctx context.Context) ApiIamServiceListRolesRequest {
+	return ApiIamServiceListRolesRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -8898,35 +8912,35 @@ func (a *IamApiService) IamServiceListUserTags(ctx context.Context) ApiIamServic
 
 // Execute executes the request
 //
-//	@return IamServiceListUserTagsResponse
-func (a *IamApiService) IamServiceListUserTagsExecute(r ApiIamServiceListUserTagsRequest) (*IamServiceListUserTagsResponse, *http.Response, error) {
+//	@return IamServiceListRolesResponse
+func (a *IamApiService) IamServiceListRolesExecute(r ApiIamServiceListRolesRequest) (*IamServiceListRolesResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceListUserTagsResponse
+		localVarReturnValue *IamServiceListRolesResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListUserTags")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListRoles")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=ListUserTags"
+	localVarPath := localBasePath + "/iam?Action=ListRoles"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.userName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
-	}
 	if r.marker != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "Marker", r.marker, "")
 	}
 	if r.maxItems != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
 	}
+	if r.pathPrefix != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PathPrefix", r.pathPrefix, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -9030,53 +9044,46 @@ func (a *IamApiService) IamServiceListUserTagsExecute(r ApiIamServiceListUserTag
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceListUsersRequest struct {
+type ApiIamServiceListSAMLProvidersRequest struct {
 	ctx           context.Context
 	ApiService    *IamApiService
 	marker        *string
 	maxItems      *int32
-	pathPrefix    *string
 	xEmcNamespace *string
 }
 
-// Marker is obtained from paginated response from the previous query. Use this only if the response indicates it is truncated.
-func (r ApiIamServiceListUsersRequest) Marker(marker string) ApiIamServiceListUsersRequest {
+// For pagination, the value of the Marker element in the response that you received to indicate where the next call should start.
+func (r ApiIamServiceListSAMLProvidersRequest) Marker(marker string) ApiIamServiceListSAMLProvidersRequest {
 	r.marker = &marker
 	return r
 }
 
-// Indicates the maximum number of elements to be returned in the response.
-func (r ApiIamServiceListUsersRequest) MaxItems(maxItems int32) ApiIamServiceListUsersRequest {
+// Use this only when paginating results to indicate the maximum number of items you want in the response.  If additional items exist beyond the maximum you specify, the IsTruncated response element is true and  Marker contains a value to include in the subsequent call that tells the service where to continue from.
+func (r ApiIamServiceListSAMLProvidersRequest) MaxItems(maxItems int32) ApiIamServiceListSAMLProvidersRequest {
 	r.maxItems = &maxItems
 	return r
 }
 
-// Path prefix for filtering the results. Optional, default to \&quot;/\&quot;. Only \&quot;/\&quot; is allowed.
-func (r ApiIamServiceListUsersRequest) PathPrefix(pathPrefix string) ApiIamServiceListUsersRequest {
-	r.pathPrefix = &pathPrefix
-	return r
-}
-
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceListUsersRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListUsersRequest {
+func (r ApiIamServiceListSAMLProvidersRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListSAMLProvidersRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceListUsersRequest) Execute() (*IamServiceListUsersResponse, *http.Response, error) {
-	return r.ApiService.IamServiceListUsersExecute(r)
+func (r ApiIamServiceListSAMLProvidersRequest) Execute() (*IamServiceListSAMLProvidersResponse, *http.Response, error) {
+	return r.ApiService.IamServiceListSAMLProvidersExecute(r)
 }
 
 /*
-IamServiceListUsers Lists the IAM users.
+IamServiceListSAMLProviders List the SAML Identity Providers.
 
-Lists the IAM users.
+List the SAML Identity Providers.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceListUsersRequest
+	@return ApiIamServiceListSAMLProvidersRequest
 */
-func (a *IamApiService) IamServiceListUsers(ctx context.Context) ApiIamServiceListUsersRequest {
-	return ApiIamServiceListUsersRequest{
+func (a *IamApiService) IamServiceListSAMLProviders(ctx context.Context) ApiIamServiceListSAMLProvidersRequest {
+	return ApiIamServiceListSAMLProvidersRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -9084,21 +9091,21 @@ func (a *IamApiService) IamServiceListUsers(ctx context.Context) ApiIamServiceLi
 
 // Execute executes the request
 //
-//	@return IamServiceListUsersResponse
-func (a *IamApiService) IamServiceListUsersExecute(r ApiIamServiceListUsersRequest) (*IamServiceListUsersResponse, *http.Response, error) {
+//	@return IamServiceListSAMLProvidersResponse
+func (a *IamApiService) IamServiceListSAMLProvidersExecute(r ApiIamServiceListSAMLProvidersRequest) (*IamServiceListSAMLProvidersResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IamServiceListUsersResponse
+		localVarReturnValue *IamServiceListSAMLProvidersResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListUsers")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListSAMLProviders")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=ListUsers"
+	localVarPath := localBasePath + "/iam?Action=ListSAMLProviders"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -9110,9 +9117,6 @@ func (a *IamApiService) IamServiceListUsersExecute(r ApiIamServiceListUsersReque
 	if r.maxItems != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
 	}
-	if r.pathPrefix != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PathPrefix", r.pathPrefix, "")
-	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -9216,53 +9220,53 @@ func (a *IamApiService) IamServiceListUsersExecute(r ApiIamServiceListUsersReque
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServicePutGroupPolicyRequest struct {
-	ctx            context.Context
-	ApiService     *IamApiService
-	policyDocument *string
-	groupName      *string
-	policyName     *string
-	xEmcNamespace  *string
+type ApiIamServiceListUserPoliciesRequest struct {
+	ctx           context.Context
+	ApiService    *IamApiService
+	userName      *string
+	marker        *string
+	maxItems      *int32
+	xEmcNamespace *string
 }
 
-// The policy document in JSON format.
-func (r ApiIamServicePutGroupPolicyRequest) PolicyDocument(policyDocument string) ApiIamServicePutGroupPolicyRequest {
-	r.policyDocument = &policyDocument
+// The name of the user to list attached policies for.
+func (r ApiIamServiceListUserPoliciesRequest) UserName(userName string) ApiIamServiceListUserPoliciesRequest {
+	r.userName = &userName
 	return r
 }
 
-// Simple name identifying the group.
-func (r ApiIamServicePutGroupPolicyRequest) GroupName(groupName string) ApiIamServicePutGroupPolicyRequest {
-	r.groupName = &groupName
+// Marker is obtained from paginated response from the previous query. Use this only if the response indicates it is truncated.
+func (r ApiIamServiceListUserPoliciesRequest) Marker(marker string) ApiIamServiceListUserPoliciesRequest {
+	r.marker = &marker
 	return r
 }
 
-// Simple name identifying the policy.
-func (r ApiIamServicePutGroupPolicyRequest) PolicyName(policyName string) ApiIamServicePutGroupPolicyRequest {
-	r.policyName = &policyName
+// Indicates the maximum number of elements to be returned in the response.
+func (r ApiIamServiceListUserPoliciesRequest) MaxItems(maxItems int32) ApiIamServiceListUserPoliciesRequest {
+	r.maxItems = &maxItems
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServicePutGroupPolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServicePutGroupPolicyRequest {
+func (r ApiIamServiceListUserPoliciesRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListUserPoliciesRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServicePutGroupPolicyRequest) Execute() (*BasicResponse, *http.Response, error) {
-	return r.ApiService.IamServicePutGroupPolicyExecute(r)
+func (r ApiIamServiceListUserPoliciesRequest) Execute() (*IamServiceListUserPoliciesResponse, *http.Response, error) {
+	return r.ApiService.IamServiceListUserPoliciesExecute(r)
 }
 
 /*
-IamServicePutGroupPolicy Add or Update Inline Policy for IAM Group.
+IamServiceListUserPolicies List Inline Policies for IAM User.
 
-Add or Update Inline Policy for IAM Group.
+List Inline Policies for IAM User.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServicePutGroupPolicyRequest
+	@return ApiIamServiceListUserPoliciesRequest
 */
-func (a *IamApiService) IamServicePutGroupPolicy(ctx context.Context) ApiIamServicePutGroupPolicyRequest {
-	return ApiIamServicePutGroupPolicyRequest{
+func (a *IamApiService) IamServiceListUserPolicies(ctx context.Context) ApiIamServiceListUserPoliciesRequest {
+	return ApiIamServiceListUserPoliciesRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -9270,34 +9274,34 @@ func (a *IamApiService) IamServicePutGroupPolicy(ctx context.Context) ApiIamServ
 
 // Execute executes the request
 //
-//	@return BasicResponse
-func (a *IamApiService) IamServicePutGroupPolicyExecute(r ApiIamServicePutGroupPolicyRequest) (*BasicResponse, *http.Response, error) {
+//	@return IamServiceListUserPoliciesResponse
+func (a *IamApiService) IamServiceListUserPoliciesExecute(r ApiIamServiceListUserPoliciesRequest) (*IamServiceListUserPoliciesResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *BasicResponse
+		localVarReturnValue *IamServiceListUserPoliciesResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServicePutGroupPolicy")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListUserPolicies")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=PutGroupPolicy"
+	localVarPath := localBasePath + "/iam?Action=ListUserPolicies"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.policyDocument != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyDocument", r.policyDocument, "")
+	if r.userName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
 	}
-	if r.groupName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "GroupName", r.groupName, "")
+	if r.marker != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "Marker", r.marker, "")
 	}
-	if r.policyName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyName", r.policyName, "")
+	if r.maxItems != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -9402,46 +9406,53 @@ func (a *IamApiService) IamServicePutGroupPolicyExecute(r ApiIamServicePutGroupP
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServicePutRolePermissionsBoundaryRequest struct {
-	ctx                 context.Context
-	ApiService          *IamApiService
-	permissionsBoundary *string
-	roleName            *string
-	xEmcNamespace       *string
+type ApiIamServiceListUserTagsRequest struct {
+	ctx           context.Context
+	ApiService    *IamApiService
+	userName      *string
+	marker        *string
+	maxItems      *int32
+	xEmcNamespace *string
 }
 
-// Arn that identifies the policy.
-func (r ApiIamServicePutRolePermissionsBoundaryRequest) PermissionsBoundary(permissionsBoundary string) ApiIamServicePutRolePermissionsBoundaryRequest {
-	r.permissionsBoundary = &permissionsBoundary
+// Simple name identifying the user.
+func (r ApiIamServiceListUserTagsRequest) UserName(userName string) ApiIamServiceListUserTagsRequest {
+	r.userName = &userName
 	return r
 }
 
-// Simple name identifying the role.
-func (r ApiIamServicePutRolePermissionsBoundaryRequest) RoleName(roleName string) ApiIamServicePutRolePermissionsBoundaryRequest {
-	r.roleName = &roleName
+// For pagination, the value of the Marker element in the response that you received to indicate where the next call should start.
+func (r ApiIamServiceListUserTagsRequest) Marker(marker string) ApiIamServiceListUserTagsRequest {
+	r.marker = &marker
+	return r
+}
+
+// Use this only when paginating results to indicate the maximum number of items you want in the response.  If additional items exist beyond the maximum you specify, the IsTruncated response element is true and  Marker contains a value to include in the subsequent call that tells the service where to continue from.
+func (r ApiIamServiceListUserTagsRequest) MaxItems(maxItems int32) ApiIamServiceListUserTagsRequest {
+	r.maxItems = &maxItems
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServicePutRolePermissionsBoundaryRequest) XEmcNamespace(xEmcNamespace string) ApiIamServicePutRolePermissionsBoundaryRequest {
+func (r ApiIamServiceListUserTagsRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListUserTagsRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServicePutRolePermissionsBoundaryRequest) Execute() (*BasicResponse, *http.Response, error) {
-	return r.ApiService.IamServicePutRolePermissionsBoundaryExecute(r)
+func (r ApiIamServiceListUserTagsRequest) Execute() (*IamServiceListUserTagsResponse, *http.Response, error) {
+	return r.ApiService.IamServiceListUserTagsExecute(r)
 }
 
 /*
-IamServicePutRolePermissionsBoundary Adds or updates the policy that is specified as the IAM role's permissions boundary.
+IamServiceListUserTags Lists the tags that are attached to the specified IAM User.
 
-Adds or updates the policy that is specified as the IAM role's permissions boundary.
+Lists the tags that are attached to the specified IAM User.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServicePutRolePermissionsBoundaryRequest
+	@return ApiIamServiceListUserTagsRequest
 */
-func (a *IamApiService) IamServicePutRolePermissionsBoundary(ctx context.Context) ApiIamServicePutRolePermissionsBoundaryRequest {
-	return ApiIamServicePutRolePermissionsBoundaryRequest{
+func (a *IamApiService) IamServiceListUserTags(ctx context.Context) ApiIamServiceListUserTagsRequest {
+	return ApiIamServiceListUserTagsRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -9449,31 +9460,34 @@ func (a *IamApiService) IamServicePutRolePermissionsBoundary(ctx context.Context
 
 // Execute executes the request
 //
-//	@return BasicResponse
-func (a *IamApiService) IamServicePutRolePermissionsBoundaryExecute(r ApiIamServicePutRolePermissionsBoundaryRequest) (*BasicResponse, *http.Response, error) {
+//	@return IamServiceListUserTagsResponse
+func (a *IamApiService) IamServiceListUserTagsExecute(r ApiIamServiceListUserTagsRequest) (*IamServiceListUserTagsResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *BasicResponse
+		localVarReturnValue *IamServiceListUserTagsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServicePutRolePermissionsBoundary")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListUserTags")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=PutRolePermissionsBoundary"
+	localVarPath := localBasePath + "/iam?Action=ListUserTags"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.permissionsBoundary != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PermissionsBoundary", r.permissionsBoundary, "")
+	if r.userName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
 	}
-	if r.roleName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "RoleName", r.roleName, "")
+	if r.marker != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "Marker", r.marker, "")
+	}
+	if r.maxItems != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -9578,53 +9592,53 @@ func (a *IamApiService) IamServicePutRolePermissionsBoundaryExecute(r ApiIamServ
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServicePutRolePolicyRequest struct {
-	ctx            context.Context
-	ApiService     *IamApiService
-	policyDocument *string
-	roleName       *string
-	policyName     *string
-	xEmcNamespace  *string
+type ApiIamServiceListUsersRequest struct {
+	ctx           context.Context
+	ApiService    *IamApiService
+	marker        *string
+	maxItems      *int32
+	pathPrefix    *string
+	xEmcNamespace *string
 }
 
-// The policy document in JSON format.
-func (r ApiIamServicePutRolePolicyRequest) PolicyDocument(policyDocument string) ApiIamServicePutRolePolicyRequest {
-	r.policyDocument = &policyDocument
+// Marker is obtained from paginated response from the previous query. Use this only if the response indicates it is truncated.
+func (r ApiIamServiceListUsersRequest) Marker(marker string) ApiIamServiceListUsersRequest {
+	r.marker = &marker
 	return r
 }
 
-// Simple name identifying the role.
-func (r ApiIamServicePutRolePolicyRequest) RoleName(roleName string) ApiIamServicePutRolePolicyRequest {
-	r.roleName = &roleName
+// Indicates the maximum number of elements to be returned in the response.
+func (r ApiIamServiceListUsersRequest) MaxItems(maxItems int32) ApiIamServiceListUsersRequest {
+	r.maxItems = &maxItems
 	return r
 }
 
-// Simple name identifying the policy.
-func (r ApiIamServicePutRolePolicyRequest) PolicyName(policyName string) ApiIamServicePutRolePolicyRequest {
-	r.policyName = &policyName
+// Path prefix for filtering the results. Optional, default to \&quot;/\&quot;. Only \&quot;/\&quot; is allowed.
+func (r ApiIamServiceListUsersRequest) PathPrefix(pathPrefix string) ApiIamServiceListUsersRequest {
+	r.pathPrefix = &pathPrefix
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServicePutRolePolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServicePutRolePolicyRequest {
+func (r ApiIamServiceListUsersRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceListUsersRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServicePutRolePolicyRequest) Execute() (*BasicResponse, *http.Response, error) {
-	return r.ApiService.IamServicePutRolePolicyExecute(r)
+func (r ApiIamServiceListUsersRequest) Execute() (*IamServiceListUsersResponse, *http.Response, error) {
+	return r.ApiService.IamServiceListUsersExecute(r)
 }
 
 /*
-IamServicePutRolePolicy Adds or updates an inline policy document that is embedded in the specified IAM role.
+IamServiceListUsers Lists the IAM users.
 
-Adds or updates an inline policy document that is embedded in the specified IAM role.
+Lists the IAM users.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServicePutRolePolicyRequest
+	@return ApiIamServiceListUsersRequest
 */
-func (a *IamApiService) IamServicePutRolePolicy(ctx context.Context) ApiIamServicePutRolePolicyRequest {
-	return ApiIamServicePutRolePolicyRequest{
+func (a *IamApiService) IamServiceListUsers(ctx context.Context) ApiIamServiceListUsersRequest {
+	return ApiIamServiceListUsersRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -9632,34 +9646,34 @@ func (a *IamApiService) IamServicePutRolePolicy(ctx context.Context) ApiIamServi
 
 // Execute executes the request
 //
-//	@return BasicResponse
-func (a *IamApiService) IamServicePutRolePolicyExecute(r ApiIamServicePutRolePolicyRequest) (*BasicResponse, *http.Response, error) {
+//	@return IamServiceListUsersResponse
+func (a *IamApiService) IamServiceListUsersExecute(r ApiIamServiceListUsersRequest) (*IamServiceListUsersResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *BasicResponse
+		localVarReturnValue *IamServiceListUsersResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServicePutRolePolicy")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceListUsers")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=PutRolePolicy"
+	localVarPath := localBasePath + "/iam?Action=ListUsers"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.policyDocument != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyDocument", r.policyDocument, "")
+	if r.marker != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "Marker", r.marker, "")
 	}
-	if r.roleName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "RoleName", r.roleName, "")
+	if r.maxItems != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
 	}
-	if r.policyName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyName", r.policyName, "")
+	if r.pathPrefix != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PathPrefix", r.pathPrefix, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -9764,46 +9778,53 @@ func (a *IamApiService) IamServicePutRolePolicyExecute(r ApiIamServicePutRolePol
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServicePutUserPermissionsBoundaryRequest struct {
-	ctx                 context.Context
-	ApiService          *IamApiService
-	permissionsBoundary *string
-	userName            *string
-	xEmcNamespace       *string
+type ApiIamServicePutGroupPolicyRequest struct {
+	ctx            context.Context
+	ApiService     *IamApiService
+	policyDocument *string
+	groupName      *string
+	policyName     *string
+	xEmcNamespace  *string
 }
 
-// Arn of the Policy which is to be set as Permission Boundary for the user.
-func (r ApiIamServicePutUserPermissionsBoundaryRequest) PermissionsBoundary(permissionsBoundary string) ApiIamServicePutUserPermissionsBoundaryRequest {
-	r.permissionsBoundary = &permissionsBoundary
+// The policy document in JSON format.
+func (r ApiIamServicePutGroupPolicyRequest) PolicyDocument(policyDocument string) ApiIamServicePutGroupPolicyRequest {
+	r.policyDocument = &policyDocument
 	return r
 }
 
-// Urn of the user whose Permission Boundary is to be added/updated.
-func (r ApiIamServicePutUserPermissionsBoundaryRequest) UserName(userName string) ApiIamServicePutUserPermissionsBoundaryRequest {
-	r.userName = &userName
+// Simple name identifying the group.
+func (r ApiIamServicePutGroupPolicyRequest) GroupName(groupName string) ApiIamServicePutGroupPolicyRequest {
+	r.groupName = &groupName
+	return r
+}
+
+// Simple name identifying the policy.
+func (r ApiIamServicePutGroupPolicyRequest) PolicyName(policyName string) ApiIamServicePutGroupPolicyRequest {
+	r.policyName = &policyName
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServicePutUserPermissionsBoundaryRequest) XEmcNamespace(xEmcNamespace string) ApiIamServicePutUserPermissionsBoundaryRequest {
+func (r ApiIamServicePutGroupPolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServicePutGroupPolicyRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServicePutUserPermissionsBoundaryRequest) Execute() (*BasicResponse, *http.Response, error) {
-	return r.ApiService.IamServicePutUserPermissionsBoundaryExecute(r)
+func (r ApiIamServicePutGroupPolicyRequest) Execute() (*BasicResponse, *http.Response, error) {
+	return r.ApiService.IamServicePutGroupPolicyExecute(r)
 }
 
 /*
-IamServicePutUserPermissionsBoundary Update User's PermissionsBoundary.
+IamServicePutGroupPolicy Add or Update Inline Policy for IAM Group.
 
-Update User's PermissionsBoundary.
+Add or Update Inline Policy for IAM Group.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServicePutUserPermissionsBoundaryRequest
+	@return ApiIamServicePutGroupPolicyRequest
 */
-func (a *IamApiService) IamServicePutUserPermissionsBoundary(ctx context.Context) ApiIamServicePutUserPermissionsBoundaryRequest {
-	return ApiIamServicePutUserPermissionsBoundaryRequest{
+func (a *IamApiService) IamServicePutGroupPolicy(ctx context.Context) ApiIamServicePutGroupPolicyRequest {
+	return ApiIamServicePutGroupPolicyRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -9812,7 +9833,7 @@ func (a *IamApiService) IamServicePutUserPermissionsBoundary(ctx context.Context
 // Execute executes the request
 //
 //	@return BasicResponse
-func (a *IamApiService) IamServicePutUserPermissionsBoundaryExecute(r ApiIamServicePutUserPermissionsBoundaryRequest) (*BasicResponse, *http.Response, error) {
+func (a *IamApiService) IamServicePutGroupPolicyExecute(r ApiIamServicePutGroupPolicyRequest) (*BasicResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -9820,22 +9841,25 @@ func (a *IamApiService) IamServicePutUserPermissionsBoundaryExecute(r ApiIamServ
 		localVarReturnValue *BasicResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServicePutUserPermissionsBoundary")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServicePutGroupPolicy")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=PutUserPermissionsBoundary"
+	localVarPath := localBasePath + "/iam?Action=PutGroupPolicy"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.permissionsBoundary != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PermissionsBoundary", r.permissionsBoundary, "")
+	if r.policyDocument != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyDocument", r.policyDocument, "")
 	}
-	if r.userName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
+	if r.groupName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "GroupName", r.groupName, "")
+	}
+	if r.policyName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyName", r.policyName, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -9940,53 +9964,46 @@ func (a *IamApiService) IamServicePutUserPermissionsBoundaryExecute(r ApiIamServ
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServicePutUserPolicyRequest struct {
-	ctx            context.Context
-	ApiService     *IamApiService
-	policyDocument *string
-	policyName     *string
-	userName       *string
-	xEmcNamespace  *string
-}
-
-// The policy document in JSON format.
-func (r ApiIamServicePutUserPolicyRequest) PolicyDocument(policyDocument string) ApiIamServicePutUserPolicyRequest {
-	r.policyDocument = &policyDocument
-	return r
+type ApiIamServicePutRolePermissionsBoundaryRequest struct {
+	ctx                 context.Context
+	ApiService          *IamApiService
+	permissionsBoundary *string
+	roleName            *string
+	xEmcNamespace       *string
 }
 
-// Simple name identifying the policy.
-func (r ApiIamServicePutUserPolicyRequest) PolicyName(policyName string) ApiIamServicePutUserPolicyRequest {
-	r.policyName = &policyName
+// Arn that identifies the policy.
+func (r ApiIamServicePutRolePermissionsBoundaryRequest) PermissionsBoundary(permissionsBoundary string) ApiIamServicePutRolePermissionsBoundaryRequest {
+	r.permissionsBoundary = &permissionsBoundary
 	return r
 }
 
-// Simple name identifying the user.
-func (r ApiIamServicePutUserPolicyRequest) UserName(userName string) ApiIamServicePutUserPolicyRequest {
-	r.userName = &userName
+// Simple name identifying the role.
+func (r ApiIamServicePutRolePermissionsBoundaryRequest) RoleName(roleName string) ApiIamServicePutRolePermissionsBoundaryRequest {
+	r.roleName = &roleName
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServicePutUserPolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServicePutUserPolicyRequest {
+func (r ApiIamServicePutRolePermissionsBoundaryRequest) XEmcNamespace(xEmcNamespace string) ApiIamServicePutRolePermissionsBoundaryRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServicePutUserPolicyRequest) Execute() (*BasicResponse, *http.Response, error) {
-	return r.ApiService.IamServicePutUserPolicyExecute(r)
+func (r ApiIamServicePutRolePermissionsBoundaryRequest) Execute() (*BasicResponse, *http.Response, error) {
+	return r.ApiService.IamServicePutRolePermissionsBoundaryExecute(r)
 }
 
 /*
-IamServicePutUserPolicy Add or Update Inline Policy for IAM User.
+IamServicePutRolePermissionsBoundary Adds or updates the policy that is specified as the IAM role's permissions boundary.
 
-Add or Update Inline Policy for IAM User.
+Adds or updates the policy that is specified as the IAM role's permissions boundary.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServicePutUserPolicyRequest
+	@return ApiIamServicePutRolePermissionsBoundaryRequest
 */
-func (a *IamApiService) IamServicePutUserPolicy(ctx context.Context) ApiIamServicePutUserPolicyRequest {
-	return ApiIamServicePutUserPolicyRequest{
+func (a *IamApiService) IamServicePutRolePermissionsBoundary(ctx context.Context) ApiIamServicePutRolePermissionsBoundaryRequest {
+	return ApiIamServicePutRolePermissionsBoundaryRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -9995,7 +10012,7 @@ func (a *IamApiService) IamServicePutUserPolicy(ctx context.Context) ApiIamServi
 // Execute executes the request
 //
 //	@return BasicResponse
-func (a *IamApiService) IamServicePutUserPolicyExecute(r ApiIamServicePutUserPolicyRequest) (*BasicResponse, *http.Response, error) {
+func (a *IamApiService) IamServicePutRolePermissionsBoundaryExecute(r ApiIamServicePutRolePermissionsBoundaryRequest) (*BasicResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -10003,25 +10020,22 @@ func (a *IamApiService) IamServicePutUserPolicyExecute(r ApiIamServicePutUserPol
 		localVarReturnValue *BasicResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServicePutUserPolicy")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServicePutRolePermissionsBoundary")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=PutUserPolicy"
+	localVarPath := localBasePath + "/iam?Action=PutRolePermissionsBoundary"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.policyDocument != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyDocument", r.policyDocument, "")
-	}
-	if r.policyName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyName", r.policyName, "")
+	if r.permissionsBoundary != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PermissionsBoundary", r.permissionsBoundary, "")
 	}
-	if r.userName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
+	if r.roleName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "RoleName", r.roleName, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -10126,46 +10140,53 @@ func (a *IamApiService) IamServicePutUserPolicyExecute(r ApiIamServicePutUserPol
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceRemoveUserFromGroupRequest struct {
-	ctx           context.Context
-	ApiService    *IamApiService
-	groupName     *string
-	userName      *string
-	xEmcNamespace *string
+type ApiIamServicePutRolePolicyRequest struct {
+	ctx            context.Context
+	ApiService     *IamApiService
+	policyDocument *string
+	roleName       *string
+	policyName     *string
+	xEmcNamespace  *string
 }
 
-// The name of the group to update.
-func (r ApiIamServiceRemoveUserFromGroupRequest) GroupName(groupName string) ApiIamServiceRemoveUserFromGroupRequest {
-	r.groupName = &groupName
+// The policy document in JSON format.
+func (r ApiIamServicePutRolePolicyRequest) PolicyDocument(policyDocument string) ApiIamServicePutRolePolicyRequest {
+	r.policyDocument = &policyDocument
 	return r
 }
 
-// The name of the user to be removed.
-func (r ApiIamServiceRemoveUserFromGroupRequest) UserName(userName string) ApiIamServiceRemoveUserFromGroupRequest {
-	r.userName = &userName
+// Simple name identifying the role.
+func (r ApiIamServicePutRolePolicyRequest) RoleName(roleName string) ApiIamServicePutRolePolicyRequest {
+	r.roleName = &roleName
+	return r
+}
+
+// Simple name identifying the policy.
+func (r ApiIamServicePutRolePolicyRequest) PolicyName(policyName string) ApiIamServicePutRolePolicyRequest {
+	r.policyName = &policyName
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceRemoveUserFromGroupRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceRemoveUserFromGroupRequest {
+func (r ApiIamServicePutRolePolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServicePutRolePolicyRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceRemoveUserFromGroupRequest) Execute() (*BasicResponse, *http.Response, error) {
-	return r.ApiService.IamServiceRemoveUserFromGroupExecute(r)
+func (r ApiIamServicePutRolePolicyRequest) Execute() (*BasicResponse, *http.Response, error) {
+	return r.ApiService.IamServicePutRolePolicyExecute(r)
 }
 
 /*
-IamServiceRemoveUserFromGroup Remove User from a Group.
+IamServicePutRolePolicy Adds or updates an inline policy document that is embedded in the specified IAM role.
 
-Remove User from a Group.
+Adds or updates an inline policy document that is embedded in the specified IAM role.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceRemoveUserFromGroupRequest
+	@return ApiIamServicePutRolePolicyRequest
 */
-func (a *IamApiService) IamServiceRemoveUserFromGroup(ctx context.Context) ApiIamServiceRemoveUserFromGroupRequest {
-	return ApiIamServiceRemoveUserFromGroupRequest{
+func (a *IamApiService) IamServicePutRolePolicy(ctx context.Context) ApiIamServicePutRolePolicyRequest {
+	return ApiIamServicePutRolePolicyRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -10174,7 +10195,7 @@ func (a *IamApiService) IamServiceRemoveUserFromGroup(ctx context.Context) ApiIa
 // Execute executes the request
 //
 //	@return BasicResponse
-func (a *IamApiService) IamServiceRemoveUserFromGroupExecute(r ApiIamServiceRemoveUserFromGroupRequest) (*BasicResponse, *http.Response, error) {
+func (a *IamApiService) IamServicePutRolePolicyExecute(r ApiIamServicePutRolePolicyRequest) (*BasicResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -10182,22 +10203,25 @@ func (a *IamApiService) IamServiceRemoveUserFromGroupExecute(r ApiIamServiceRemo
 		localVarReturnValue *BasicResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceRemoveUserFromGroup")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServicePutRolePolicy")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/iam?Action=RemoveUserFromGroup"
+	localVarPath := localBasePath + "/iam?Action=PutRolePolicy"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.groupName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "GroupName", r.groupName, "")
+	if r.policyDocument != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyDocument", r.policyDocument, "")
 	}
-	if r.userName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
+	if r.roleName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "RoleName", r.roleName, "")
+	}
+	if r.policyName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyName", r.policyName, "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -10302,46 +10326,46 @@ func (a *IamApiService) IamServiceRemoveUserFromGroupExecute(r ApiIamServiceRemo
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIamServiceSetDefaultPolicyVersionRequest struct {
-	ctx           context.Context
-	ApiService    *IamApiService
-	policyArn     *string
-	versionId     *string
-	xEmcNamespace *string
+type ApiIamServicePutUserPermissionsBoundaryRequest struct {
+	ctx                 context.Context
+	ApiService          *IamApiService
+	permissionsBoundary *string
+	userName            *string
+	xEmcNamespace       *string
 }
 
-// ARN of the IAM Managed policy to set default version.
-func (r ApiIamServiceSetDefaultPolicyVersionRequest) PolicyArn(policyArn string) ApiIamServiceSetDefaultPolicyVersionRequest {
-	r.policyArn = &policyArn
+// Arn of the Policy which is to be set as Permission Boundary for the user.
+func (r ApiIamServicePutUserPermissionsBoundaryRequest) PermissionsBoundary(permissionsBoundary string) ApiIamServicePutUserPermissionsBoundaryRequest {
+	r.permissionsBoundary = &permissionsBoundary
 	return r
 }
 
-// Policy Version to be set as default.
-func (r ApiIamServiceSetDefaultPolicyVersionRequest) VersionId(versionId string) ApiIamServiceSetDefaultPolicyVersionRequest {
-	r.versionId = &versionId
+// Urn of the user whose Permission Boundary is to be added/updated.
+func (r ApiIamServicePutUserPermissionsBoundaryRequest) UserName(userName string) ApiIamServicePutUserPermissionsBoundaryRequest {
+	r.userName = &userName
 	return r
 }
 
 // ECS namespace IAM entity belongs to, only required when request performed by management user
-func (r ApiIamServiceSetDefaultPolicyVersionRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceSetDefaultPolicyVersionRequest {
+func (r ApiIamServicePutUserPermissionsBoundaryRequest) XEmcNamespace(xEmcNamespace string) ApiIamServicePutUserPermissionsBoundaryRequest {
 	r.xEmcNamespace = &xEmcNamespace
 	return r
 }
 
-func (r ApiIamServiceSetDefaultPolicyVersionRequest) Execute() (*BasicResponse, *http.Response, error) {
-	return r.ApiService.IamServiceSetDefaultPolicyVersionExecute(r)
+func (r ApiIamServicePutUserPermissionsBoundaryRequest) Execute() (*BasicResponse, *http.Response, error) {
+	return r.ApiService.IamServicePutUserPermissionsBoundaryExecute(r)
 }
 
 /*
-IamServiceSetDefaultPolicyVersion set default version of Managed Policy.
+IamServicePutUserPermissionsBoundary Update User's PermissionsBoundary.
 
-set default version of Managed Policy.
+Update User's PermissionsBoundary.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIamServiceSetDefaultPolicyVersionRequest
+	@return ApiIamServicePutUserPermissionsBoundaryRequest
 */
-func (a *IamApiService) IamServiceSetDefaultPolicyVersion(ctx context.Context) ApiIamServiceSetDefaultPolicyVersionRequest {
-	return ApiIamServiceSetDefaultPolicyVersionRequest{
+func (a *IamApiService) IamServicePutUserPermissionsBoundary(ctx context.Context) ApiIamServicePutUserPermissionsBoundaryRequest {
+	return ApiIamServicePutUserPermissionsBoundaryRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -10350,7 +10374,7 @@ func (a *IamApiService) IamServiceSetDefaultPolicyVersion(ctx context.Context) A
 // Execute executes the request
 //
 //	@return BasicResponse
-func (a *IamApiService) IamServiceSetDefaultPolicyVersionExecute(r ApiIamServiceSetDefaultPolicyVersionRequest) (*BasicResponse, *http.Response, error) {
+func (a *IamApiService) IamServicePutUserPermissionsBoundaryExecute(r ApiIamServicePutUserPermissionsBoundaryRequest) (*BasicResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
@@ -10358,7 +10382,545 @@ func (a *IamApiService) IamServiceSetDefaultPolicyVersionExecute(r ApiIamService
 		localVarReturnValue *BasicResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceSetDefaultPolicyVersion")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServicePutUserPermissionsBoundary")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/iam?Action=PutUserPermissionsBoundary"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.permissionsBoundary != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PermissionsBoundary", r.permissionsBoundary, "")
+	}
+	if r.userName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.xEmcNamespace != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "x-emc-namespace", r.xEmcNamespace, "")
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["AuthToken"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["X-SDS-AUTH-TOKEN"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiIamServicePutUserPolicyRequest struct {
+	ctx            context.Context
+	ApiService     *IamApiService
+	policyDocument *string
+	policyName     *string
+	userName       *string
+	xEmcNamespace  *string
+}
+
+// The policy document in JSON format.
+func (r ApiIamServicePutUserPolicyRequest) PolicyDocument(policyDocument string) ApiIamServicePutUserPolicyRequest {
+	r.policyDocument = &policyDocument
+	return r
+}
+
+// Simple name identifying the policy.
+func (r ApiIamServicePutUserPolicyRequest) PolicyName(policyName string) ApiIamServicePutUserPolicyRequest {
+	r.policyName = &policyName
+	return r
+}
+
+// Simple name identifying the user.
+func (r ApiIamServicePutUserPolicyRequest) UserName(userName string) ApiIamServicePutUserPolicyRequest {
+	r.userName = &userName
+	return r
+}
+
+// ECS namespace IAM entity belongs to, only required when request performed by management user
+func (r ApiIamServicePutUserPolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServicePutUserPolicyRequest {
+	r.xEmcNamespace = &xEmcNamespace
+	return r
+}
+
+func (r ApiIamServicePutUserPolicyRequest) Execute() (*BasicResponse, *http.Response, error) {
+	return r.ApiService.IamServicePutUserPolicyExecute(r)
+}
+
+/*
+IamServicePutUserPolicy Add or Update Inline Policy for IAM User.
+
+Add or Update Inline Policy for IAM User.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiIamServicePutUserPolicyRequest
+*/
+func (a *IamApiService) IamServicePutUserPolicy(ctx context.Context) ApiIamServicePutUserPolicyRequest {
+	return ApiIamServicePutUserPolicyRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return BasicResponse
+func (a *IamApiService) IamServicePutUserPolicyExecute(r ApiIamServicePutUserPolicyRequest) (*BasicResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *BasicResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServicePutUserPolicy")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/iam?Action=PutUserPolicy"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.policyDocument != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyDocument", r.policyDocument, "")
+	}
+	if r.policyName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyName", r.policyName, "")
+	}
+	if r.userName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.xEmcNamespace != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "x-emc-namespace", r.xEmcNamespace, "")
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["AuthToken"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["X-SDS-AUTH-TOKEN"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiIamServiceRemoveUserFromGroupRequest struct {
+	ctx           context.Context
+	ApiService    *IamApiService
+	groupName     *string
+	userName      *string
+	xEmcNamespace *string
+}
+
+// The name of the group to update.
+func (r ApiIamServiceRemoveUserFromGroupRequest) GroupName(groupName string) ApiIamServiceRemoveUserFromGroupRequest {
+	r.groupName = &groupName
+	return r
+}
+
+// The name of the user to be removed.
+func (r ApiIamServiceRemoveUserFromGroupRequest) UserName(userName string) ApiIamServiceRemoveUserFromGroupRequest {
+	r.userName = &userName
+	return r
+}
+
+// ECS namespace IAM entity belongs to, only required when request performed by management user
+func (r ApiIamServiceRemoveUserFromGroupRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceRemoveUserFromGroupRequest {
+	r.xEmcNamespace = &xEmcNamespace
+	return r
+}
+
+func (r ApiIamServiceRemoveUserFromGroupRequest) Execute() (*BasicResponse, *http.Response, error) {
+	return r.ApiService.IamServiceRemoveUserFromGroupExecute(r)
+}
+
+/*
+IamServiceRemoveUserFromGroup Remove User from a Group.
+
+Remove User from a Group.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiIamServiceRemoveUserFromGroupRequest
+*/
+func (a *IamApiService) IamServiceRemoveUserFromGroup(ctx context.Context) ApiIamServiceRemoveUserFromGroupRequest {
+	return ApiIamServiceRemoveUserFromGroupRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return BasicResponse
+func (a *IamApiService) IamServiceRemoveUserFromGroupExecute(r ApiIamServiceRemoveUserFromGroupRequest) (*BasicResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *BasicResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceRemoveUserFromGroup")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/iam?Action=RemoveUserFromGroup"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.groupName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "GroupName", r.groupName, "")
+	}
+	if r.userName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "UserName", r.userName, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.xEmcNamespace != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "x-emc-namespace", r.xEmcNamespace, "")
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["AuthToken"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["X-SDS-AUTH-TOKEN"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiIamServiceSetDefaultPolicyVersionRequest struct {
+	ctx           context.Context
+	ApiService    *IamApiService
+	policyArn     *string
+	versionId     *string
+	xEmcNamespace *string
+}
+
+// ARN of the IAM Managed policy to set default version.
+func (r ApiIamServiceSetDefaultPolicyVersionRequest) PolicyArn(policyArn string) ApiIamServiceSetDefaultPolicyVersionRequest {
+	r.policyArn = &policyArn
+	return r
+}
+
+// Policy Version to be set as default.
+func (r ApiIamServiceSetDefaultPolicyVersionRequest) VersionId(versionId string) ApiIamServiceSetDefaultPolicyVersionRequest {
+	r.versionId = &versionId
+	return r
+}
+
+// ECS namespace IAM entity belongs to, only required when request performed by management user
+func (r ApiIamServiceSetDefaultPolicyVersionRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceSetDefaultPolicyVersionRequest {
+	r.xEmcNamespace = &xEmcNamespace
+	return r
+}
+
+func (r ApiIamServiceSetDefaultPolicyVersionRequest) Execute() (*BasicResponse, *http.Response, error) {
+	return r.ApiService.IamServiceSetDefaultPolicyVersionExecute(r)
+}
+
+/*
+IamServiceSetDefaultPolicyVersion set default version of Managed Policy.
+
+set default version of Managed Policy.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiIamServiceSetDefaultPolicyVersionRequest
+*/
+func (a *IamApiService) IamServiceSetDefaultPolicyVersion(ctx context.Context) ApiIamServiceSetDefaultPolicyVersionRequest {
+	return ApiIamServiceSetDefaultPolicyVersionRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return BasicResponse
+func (a *IamApiService) IamServiceSetDefaultPolicyVersionExecute(r ApiIamServiceSetDefaultPolicyVersionRequest) (*BasicResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *BasicResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceSetDefaultPolicyVersion")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
@@ -10369,11 +10931,521 @@ func (a *IamApiService) IamServiceSetDefaultPolicyVersionExecute(r ApiIamService
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.policyArn != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyArn", r.policyArn, "")
+	if r.policyArn != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicyArn", r.policyArn, "")
+	}
+	if r.versionId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "VersionId", r.versionId, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.xEmcNamespace != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "x-emc-namespace", r.xEmcNamespace, "")
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["AuthToken"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["X-SDS-AUTH-TOKEN"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiIamServiceSimulateCustomPolicyRequest struct {
+	ctx                                       context.Context
+	ApiService                                *IamApiService
+	callerArn                                 *string
+	actionNamesMemberN                        *[]string
+	resourceArnsMemberN                       *[]string
+	policyInputListMemberN                    *[]string
+	permissionsBoundaryPolicyInputListMemberN *[]string
+	contextEntriesMemberN                     *[]IamContextEntry
+	marker                                    *string
+	maxItems                                  *int32
+	xEmcNamespace                             *string
+}
+
+// The ARN of the IAM user that you want to specify as the simulated caller of the API operations.
+func (r ApiIamServiceSimulateCustomPolicyRequest) CallerArn(callerArn string) ApiIamServiceSimulateCustomPolicyRequest {
+	r.callerArn = &callerArn
+	return r
+}
+
+// A list of names of API operations to evaluate in the simulation.
+func (r ApiIamServiceSimulateCustomPolicyRequest) ActionNamesMemberN(actionNamesMemberN []string) ApiIamServiceSimulateCustomPolicyRequest {
+	r.actionNamesMemberN = &actionNamesMemberN
+	return r
+}
+
+// A list of ARNs of the resources to include in the simulation. Defaults to all resources.
+func (r ApiIamServiceSimulateCustomPolicyRequest) ResourceArnsMemberN(resourceArnsMemberN []string) ApiIamServiceSimulateCustomPolicyRequest {
+	r.resourceArnsMemberN = &resourceArnsMemberN
+	return r
+}
+
+// An optional list of additional policy documents to include in the simulation.
+func (r ApiIamServiceSimulateCustomPolicyRequest) PolicyInputListMemberN(policyInputListMemberN []string) ApiIamServiceSimulateCustomPolicyRequest {
+	r.policyInputListMemberN = &policyInputListMemberN
+	return r
+}
+
+// An optional list of additional PermissionBoundaryPolicy documents to include in the simulation. Only 1 is allowed.
+func (r ApiIamServiceSimulateCustomPolicyRequest) PermissionsBoundaryPolicyInputListMemberN(permissionsBoundaryPolicyInputListMemberN []string) ApiIamServiceSimulateCustomPolicyRequest {
+	r.permissionsBoundaryPolicyInputListMemberN = &permissionsBoundaryPolicyInputListMemberN
+	return r
+}
+
+// A list of context keys and corresponding values for the simulation to use.
+func (r ApiIamServiceSimulateCustomPolicyRequest) ContextEntriesMemberN(contextEntriesMemberN []IamContextEntry) ApiIamServiceSimulateCustomPolicyRequest {
+	r.contextEntriesMemberN = &contextEntriesMemberN
+	return r
+}
+
+// Marker is obtained from paginated response from the previous query. Use this only if the response indicates it is truncated.
+func (r ApiIamServiceSimulateCustomPolicyRequest) Marker(marker string) ApiIamServiceSimulateCustomPolicyRequest {
+	r.marker = &marker
+	return r
+}
+
+// Indicates the maximum number of elements to be returned in the response.
+func (r ApiIamServiceSimulateCustomPolicyRequest) MaxItems(maxItems int32) ApiIamServiceSimulateCustomPolicyRequest {
+	r.maxItems = &maxItems
+	return r
+}
+
+// ECS namespace IAM entity belongs to, only required when request performed by management user
+func (r ApiIamServiceSimulateCustomPolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceSimulateCustomPolicyRequest {
+	r.xEmcNamespace = &xEmcNamespace
+	return r
+}
+
+func (r ApiIamServiceSimulateCustomPolicyRequest) Execute() (*IamServiceSimulateCustomPolicyResponse, *http.Response, error) {
+	return r.ApiService.IamServiceSimulateCustomPolicyExecute(r)
+}
+
+/*
+IamServiceSimulateCustomPolicy Simulate Custom Policies with Actions and Resources to determine effective permissions.
+
+Simulate Custom Policies with Actions and Resources to determine effective permissions.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiIamServiceSimulateCustomPolicyRequest
+*/
+func (a *IamApiService) IamServiceSimulateCustomPolicy(ctx context.Context) ApiIamServiceSimulateCustomPolicyRequest {
+	return ApiIamServiceSimulateCustomPolicyRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return IamServiceSimulateCustomPolicyResponse
+func (a *IamApiService) IamServiceSimulateCustomPolicyExecute(r ApiIamServiceSimulateCustomPolicyRequest) (*IamServiceSimulateCustomPolicyResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *IamServiceSimulateCustomPolicyResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceSimulateCustomPolicy")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/iam?Action=SimulateCustomPolicy"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.callerArn != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "CallerArn", r.callerArn, "")
 	}
-	if r.versionId != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "VersionId", r.versionId, "")
+	if r.actionNamesMemberN != nil {
+		for i, item := range *r.actionNamesMemberN {
+			parameterAddToHeaderOrQuery(localVarQueryParams, fmt.Sprintf("ActionNames.member.%d", i+1), item, "")
+		}
+	}
+	if r.resourceArnsMemberN != nil {
+		for i, item := range *r.resourceArnsMemberN {
+			parameterAddToHeaderOrQuery(localVarQueryParams, fmt.Sprintf("ResourceArns.member.%d", i+1), item, "")
+		}
+	}
+	if r.policyInputListMemberN != nil {
+		for i, item := range *r.policyInputListMemberN {
+			parameterAddToHeaderOrQuery(localVarQueryParams, fmt.Sprintf("PolicyInputList.member.%d", i+1), item, "")
+		}
+	}
+	if r.permissionsBoundaryPolicyInputListMemberN != nil {
+		for i, item := range *r.permissionsBoundaryPolicyInputListMemberN {
+			parameterAddToHeaderOrQuery(localVarQueryParams, fmt.Sprintf("PermissionsBoundaryPolicyInputList.member.%d", i+1), item, "")
+		}
+	}
+	if r.contextEntriesMemberN != nil {
+		for i, item := range *r.contextEntriesMemberN {
+			parameterAddToHeaderOrQuery(localVarQueryParams, fmt.Sprintf("ContextEntries.member.%d.ContextKeyName", i+1), item.ContextKeyName, "")
+			parameterAddToHeaderOrQuery(localVarQueryParams, fmt.Sprintf("ContextEntries.member.%d.ContextKeyType", i+1), item.ContextKeyType, "")
+			for j, value := range item.ContextKeyValues {
+				parameterAddToHeaderOrQuery(localVarQueryParams, fmt.Sprintf("ContextEntries.member.%d.ContextKeyValues.member.%d", i+1, j+1), value, "")
+			}
+		}
+	}
+	if r.marker != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "Marker", r.marker, "")
+	}
+	if r.maxItems != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.xEmcNamespace != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "x-emc-namespace", r.xEmcNamespace, "")
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["AuthToken"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["X-SDS-AUTH-TOKEN"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v map[string]interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiIamServiceSimulatePrincipalPolicyRequest struct {
+	ctx                                       context.Context
+	ApiService                                *IamApiService
+	callerArn                                 *string
+	actionNamesMemberN                        *[]string
+	resourceArnsMemberN                       *[]string
+	policyInputListMemberN                    *[]string
+	contextEntriesMemberN                     *[]IamContextEntry
+	policySourceArn                           *string
+	marker                                    *string
+	maxItems                                  *int32
+	permissionsBoundaryPolicyInputListMemberN *[]string
+	xEmcNamespace                             *string
+}
+
+// The ARN of the IAM user that you want to specify as the simulated caller of the API operations.
+func (r ApiIamServiceSimulatePrincipalPolicyRequest) CallerArn(callerArn string) ApiIamServiceSimulatePrincipalPolicyRequest {
+	r.callerArn = &callerArn
+	return r
+}
+
+// A list of names of API operations to evaluate in the simulation.
+func (r ApiIamServiceSimulatePrincipalPolicyRequest) ActionNamesMemberN(actionNamesMemberN []string) ApiIamServiceSimulatePrincipalPolicyRequest {
+	r.actionNamesMemberN = &actionNamesMemberN
+	return r
+}
+
+// A list of ARNs of the resources to include in the simulation. Defaults to all resources.
+func (r ApiIamServiceSimulatePrincipalPolicyRequest) ResourceArnsMemberN(resourceArnsMemberN []string) ApiIamServiceSimulatePrincipalPolicyRequest {
+	r.resourceArnsMemberN = &resourceArnsMemberN
+	return r
+}
+
+// An optional list of additional policy documents to include in the simulation.
+func (r ApiIamServiceSimulatePrincipalPolicyRequest) PolicyInputListMemberN(policyInputListMemberN []string) ApiIamServiceSimulatePrincipalPolicyRequest {
+	r.policyInputListMemberN = &policyInputListMemberN
+	return r
+}
+
+// A list of context keys and corresponding values for the simulation to use.
+func (r ApiIamServiceSimulatePrincipalPolicyRequest) ContextEntriesMemberN(contextEntriesMemberN []IamContextEntry) ApiIamServiceSimulatePrincipalPolicyRequest {
+	r.contextEntriesMemberN = &contextEntriesMemberN
+	return r
+}
+
+// ARN of a user, group, or role whose policies you want to include in the simulation.
+func (r ApiIamServiceSimulatePrincipalPolicyRequest) PolicySourceArn(policySourceArn string) ApiIamServiceSimulatePrincipalPolicyRequest {
+	r.policySourceArn = &policySourceArn
+	return r
+}
+
+// Marker is obtained from paginated response from the previous query. Use this only if the response indicates it is truncated.
+func (r ApiIamServiceSimulatePrincipalPolicyRequest) Marker(marker string) ApiIamServiceSimulatePrincipalPolicyRequest {
+	r.marker = &marker
+	return r
+}
+
+// Indicates the maximum number of elements to be returned in the response.
+func (r ApiIamServiceSimulatePrincipalPolicyRequest) MaxItems(maxItems int32) ApiIamServiceSimulatePrincipalPolicyRequest {
+	r.maxItems = &maxItems
+	return r
+}
+
+// An optional list of additional PermissionBoundaryPolicy documents to include in the simulation. Only 1 is allowed.
+func (r ApiIamServiceSimulatePrincipalPolicyRequest) PermissionsBoundaryPolicyInputListMemberN(permissionsBoundaryPolicyInputListMemberN []string) ApiIamServiceSimulatePrincipalPolicyRequest {
+	r.permissionsBoundaryPolicyInputListMemberN = &permissionsBoundaryPolicyInputListMemberN
+	return r
+}
+
+// ECS namespace IAM entity belongs to, only required when request performed by management user
+func (r ApiIamServiceSimulatePrincipalPolicyRequest) XEmcNamespace(xEmcNamespace string) ApiIamServiceSimulatePrincipalPolicyRequest {
+	r.xEmcNamespace = &xEmcNamespace
+	return r
+}
+
+func (r ApiIamServiceSimulatePrincipalPolicyRequest) Execute() (*IamServiceSimulatePrincipalPolicyResponse, *http.Response, error) {
+	return r.ApiService.IamServiceSimulatePrincipalPolicyExecute(r)
+}
+
+/*
+IamServiceSimulatePrincipalPolicy Simulate Policies attached to entities with Actions and Resources to determine effective permissions.
+
+Simulate Policies attached to entities with Actions and Resources to determine effective permissions.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiIamServiceSimulatePrincipalPolicyRequest
+*/
+func (a *IamApiService) IamServiceSimulatePrincipalPolicy(ctx context.Context) ApiIamServiceSimulatePrincipalPolicyRequest {
+	return ApiIamServiceSimulatePrincipalPolicyRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return IamServiceSimulatePrincipalPolicyResponse
+func (a *IamApiService) IamServiceSimulatePrincipalPolicyExecute(r ApiIamServiceSimulatePrincipalPolicyRequest) (*IamServiceSimulatePrincipalPolicyResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *IamServiceSimulatePrincipalPolicyResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IamApiService.IamServiceSimulatePrincipalPolicy")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/iam?Action=SimulatePrincipalPolicy"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.callerArn != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "CallerArn", r.callerArn, "")
+	}
+	if r.actionNamesMemberN != nil {
+		for i, item := range *r.actionNamesMemberN {
+			parameterAddToHeaderOrQuery(localVarQueryParams, fmt.Sprintf("ActionNames.member.%d", i+1), item, "")
+		}
+	}
+	if r.resourceArnsMemberN != nil {
+		for i, item := range *r.resourceArnsMemberN {
+			parameterAddToHeaderOrQuery(localVarQueryParams, fmt.Sprintf("ResourceArns.member.%d", i+1), item, "")
+		}
+	}
+	if r.policyInputListMemberN != nil {
+		for i, item := range *r.policyInputListMemberN {
+			parameterAddToHeaderOrQuery(localVarQueryParams, fmt.Sprintf("PolicyInputList.member.%d", i+1), item, "")
+		}
+	}
+	if r.contextEntriesMemberN != nil {
+		for i, item := range *r.contextEntriesMemberN {
+			parameterAddToHeaderOrQuery(localVarQueryParams, fmt.Sprintf("ContextEntries.member.%d.ContextKeyName", i+1), item.ContextKeyName, "")
+			parameterAddToHeaderOrQuery(localVarQueryParams, fmt.Sprintf("ContextEntries.member.%d.ContextKeyType", i+1), item.ContextKeyType, "")
+			for j, value := range item.ContextKeyValues {
+				parameterAddToHeaderOrQuery(localVarQueryParams, fmt.Sprintf("ContextEntries.member.%d.ContextKeyValues.member.%d", i+1, j+1), value, "")
+			}
+		}
+	}
+	if r.policySourceArn != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "PolicySourceArn", r.policySourceArn, "")
+	}
+	if r.marker != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "Marker", r.marker, "")
+	}
+	if r.maxItems != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "MaxItems", r.maxItems, "")
+	}
+	if r.permissionsBoundaryPolicyInputListMemberN != nil {
+		for i, item := range *r.permissionsBoundaryPolicyInputListMemberN {
+			parameterAddToHeaderOrQuery(localVarQueryParams, fmt.Sprintf("PermissionsBoundaryPolicyInputList.member.%d", i+1), item, "")
+		}
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
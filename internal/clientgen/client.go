@@ -68,6 +68,8 @@ type APIClient struct {
 
 	UserSecretKeyApi *UserSecretKeyApiService
 
+	WebhookConfigurationApi *WebhookConfigurationApiService
+
 	ZoneInfoApi *ZoneInfoApiService
 }
 
@@ -97,6 +99,7 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.ObjectVarrayApi = (*ObjectVarrayApiService)(&c.common)
 	c.UserManagementApi = (*UserManagementApiService)(&c.common)
 	c.UserSecretKeyApi = (*UserSecretKeyApiService)(&c.common)
+	c.WebhookConfigurationApi = (*WebhookConfigurationApiService)(&c.common)
 	c.ZoneInfoApi = (*ZoneInfoApiService)(&c.common)
 
 	return c
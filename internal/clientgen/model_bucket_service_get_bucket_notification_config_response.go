@@ -12,5 +12,5 @@ package clientgen
 
 // BucketServiceGetBucketNotificationConfigResponse struct for BucketServiceGetBucketNotificationConfigResponse
 type BucketServiceGetBucketNotificationConfigResponse struct {
-	TopicConfiguration *string `json:"TopicConfiguration,omitempty"`
+	TopicConfiguration []BucketNotificationTopicConfiguration `json:"TopicConfiguration,omitempty"`
 }
@@ -12,5 +12,5 @@ package clientgen
 
 // BucketServicePutBucketNotificationConfigRequest struct for BucketServicePutBucketNotificationConfigRequest
 type BucketServicePutBucketNotificationConfigRequest struct {
-	TopicConfiguration *string `json:"TopicConfiguration,omitempty"`
+	TopicConfiguration []BucketNotificationTopicConfiguration `json:"TopicConfiguration,omitempty"`
 }
@@ -122,3 +122,47 @@ func (a *IamServiceListSAMLProvidersResponse) GetNextMarker() *string {
 func (o *IamServiceListSAMLProvidersResponse) GetPaginatedResp() []IamSamlProviderEntry {
 	return o.ListSAMLProvidersResult.SAMLProviderList
 }
+
+// List Policy Versions pagination helper methods
+func (a *IamServiceListPolicyVersionsResponse) GetNextMarker() *string {
+	return a.ListPolicyVersionsResult.Marker
+}
+
+func (o *IamServiceListPolicyVersionsResponse) GetPaginatedResp() []IamPolicyVersion {
+	return o.ListPolicyVersionsResult.Versions
+}
+
+// Simulate Principal Policy pagination helper methods
+func (a *IamServiceSimulatePrincipalPolicyResponse) GetNextMarker() *string {
+	return a.SimulatePrincipalPolicyResult.Marker
+}
+
+func (o *IamServiceSimulatePrincipalPolicyResponse) GetPaginatedResp() []IamEvaluationResult {
+	return o.SimulatePrincipalPolicyResult.EvaluationResults
+}
+
+// Simulate Custom Policy pagination helper methods
+func (a *IamServiceSimulateCustomPolicyResponse) GetNextMarker() *string {
+	return a.SimulateCustomerPolicyResult.Marker
+}
+
+func (o *IamServiceSimulateCustomPolicyResponse) GetPaginatedResp() []IamEvaluationResult {
+	return o.SimulateCustomerPolicyResult.EvaluationResults
+}
+
+// List Entities For Policy pagination helper methods
+// Each page carries users, groups and roles, so the page result itself is the paginated item.
+// The result element is optional, a response without it is an empty last page.
+func (a *IamServiceListEntitiesForPolicyResponse) GetNextMarker() *string {
+	if a.ListEntitiesForPolicyResult == nil {
+		return nil
+	}
+	return a.ListEntitiesForPolicyResult.Marker
+}
+
+func (o *IamServiceListEntitiesForPolicyResponse) GetPaginatedResp() []IamServiceListEntitiesForPolicyResponseListEntitiesForPolicyResult {
+	if o.ListEntitiesForPolicyResult == nil {
+		return nil
+	}
+	return []IamServiceListEntitiesForPolicyResponseListEntitiesForPolicyResult{*o.ListEntitiesForPolicyResult}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"terraform-provider-objectscale/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// APIErrorDetail returns the detail of the diagnostic for an error returned by the ObjectScale API:
+// the error, followed by its classification and what the user can do about it.
+func APIErrorDetail(err error) string {
+	apiErr := client.ParseError(err)
+	if apiErr == nil || apiErr.Kind == client.ErrorUnknown {
+		return err.Error()
+	}
+	return err.Error() + "\n\n" + apiErr.Summary() + ": " + apiErr.Remediation()
+}
+
+// RemoveNotFound removes a resource from the state when the error tells that its object does not exist anymore,
+// as happens when it is deleted outside of Terraform, so that the next plan recreates it.
+// It returns whether the resource was removed.
+func RemoveNotFound(ctx context.Context, err error, state *tfsdk.State, object string) bool {
+	if !client.IsNotFound(err) {
+		return false
+	}
+	RemoveMissing(ctx, state, object)
+	return true
+}
+
+// RemoveMissing removes a resource whose object was deleted outside of Terraform from the state.
+func RemoveMissing(ctx context.Context, state *tfsdk.State, object string) {
+	tflog.Warn(ctx, object+" not found, removing it from state")
+	state.RemoveResource(ctx)
+}
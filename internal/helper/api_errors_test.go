@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"errors"
+	"strings"
+	"terraform-provider-objectscale/internal/client"
+	"testing"
+)
+
+// APIErrorDetail appends the remediation of the kind of an API error.
+func TestAPIErrorDetail(t *testing.T) {
+	body := []byte(`<ErrorResponse><Error><Code>EntityAlreadyExists</Code><Message>exists</Message></Error></ErrorResponse>`)
+	err := client.NewAPIError(0, body, errors.New("undefined response type"))
+	if detail := APIErrorDetail(err); !strings.HasPrefix(detail, err.Error()) || !strings.Contains(detail, "Import the existing object") {
+		t.Fatalf("unexpected detail %q", detail)
+	}
+	plain := errors.New("connection refused")
+	if detail := APIErrorDetail(plain); detail != plain.Error() {
+		t.Fatalf("unexpected detail %q", detail)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"strings"
 	"terraform-provider-objectscale/internal/client"
+	"terraform-provider-objectscale/internal/clientgen"
 	"terraform-provider-objectscale/internal/models"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -243,3 +244,46 @@ func computeDiff(a, b []string) []string {
 	}
 	return out
 }
+
+// ListEntitiesForPolicy returns the users, groups and roles that a managed policy is attached to,
+// merging all the pages of the ListEntitiesForPolicy API.
+func ListEntitiesForPolicy(client *client.Client, ctx context.Context, namespace, policyArn string) (clientgen.IamServiceListEntitiesForPolicyResponseListEntitiesForPolicyResult, error) {
+	var ret clientgen.IamServiceListEntitiesForPolicyResponseListEntitiesForPolicyResult
+	pages, err := GetAllInstances(client.GenClient.IamApi.IamServiceListEntitiesForPolicy(ctx).
+		XEmcNamespace(namespace).
+		PolicyArn(policyArn))
+	if err != nil {
+		return ret, err
+	}
+	for _, page := range pages {
+		ret.PolicyUsers = append(ret.PolicyUsers, page.PolicyUsers...)
+		ret.PolicyGroups = append(ret.PolicyGroups, page.PolicyGroups...)
+		ret.PolicyRoles = append(ret.PolicyRoles, page.PolicyRoles...)
+	}
+	return ret, nil
+}
+
+// DetachPolicyFromAllEntities detaches a managed policy from every user, group and role it is attached to.
+func DetachPolicyFromAllEntities(client *client.Client, ctx context.Context, namespace, policyArn string) error {
+	entities, err := ListEntitiesForPolicy(client, ctx, namespace, policyArn)
+	if err != nil {
+		return fmt.Errorf("failed to list entities for policy arn %s: %w", policyArn, err)
+	}
+	api := client.GenClient.IamApi
+	for _, u := range entities.PolicyUsers {
+		if _, _, err := api.IamServiceDetachUserPolicy(ctx).XEmcNamespace(namespace).UserName(*u.UserName).PolicyArn(policyArn).Execute(); err != nil {
+			return fmt.Errorf("failed to detach policy arn %s from user %s: %w", policyArn, *u.UserName, err)
+		}
+	}
+	for _, g := range entities.PolicyGroups {
+		if _, _, err := api.IamServiceDetachGroupPolicy(ctx).XEmcNamespace(namespace).GroupName(*g.GroupName).PolicyArn(policyArn).Execute(); err != nil {
+			return fmt.Errorf("failed to detach policy arn %s from group %s: %w", policyArn, *g.GroupName, err)
+		}
+	}
+	for _, r := range entities.PolicyRoles {
+		if _, _, err := api.IamServiceDetachRolePolicy(ctx).XEmcNamespace(namespace).RoleName(*r.RoleName).PolicyArn(policyArn).Execute(); err != nil {
+			return fmt.Errorf("failed to detach policy arn %s from role %s: %w", policyArn, *r.RoleName, err)
+		}
+	}
+	return nil
+}
@@ -75,3 +75,14 @@ func TestMockPaginationError(t *testing.T) {
 
 	FunctionMocker.UnPatch()
 }
+
+// Test Mock Fetch Entities For Policy without a result element.
+func TestMockPaginationEmptyEntitiesForPolicy(t *testing.T) {
+	FunctionMocker := mockey.Mock(clientgen.ApiIamServiceListEntitiesForPolicyRequest.Execute).
+		Return(&clientgen.IamServiceListEntitiesForPolicyResponse{}, nil, nil).Build()
+	defer FunctionMocker.UnPatch()
+
+	ret, err := GetAllInstances(clientgen.ApiIamServiceListEntitiesForPolicyRequest{})
+	assert.Nil(t, err)
+	assert.Empty(t, ret)
+}
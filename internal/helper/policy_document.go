@@ -0,0 +1,244 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// DefaultPolicyVersion is the version of the IAM policy language used when none is set.
+const DefaultPolicyVersion = "2012-10-17"
+
+// PolicyDocument is an IAM policy document.
+type PolicyDocument struct {
+	Version    string             `json:"Version,omitempty"`
+	Id         string             `json:"Id,omitempty"`
+	Statements []*PolicyStatement `json:"Statement"`
+}
+
+// PolicyStatement is a statement of an IAM policy document.
+// The values are kept in their JSON form, either a string or a list of strings for the actions and resources,
+// so that the statements of the source and override documents are rendered as they were written.
+type PolicyStatement struct {
+	Sid           string `json:"Sid,omitempty"`
+	Effect        string `json:"Effect,omitempty"`
+	Actions       any    `json:"Action,omitempty"`
+	NotActions    any    `json:"NotAction,omitempty"`
+	Resources     any    `json:"Resource,omitempty"`
+	NotResources  any    `json:"NotResource,omitempty"`
+	Principals    any    `json:"Principal,omitempty"`
+	NotPrincipals any    `json:"NotPrincipal,omitempty"`
+	Conditions    any    `json:"Condition,omitempty"`
+}
+
+// PolicyPrincipal is a principal of a statement, e.g. type AWS with the ARNs of users.
+type PolicyPrincipal struct {
+	Type        string
+	Identifiers []string
+}
+
+// PolicyCondition is a condition of a statement, e.g. test StringEquals on variable aws:username.
+type PolicyCondition struct {
+	Test     string
+	Variable string
+	Values   []string
+}
+
+// UnmarshalJSON decodes a policy document, whose Statement may be a single statement instead of a list.
+func (d *PolicyDocument) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Version   string          `json:"Version"`
+		Id        string          `json:"Id"`
+		Statement json.RawMessage `json:"Statement"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	d.Version, d.Id, d.Statements = raw.Version, raw.Id, nil
+	statement := bytes.TrimSpace(raw.Statement)
+	switch {
+	case len(statement) == 0 || bytes.Equal(statement, []byte("null")):
+	case statement[0] == '{':
+		var s PolicyStatement
+		if err := json.Unmarshal(statement, &s); err != nil {
+			return err
+		}
+		d.Statements = []*PolicyStatement{&s}
+	default:
+		if err := json.Unmarshal(statement, &d.Statements); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParsePolicyDocument decodes a JSON policy document.
+func ParsePolicyDocument(document string) (*PolicyDocument, error) {
+	var d PolicyDocument
+	if err := json.Unmarshal([]byte(document), &d); err != nil {
+		return nil, fmt.Errorf("invalid policy document: %w", err)
+	}
+	return &d, nil
+}
+
+// PolicyValues returns the JSON form of a list of values: a single value is rendered as a string,
+// and several values as a sorted list. It returns nil when there is no value, so that the element is omitted.
+func PolicyValues(values []string) any {
+	switch len(values) {
+	case 0:
+		return nil
+	case 1:
+		return values[0]
+	}
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return slices.Compact(sorted)
+}
+
+// PolicyPrincipals returns the JSON form of the principals of a statement, merging the identifiers of the same type.
+// The "*" principal type with the "*" identifier stands for everyone, and is rendered as "*".
+func PolicyPrincipals(principals []PolicyPrincipal) any {
+	if len(principals) == 0 {
+		return nil
+	}
+	identifiers := map[string][]string{}
+	for _, p := range principals {
+		if p.Type == "*" && slices.Equal(p.Identifiers, []string{"*"}) && len(principals) == 1 {
+			return "*"
+		}
+		identifiers[p.Type] = append(identifiers[p.Type], p.Identifiers...)
+	}
+	out := map[string]any{}
+	for t, ids := range identifiers {
+		out[t] = PolicyValues(ids)
+	}
+	return out
+}
+
+// PolicyConditions returns the JSON form of the conditions of a statement, grouped by test and variable.
+func PolicyConditions(conditions []PolicyCondition) any {
+	if len(conditions) == 0 {
+		return nil
+	}
+	values := map[string]map[string][]string{}
+	for _, c := range conditions {
+		if values[c.Test] == nil {
+			values[c.Test] = map[string][]string{}
+		}
+		values[c.Test][c.Variable] = append(values[c.Test][c.Variable], c.Values...)
+	}
+	out := map[string]map[string]any{}
+	for test, variables := range values {
+		out[test] = map[string]any{}
+		for variable, v := range variables {
+			// condition values are kept as a list, unless there is a single one
+			if len(v) == 1 {
+				out[test][variable] = v[0]
+			} else {
+				out[test][variable] = v
+			}
+		}
+	}
+	return out
+}
+
+// MergePolicyDocuments merges the statements of a policy document with its source and override documents:
+//   - the statements of the sources come first, and must have unique Sids;
+//   - a statement of the document replaces the statement of the sources with the same Sid, or is appended;
+//   - a statement of an override replaces the statement with the same Sid, or is appended, in the order of the overrides.
+//
+// Statements without Sid are never replaced. The Version and Id of the document, when set, take precedence over
+// the ones of the sources, and the ones of the overrides over both.
+func MergePolicyDocuments(sources []*PolicyDocument, document *PolicyDocument, overrides []*PolicyDocument) (*PolicyDocument, error) {
+	merged := &PolicyDocument{Statements: []*PolicyStatement{}}
+	for i, source := range sources {
+		mergeHeader(merged, source)
+		for _, s := range source.Statements {
+			if s.Sid != "" && slices.ContainsFunc(merged.Statements, func(m *PolicyStatement) bool { return m.Sid == s.Sid }) {
+				return nil, fmt.Errorf("duplicate Sid %q in source policy document %d", s.Sid, i+1)
+			}
+			merged.Statements = append(merged.Statements, s)
+		}
+	}
+
+	mergeHeader(merged, document)
+	seen := map[string]bool{}
+	for _, s := range document.Statements {
+		if s.Sid != "" {
+			if seen[s.Sid] {
+				return nil, fmt.Errorf("duplicate Sid %q in the statements", s.Sid)
+			}
+			seen[s.Sid] = true
+		}
+		overrideStatement(merged, s)
+	}
+
+	for _, override := range overrides {
+		mergeHeader(merged, override)
+		for _, s := range override.Statements {
+			overrideStatement(merged, s)
+		}
+	}
+
+	if merged.Version == "" {
+		merged.Version = DefaultPolicyVersion
+	}
+	return merged, nil
+}
+
+// helper function to set the Version and Id of a merged document from the ones of a document, when set.
+func mergeHeader(merged, document *PolicyDocument) {
+	if document.Version != "" {
+		merged.Version = document.Version
+	}
+	if document.Id != "" {
+		merged.Id = document.Id
+	}
+}
+
+// helper function to replace the statement with the same Sid in a merged document, or append the statement.
+func overrideStatement(merged *PolicyDocument, s *PolicyStatement) {
+	if s.Sid != "" {
+		if i := slices.IndexFunc(merged.Statements, func(m *PolicyStatement) bool { return m.Sid == s.Sid }); i >= 0 {
+			merged.Statements[i] = s
+			return
+		}
+	}
+	merged.Statements = append(merged.Statements, s)
+}
+
+// MarshalPolicyDocument returns the indented and minified JSON forms of a policy document.
+// Unlike json.Marshal, the characters <, > and & of the values are not escaped.
+func MarshalPolicyDocument(d *PolicyDocument) (string, string, error) {
+	var minified bytes.Buffer
+	encoder := json.NewEncoder(&minified)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(d); err != nil {
+		return "", "", err
+	}
+	// the encoder terminates the document with a newline
+	minified.Truncate(minified.Len() - 1)
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, minified.Bytes(), "", "  "); err != nil {
+		return "", "", err
+	}
+	return indented.String(), minified.String(), nil
+}
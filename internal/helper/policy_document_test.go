@@ -0,0 +1,146 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyValues(t *testing.T) {
+	assert.Nil(t, PolicyValues(nil))
+	assert.Equal(t, "s3:GetObject", PolicyValues([]string{"s3:GetObject"}))
+	assert.Equal(t, []string{"s3:GetObject", "s3:PutObject"}, PolicyValues([]string{"s3:PutObject", "s3:GetObject", "s3:PutObject"}))
+}
+
+func TestPolicyPrincipals(t *testing.T) {
+	assert.Nil(t, PolicyPrincipals(nil))
+	assert.Equal(t, "*", PolicyPrincipals([]PolicyPrincipal{{Type: "*", Identifiers: []string{"*"}}}))
+	assert.Equal(t, map[string]any{
+		"AWS":       []string{"urn:ecs:iam::ns1:user/u1", "urn:ecs:iam::ns1:user/u2"},
+		"Federated": "urn:ecs:iam::ns1:saml-provider/idp",
+	}, PolicyPrincipals([]PolicyPrincipal{
+		{Type: "AWS", Identifiers: []string{"urn:ecs:iam::ns1:user/u2"}},
+		{Type: "Federated", Identifiers: []string{"urn:ecs:iam::ns1:saml-provider/idp"}},
+		{Type: "AWS", Identifiers: []string{"urn:ecs:iam::ns1:user/u1"}},
+	}))
+}
+
+func TestPolicyConditions(t *testing.T) {
+	assert.Nil(t, PolicyConditions(nil))
+	assert.Equal(t, map[string]map[string]any{
+		"StringEquals": {"aws:username": "u1", "s3:prefix": []string{"home/", "shared/"}},
+		"IpAddress":    {"aws:SourceIp": "10.0.0.0/8"},
+	}, PolicyConditions([]PolicyCondition{
+		{Test: "StringEquals", Variable: "aws:username", Values: []string{"u1"}},
+		{Test: "StringEquals", Variable: "s3:prefix", Values: []string{"home/", "shared/"}},
+		{Test: "IpAddress", Variable: "aws:SourceIp", Values: []string{"10.0.0.0/8"}},
+	}))
+}
+
+func TestParsePolicyDocument(t *testing.T) {
+	d, err := ParsePolicyDocument(`{"Version":"2012-10-17","Statement":{"Sid":"One","Effect":"Allow","Action":"s3:*","Resource":"*"}}`)
+	require.NoError(t, err)
+	require.Len(t, d.Statements, 1)
+	assert.Equal(t, "One", d.Statements[0].Sid)
+	assert.Equal(t, "s3:*", d.Statements[0].Actions)
+
+	d, err = ParsePolicyDocument(`{"Statement":[{"Sid":"One"},{"Sid":"Two"}]}`)
+	require.NoError(t, err)
+	assert.Len(t, d.Statements, 2)
+
+	_, err = ParsePolicyDocument(`{"Statement":`)
+	assert.Error(t, err)
+}
+
+func TestMergePolicyDocuments(t *testing.T) {
+	statement := func(sid, effect string) *PolicyStatement {
+		return &PolicyStatement{Sid: sid, Effect: effect, Actions: "s3:GetObject", Resources: "*"}
+	}
+	sources := []*PolicyDocument{
+		{Version: "2008-10-17", Statements: []*PolicyStatement{statement("A", "Allow"), statement("", "Allow")}},
+		{Statements: []*PolicyStatement{statement("B", "Allow")}},
+	}
+	document := &PolicyDocument{Id: "policy", Statements: []*PolicyStatement{statement("B", "Deny"), statement("C", "Allow")}}
+	overrides := []*PolicyDocument{
+		{Statements: []*PolicyStatement{statement("A", "Deny"), statement("D", "Allow")}},
+		{Version: "2012-10-17", Statements: []*PolicyStatement{statement("D", "Deny")}},
+	}
+
+	merged, err := MergePolicyDocuments(sources, document, overrides)
+	require.NoError(t, err)
+	assert.Equal(t, &PolicyDocument{
+		Version: "2012-10-17",
+		Id:      "policy",
+		Statements: []*PolicyStatement{
+			statement("A", "Deny"),
+			statement("", "Allow"),
+			statement("B", "Deny"),
+			statement("C", "Allow"),
+			statement("D", "Deny"),
+		},
+	}, merged)
+
+	// the version defaults to 2012-10-17
+	merged, err = MergePolicyDocuments(nil, &PolicyDocument{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultPolicyVersion, merged.Version)
+	assert.Empty(t, merged.Statements)
+
+	_, err = MergePolicyDocuments([]*PolicyDocument{{Statements: []*PolicyStatement{statement("A", "Allow")}}, {Statements: []*PolicyStatement{statement("A", "Deny")}}}, &PolicyDocument{}, nil)
+	assert.ErrorContains(t, err, `duplicate Sid "A" in source policy document 2`)
+
+	_, err = MergePolicyDocuments(nil, &PolicyDocument{Statements: []*PolicyStatement{statement("A", "Allow"), statement("A", "Deny")}}, nil)
+	assert.ErrorContains(t, err, `duplicate Sid "A" in the statements`)
+}
+
+func TestMarshalPolicyDocument(t *testing.T) {
+	indented, minified, err := MarshalPolicyDocument(&PolicyDocument{
+		Version: DefaultPolicyVersion,
+		Statements: []*PolicyStatement{{
+			Effect:     "Allow",
+			Actions:    "s3:GetObject",
+			Resources:  "*",
+			Conditions: map[string]map[string]any{"StringLike": {"s3:prefix": "a&b<c>"}},
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringLike":{"s3:prefix":"a&b<c>"}}}]}`, minified)
+	assert.Equal(t, `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "*",
+      "Condition": {
+        "StringLike": {
+          "s3:prefix": "a&b<c>"
+        }
+      }
+    }
+  ]
+}`, indented)
+
+	// a document without statements still has a Statement element
+	_, minified, err = MarshalPolicyDocument(&PolicyDocument{Version: DefaultPolicyVersion, Statements: []*PolicyStatement{}})
+	require.NoError(t, err)
+	assert.Equal(t, `{"Version":"2012-10-17","Statement":[]}`, minified)
+}
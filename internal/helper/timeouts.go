@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// DefaultTimeout is the timeout of an operation which is not configured in the timeouts block.
+const DefaultTimeout = 20 * time.Minute
+
+// WithTimeout returns a context bounded by the timeout of an operation, read from the timeouts block
+// with one of the methods of timeouts.Value, e.g. plan.Timeouts.Create, or the given default.
+// The diagnostics of an invalid timeout are appended to diags. The returned cancel function must always be called.
+func WithTimeout(ctx context.Context, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics),
+	def time.Duration, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	d, timeoutDiags := timeout(ctx, def)
+	diags.Append(timeoutDiags...)
+	return context.WithTimeout(ctx, d)
+}
+
+// Sleep waits for the given duration, or until the context is done, in which case it returns the error of the context.
+func Sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
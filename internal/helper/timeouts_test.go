@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestWithTimeout(t *testing.T) {
+	configured := func(context.Context, time.Duration) (time.Duration, diag.Diagnostics) {
+		return time.Second, nil
+	}
+	invalid := func(_ context.Context, def time.Duration) (time.Duration, diag.Diagnostics) {
+		return def, diag.Diagnostics{diag.NewErrorDiagnostic("Timeout Cannot Be Parsed", "invalid")}
+	}
+
+	var diags diag.Diagnostics
+	ctx, cancel := WithTimeout(context.Background(), configured, time.Hour, &diags)
+	defer cancel()
+	if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > time.Second || diags.HasError() {
+		t.Errorf("unexpected deadline %v, diagnostics %v", deadline, diags)
+	}
+
+	ctx, cancel = WithTimeout(context.Background(), invalid, time.Minute, &diags)
+	defer cancel()
+	if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > time.Minute || !diags.HasError() {
+		t.Errorf("expected the default deadline and an error, got %v, diagnostics %v", deadline, diags)
+	}
+}
+
+func TestSleep(t *testing.T) {
+	if err := Sleep(context.Background(), time.Millisecond); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := Sleep(ctx, time.Minute); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WriteOnlySuffix and WriteOnlyVersionSuffix are appended to the name of a secret attribute
+// to name its write-only variant and the version of the write-only variant.
+const (
+	WriteOnlySuffix        = "_wo"
+	WriteOnlyVersionSuffix = "_wo_version"
+)
+
+// WriteOnlyAttribute returns the schema of the write-only variant of a secret attribute, which is never stored in the state.
+// When versioned, the write-only value is only sent to ObjectScale when its version attribute, see WriteOnlyVersionAttribute, changes.
+func WriteOnlyAttribute(attr, description string, versioned bool) schema.StringAttribute {
+	validators := []validator.String{
+		stringvalidator.ConflictsWith(path.MatchRoot(attr)),
+	}
+	description = "Write-only " + description + " It is never stored in the state and requires Terraform 1.11 or later. Conflicts with `" + attr + "`."
+	if versioned {
+		validators = append(validators, stringvalidator.AlsoRequires(path.MatchRoot(attr+WriteOnlyVersionSuffix)))
+		description += " Requires `" + attr + WriteOnlyVersionSuffix + "`."
+	}
+	return schema.StringAttribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Optional:            true,
+		Sensitive:           true,
+		WriteOnly:           true,
+		Validators:          validators,
+	}
+}
+
+// WriteOnlyVersionAttribute returns the schema of the version of the write-only variant of a secret attribute.
+// Changing the version triggers an update which sends the write-only value again.
+func WriteOnlyVersionAttribute(attr string) schema.Int64Attribute {
+	description := "Version of `" + attr + WriteOnlySuffix + "`. As write-only values are not stored in the state, Terraform cannot detect their changes: " +
+		"change this version, for instance increment it, to send the new value of `" + attr + WriteOnlySuffix + "` to ObjectScale."
+	return schema.Int64Attribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Optional:            true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot(attr + WriteOnlySuffix)),
+		},
+	}
+}
+
+// SecretValue returns the value of a secret attribute from the plan, or the value of its write-only variant
+// from the config when the attribute is not set. Write-only values are only available in the config.
+func SecretValue(ctx context.Context, value types.String, config tfsdk.Config, attr string) (types.String, diag.Diagnostics) {
+	if IsKnown(value) {
+		return value, nil
+	}
+	var writeOnly types.String
+	diags := config.GetAttribute(ctx, path.Root(attr+WriteOnlySuffix), &writeOnly)
+	return writeOnly, diags
+}
+
+// IsWriteOnlyRotated tells whether the version of the write-only variant of a secret attribute changed.
+func IsWriteOnlyRotated(plan, state types.Int64) bool {
+	return IsKnown(plan) && !plan.Equal(state)
+}
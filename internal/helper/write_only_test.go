@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSecretValue(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"password":            schema.StringAttribute{Optional: true, Sensitive: true},
+			"password_wo":         WriteOnlyAttribute("password", "password.", true),
+			"password_wo_version": WriteOnlyVersionAttribute("password"),
+		},
+	}
+	config := func(password, writeOnly tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"password":            password,
+			"password_wo":         writeOnly,
+			"password_wo_version": tftypes.NewValue(tftypes.Number, nil),
+		})}
+	}
+	null := tftypes.NewValue(tftypes.String, nil)
+
+	tests := []struct {
+		name   string
+		value  types.String
+		config tfsdk.Config
+		want   types.String
+	}{
+		{"attribute", types.StringValue("secret"), config(tftypes.NewValue(tftypes.String, "secret"), null), types.StringValue("secret")},
+		{"write-only", types.StringNull(), config(null, tftypes.NewValue(tftypes.String, "wo-secret")), types.StringValue("wo-secret")},
+		{"none", types.StringNull(), config(null, null), types.StringNull()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := SecretValue(ctx, tt.value, tt.config, "password")
+			if diags.HasError() || !got.Equal(tt.want) {
+				t.Errorf("SecretValue() = %v, %v, want %v", got, diags, tt.want)
+			}
+		})
+	}
+
+	if wo := s.Attributes["password_wo"]; !wo.IsWriteOnly() || !wo.IsSensitive() || wo.IsComputed() {
+		t.Errorf("unexpected write-only attribute %+v", wo)
+	}
+}
+
+func TestIsWriteOnlyRotated(t *testing.T) {
+	tests := []struct {
+		name        string
+		plan, state types.Int64
+		want        bool
+	}{
+		{"first version", types.Int64Value(1), types.Int64Null(), true},
+		{"new version", types.Int64Value(2), types.Int64Value(1), true},
+		{"same version", types.Int64Value(1), types.Int64Value(1), false},
+		{"no version", types.Int64Null(), types.Int64Value(1), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsWriteOnlyRotated(tt.plan, tt.state); got != tt.want {
+				t.Errorf("IsWriteOnlyRotated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -17,7 +17,12 @@ limitations under the License.
 
 package models
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"terraform-provider-objectscale/internal/policytypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 // BucketDatasourceModel represents the Terraform data source model for listing buckets.
 // It contains the namespace, optional prefix, and a list of BucketModel entries.
@@ -145,6 +150,7 @@ type BucketResourceModel struct {
 	DefaultObjectLockRetentionDays     types.Int64  `tfsdk:"default_object_lock_retention_days"`
 	IsEncryptionEnabled                types.Bool   `tfsdk:"is_encryption_enabled"`
 	DefaultRetention                   types.Int64  `tfsdk:"default_retention"`
+	ForceDestroy                       types.Bool   `tfsdk:"force_destroy"`
 	IsEmptyBucketInProgress            types.Bool   `tfsdk:"is_empty_bucket_in_progress"`
 	BlockSizeInCount                   types.Int64  `tfsdk:"block_size_in_count"`
 	NotificationSizeInCount            types.Int64  `tfsdk:"notification_size_in_count"`
@@ -155,12 +161,13 @@ type BucketResourceModel struct {
 	VersioningStatus                   types.String `tfsdk:"versioning_status"`
 
 	//Policy related fields
-	BucketPolicy types.String `tfsdk:"bucket_policy"`
+	BucketPolicy policytypes.Document `tfsdk:"bucket_policy"`
 
 	//ACL related fields
-	UserAcl        types.Set `tfsdk:"user_acl"`
-	GroupAcl       types.Set `tfsdk:"group_acl"`
-	CustomGroupAcl types.Set `tfsdk:"custom_group_acl"`
+	UserAcl        types.Set      `tfsdk:"user_acl"`
+	GroupAcl       types.Set      `tfsdk:"group_acl"`
+	CustomGroupAcl types.Set      `tfsdk:"custom_group_acl"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 type AclModel struct {
@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BucketCopyPolicyResourceModel describes the resource data model.
+type BucketCopyPolicyResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Bucket    types.String `tfsdk:"bucket"`
+	Namespace types.String `tfsdk:"namespace"`
+	// target
+	ExternalEndpoint types.String `tfsdk:"external_endpoint"`
+	TargetRegion     types.String `tfsdk:"target_region"`
+	TargetBucket     types.String `tfsdk:"target_bucket"`
+	TargetAccount    types.String `tfsdk:"target_account"`
+	TargetRole       types.String `tfsdk:"target_role"`
+	TargetAccessKey  types.String `tfsdk:"target_access_key"`
+	TargetSecretKey  types.String `tfsdk:"target_secret_key"`
+	PolicyType       types.String `tfsdk:"policy_type"`
+	// filters and options
+	DaysAfterLastWrite    types.Int64    `tfsdk:"days_after_last_write"`
+	MinimumSize           types.Int64    `tfsdk:"minimum_size"`
+	TagFilter             types.String   `tfsdk:"tag_filter"`
+	BackupReadTarget      types.Bool     `tfsdk:"backup_read_target"`
+	SseS3Enabled          types.Bool     `tfsdk:"sse_s3_enabled"`
+	DetailedLogEnabled    types.Bool     `tfsdk:"detailed_log_enabled"`
+	DetailedLogErrorsOnly types.Bool     `tfsdk:"detailed_log_errors_only"`
+	DetailedLogBucket     types.String   `tfsdk:"detailed_log_bucket"`
+	DetailedLogPrefix     types.String   `tfsdk:"detailed_log_prefix"`
+	ExternalCerts         types.String   `tfsdk:"external_certs"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+// BucketCopyPolicyDataSourceModel describes the data source data model.
+type BucketCopyPolicyDataSourceModel struct {
+	ID           types.String                     `tfsdk:"id"`
+	Namespace    types.String                     `tfsdk:"namespace"`
+	Bucket       types.String                     `tfsdk:"bucket"`
+	CopyPolicies []BucketCopyPolicyDataSourceItem `tfsdk:"copy_policies"`
+}
+
+type BucketCopyPolicyDataSourceItem struct {
+	ExternalEndpoint      types.String `tfsdk:"external_endpoint"`
+	TargetRegion          types.String `tfsdk:"target_region"`
+	TargetBucket          types.String `tfsdk:"target_bucket"`
+	TargetAccount         types.String `tfsdk:"target_account"`
+	TargetRole            types.String `tfsdk:"target_role"`
+	TargetAccessKey       types.String `tfsdk:"target_access_key"`
+	PolicyType            types.String `tfsdk:"policy_type"`
+	DaysAfterLastWrite    types.Int64  `tfsdk:"days_after_last_write"`
+	MinimumSize           types.Int64  `tfsdk:"minimum_size"`
+	TagFilter             types.String `tfsdk:"tag_filter"`
+	BackupReadTarget      types.Bool   `tfsdk:"backup_read_target"`
+	SseS3Enabled          types.Bool   `tfsdk:"sse_s3_enabled"`
+	DetailedLogEnabled    types.Bool   `tfsdk:"detailed_log_enabled"`
+	DetailedLogErrorsOnly types.Bool   `tfsdk:"detailed_log_errors_only"`
+	DetailedLogBucket     types.String `tfsdk:"detailed_log_bucket"`
+	DetailedLogPrefix     types.String `tfsdk:"detailed_log_prefix"`
+	ExternalCerts         types.String `tfsdk:"external_certs"`
+	IsRunning             types.Bool   `tfsdk:"is_running"`
+	TotalErrorCount       types.Int64  `tfsdk:"total_error_count"`
+}
@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BucketNotificationResourceModel describes the resource data model.
+type BucketNotificationResourceModel struct {
+	ID                  types.String                    `tfsdk:"id"`
+	Bucket              types.String                    `tfsdk:"bucket"`
+	Namespace           types.String                    `tfsdk:"namespace"`
+	TopicConfigurations []BucketNotificationTopicConfig `tfsdk:"topic_configurations"`
+	Timeouts            timeouts.Value                  `tfsdk:"timeouts"`
+}
+
+// BucketNotificationTopicConfig maps a topic configuration of the bucket notification.
+type BucketNotificationTopicConfig struct {
+	ID           types.String `tfsdk:"id"`
+	TopicArn     types.String `tfsdk:"topic_arn"`
+	Events       types.Set    `tfsdk:"events"`
+	FilterPrefix types.String `tfsdk:"filter_prefix"`
+	FilterSuffix types.String `tfsdk:"filter_suffix"`
+}
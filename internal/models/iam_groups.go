@@ -17,15 +17,19 @@ limitations under the License.
 
 package models
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type IAMGroupResourceModel struct {
-	GroupName  types.String `tfsdk:"name"`
-	GroupId    types.String `tfsdk:"id"`
-	Arn        types.String `tfsdk:"arn"`
-	Path       types.String `tfsdk:"path"`
-	CreateDate types.String `tfsdk:"create_date"`
-	Namespace  types.String `tfsdk:"namespace"`
+	GroupName  types.String   `tfsdk:"name"`
+	GroupId    types.String   `tfsdk:"id"`
+	Arn        types.String   `tfsdk:"arn"`
+	Path       types.String   `tfsdk:"path"`
+	CreateDate types.String   `tfsdk:"create_date"`
+	Namespace  types.String   `tfsdk:"namespace"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
 type IAMGroupsDatasourceModel struct {
@@ -18,7 +18,9 @@ limitations under the License.
 package models
 
 import (
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"terraform-provider-objectscale/internal/policytypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -32,10 +34,11 @@ type IAMInlinePolicyResourceModel struct {
 	Rolename  types.String `tfsdk:"rolename"`
 
 	Policies []IAMInlinePolicyModel `tfsdk:"policies"`
+	Timeouts timeouts.Value         `tfsdk:"timeouts"`
 }
 
 // IAMInlinePolicyModel maps an individual IAM Inline Policy data.
 type IAMInlinePolicyModel struct {
 	Name     types.String         `tfsdk:"name"`
-	Document jsontypes.Normalized `tfsdk:"document"`
+	Document policytypes.Document `tfsdk:"document"`
 }
@@ -18,7 +18,9 @@ limitations under the License.
 package models
 
 import (
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"terraform-provider-objectscale/internal/policytypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -47,22 +49,27 @@ type IamPolicyDataSourceIamPolicyModel struct {
 	PolicyName                    types.String                               `tfsdk:"policy_name"`
 	UpdateDate                    types.String                               `tfsdk:"update_date"`
 	Versions                      []IamPolicyDataSourceIamPolicyVersionModel `tfsdk:"versions"`
+	AttachedUsers                 []types.String                             `tfsdk:"attached_users"`
+	AttachedGroups                []types.String                             `tfsdk:"attached_groups"`
+	AttachedRoles                 []types.String                             `tfsdk:"attached_roles"`
 }
 
 // IamPolicyDataSourceIamPolicyVersionModel represents the schema for the versions attribute.
 type IamPolicyDataSourceIamPolicyVersionModel struct {
-	IsDefaultVersion types.Bool   `tfsdk:"is_default_version"`
-	VersionID        types.String `tfsdk:"version_id"`
-	CreateDate       types.String `tfsdk:"create_date"`
-	Document         types.String `tfsdk:"document"`
+	IsDefaultVersion types.Bool           `tfsdk:"is_default_version"`
+	VersionID        types.String         `tfsdk:"version_id"`
+	CreateDate       types.String         `tfsdk:"create_date"`
+	Document         policytypes.Document `tfsdk:"document"`
 }
 
 type IamPolicyResourceModel struct {
 	PolicyName     types.String         `tfsdk:"name"`
-	PolicyDocument jsontypes.Normalized `tfsdk:"policy_document"`
+	PolicyDocument policytypes.Document `tfsdk:"policy_document"`
 	Namespace      types.String         `tfsdk:"namespace"`
 	Description    types.String         `tfsdk:"description"`
 	Arn            types.String         `tfsdk:"arn"`
 	CreateDate     types.String         `tfsdk:"create_date"`
 	VersionId      types.String         `tfsdk:"version_id"`
+	ForceDetach    types.Bool           `tfsdk:"force_detach_on_destroy"`
+	Timeouts       timeouts.Value       `tfsdk:"timeouts"`
 }
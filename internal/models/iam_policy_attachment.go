@@ -18,6 +18,7 @@ limitations under the License.
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -30,5 +31,6 @@ type IAMPolicyAttachmentResourceModel struct {
 	Groupname types.String `tfsdk:"groupname"`
 	Rolename  types.String `tfsdk:"rolename"`
 
-	PolicyARNs types.Set `tfsdk:"policy_arns"`
+	PolicyARNs types.Set      `tfsdk:"policy_arns"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"terraform-provider-objectscale/internal/policytypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type IAMPolicyDocumentDataSourceModel struct {
+	Id                      types.String                      `tfsdk:"id"`
+	Version                 types.String                      `tfsdk:"version"`
+	PolicyId                types.String                      `tfsdk:"policy_id"`
+	SourcePolicyDocuments   types.List                        `tfsdk:"source_policy_documents"`
+	OverridePolicyDocuments types.List                        `tfsdk:"override_policy_documents"`
+	Statements              []IAMPolicyDocumentStatementModel `tfsdk:"statement"`
+	Json                    policytypes.Document              `tfsdk:"json"`
+	MinifiedJson            policytypes.Document              `tfsdk:"minified_json"`
+}
+
+type IAMPolicyDocumentStatementModel struct {
+	Sid           types.String                      `tfsdk:"sid"`
+	Effect        types.String                      `tfsdk:"effect"`
+	Actions       types.Set                         `tfsdk:"actions"`
+	NotActions    types.Set                         `tfsdk:"not_actions"`
+	Resources     types.Set                         `tfsdk:"resources"`
+	NotResources  types.Set                         `tfsdk:"not_resources"`
+	Principals    []IAMPolicyDocumentPrincipalModel `tfsdk:"principals"`
+	NotPrincipals []IAMPolicyDocumentPrincipalModel `tfsdk:"not_principals"`
+	Conditions    []IAMPolicyDocumentConditionModel `tfsdk:"condition"`
+}
+
+type IAMPolicyDocumentPrincipalModel struct {
+	Type        types.String `tfsdk:"type"`
+	Identifiers types.Set    `tfsdk:"identifiers"`
+}
+
+type IAMPolicyDocumentConditionModel struct {
+	Test     types.String `tfsdk:"test"`
+	Variable types.String `tfsdk:"variable"`
+	Values   types.List   `tfsdk:"values"`
+}
@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type IAMPolicySimulationDataSourceModel struct {
+	ID                                 types.String                      `tfsdk:"id"`
+	Namespace                          types.String                      `tfsdk:"namespace"`
+	PolicySourceArn                    types.String                      `tfsdk:"policy_source_arn"`
+	PolicyDocuments                    types.List                        `tfsdk:"policy_documents"`
+	PermissionsBoundaryPolicyDocuments types.List                        `tfsdk:"permissions_boundary_policy_documents"`
+	CallerArn                          types.String                      `tfsdk:"caller_arn"`
+	ActionNames                        types.List                        `tfsdk:"action_names"`
+	ResourceArns                       types.List                        `tfsdk:"resource_arns"`
+	Context                            []IAMPolicySimulationContextModel `tfsdk:"context"`
+	Results                            []IAMPolicySimulationResultModel  `tfsdk:"results"`
+	AllAllowed                         types.Bool                        `tfsdk:"all_allowed"`
+	ContextKeys                        types.List                        `tfsdk:"context_keys"`
+}
+
+type IAMPolicySimulationContextModel struct {
+	Key    types.String `tfsdk:"key"`
+	Type   types.String `tfsdk:"type"`
+	Values types.List   `tfsdk:"values"`
+}
+
+type IAMPolicySimulationResultModel struct {
+	ActionName           types.String                               `tfsdk:"action_name"`
+	ResourceArn          types.String                               `tfsdk:"resource_arn"`
+	Decision             types.String                               `tfsdk:"decision"`
+	Allowed              types.Bool                                 `tfsdk:"allowed"`
+	MatchedStatements    []IAMPolicySimulationMatchedStatementModel `tfsdk:"matched_statements"`
+	MissingContextValues types.List                                 `tfsdk:"missing_context_values"`
+}
+
+type IAMPolicySimulationMatchedStatementModel struct {
+	SourcePolicyID   types.String `tfsdk:"source_policy_id"`
+	SourcePolicyType types.String `tfsdk:"source_policy_type"`
+}
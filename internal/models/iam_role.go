@@ -18,7 +18,9 @@ limitations under the License.
 package models
 
 import (
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"terraform-provider-objectscale/internal/policytypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -33,7 +35,7 @@ type IAMRole struct {
 	RoleId              types.String                `tfsdk:"role_id"`
 	RoleName            types.String                `tfsdk:"role_name"`
 	Arn                 types.String                `tfsdk:"arn"`
-	AssumeRolePolicy    types.String                `tfsdk:"assume_role_policy"`
+	AssumeRolePolicy    policytypes.Document        `tfsdk:"assume_role_policy"`
 	Path                types.String                `tfsdk:"path"`
 	Description         types.String                `tfsdk:"description"`
 	CreateDate          types.String                `tfsdk:"create_date"`
@@ -55,11 +57,12 @@ type IAMRolePermissionsBoundary struct {
 type IAMRoleResourceModel struct {
 	Name                     types.String         `tfsdk:"name"`
 	Namespace                types.String         `tfsdk:"namespace"`
-	AssumeRolePolicyDocument jsontypes.Normalized `tfsdk:"assume_role_policy_document"`
+	AssumeRolePolicyDocument policytypes.Document `tfsdk:"assume_role_policy_document"`
 	Description              types.String         `tfsdk:"description"`
 	MaxSessionDuration       types.Int32          `tfsdk:"max_session_duration"`
 	Path                     types.String         `tfsdk:"path"`
 	PermissionsBoundaryArn   types.String         `tfsdk:"permissions_boundary_arn"`
 	PermissionsBoundaryType  types.String         `tfsdk:"permissions_boundary_type"`
 	Tags                     types.Set            `tfsdk:"tags"`
+	Timeouts                 timeouts.Value       `tfsdk:"timeouts"`
 }
@@ -16,18 +16,22 @@ limitations under the License.
 
 package models
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 // IAMSAMLProviderResourceModel is the state model for
 // `objectscale_iam_saml_provider` resource.
 type IAMSAMLProviderResourceModel struct {
-	ID                   types.String `tfsdk:"id"`
-	Name                 types.String `tfsdk:"name"`
-	SAMLMetadataDocument types.String `tfsdk:"saml_metadata_document"`
-	Namespace            types.String `tfsdk:"namespace"`
-	Arn                  types.String `tfsdk:"arn"`
-	CreateDate           types.String `tfsdk:"create_date"`
-	ValidUntil           types.String `tfsdk:"valid_until"`
+	ID                   types.String   `tfsdk:"id"`
+	Name                 types.String   `tfsdk:"name"`
+	SAMLMetadataDocument types.String   `tfsdk:"saml_metadata_document"`
+	Namespace            types.String   `tfsdk:"namespace"`
+	Arn                  types.String   `tfsdk:"arn"`
+	CreateDate           types.String   `tfsdk:"create_date"`
+	ValidUntil           types.String   `tfsdk:"valid_until"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 // IAMSAMLProvider is one entry in the providers list.
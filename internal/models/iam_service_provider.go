@@ -16,11 +16,33 @@ limitations under the License.
 
 package models
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 // IAMServiceProviderResourceModel is the state model for
 // `objectscale_iam_service_provider` resource (singleton).
 type IAMServiceProviderResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	DNS          types.String `tfsdk:"dns"`
+	JavaKeystore types.String `tfsdk:"java_keystore"`
+	KeyAlias     types.String `tfsdk:"key_alias"`
+	KeyPassword  types.String `tfsdk:"key_password"`
+	// write-only key password and its version
+	KeyPasswordWO        types.String   `tfsdk:"key_password_wo"`
+	KeyPasswordWOVersion types.Int64    `tfsdk:"key_password_wo_version"`
+	UUID                 types.String   `tfsdk:"uuid"`
+	UniqueID             types.String   `tfsdk:"unique_id"`
+	Etag                 types.String   `tfsdk:"etag"`
+	CreateTime           types.String   `tfsdk:"create_time"`
+	LastModified         types.String   `tfsdk:"last_modified"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+// IAMServiceProviderDataSourceModel mirrors the attributes of the resource
+// read from the API, all computed.
+type IAMServiceProviderDataSourceModel struct {
 	ID           types.String `tfsdk:"id"`
 	DNS          types.String `tfsdk:"dns"`
 	JavaKeystore types.String `tfsdk:"java_keystore"`
@@ -33,10 +55,6 @@ type IAMServiceProviderResourceModel struct {
 	LastModified types.String `tfsdk:"last_modified"`
 }
 
-// IAMServiceProviderDataSourceModel mirrors the resource model with all
-// attributes computed.
-type IAMServiceProviderDataSourceModel = IAMServiceProviderResourceModel
-
 // IAMServiceProviderMetadataDataSourceModel is the state model for
 // `objectscale_iam_service_provider_metadata` datasource.
 type IAMServiceProviderMetadataDataSourceModel struct {
@@ -18,19 +18,21 @@ limitations under the License.
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type IAMUserResourceModel struct {
-	Arn                     types.String `tfsdk:"arn"`
-	CreateDate              types.String `tfsdk:"create_date"`
-	Path                    types.String `tfsdk:"path"`
-	PermissionsBoundaryArn  types.String `tfsdk:"permissions_boundary_arn"`
-	PermissionsBoundaryType types.String `tfsdk:"permissions_boundary_type"`
-	Tags                    types.Set    `tfsdk:"tags"`
-	Id                      types.String `tfsdk:"id"`
-	Name                    types.String `tfsdk:"name"`
-	Namespace               types.String `tfsdk:"namespace"`
+	Arn                     types.String   `tfsdk:"arn"`
+	CreateDate              types.String   `tfsdk:"create_date"`
+	Path                    types.String   `tfsdk:"path"`
+	PermissionsBoundaryArn  types.String   `tfsdk:"permissions_boundary_arn"`
+	PermissionsBoundaryType types.String   `tfsdk:"permissions_boundary_type"`
+	Tags                    types.Set      `tfsdk:"tags"`
+	Id                      types.String   `tfsdk:"id"`
+	Name                    types.String   `tfsdk:"name"`
+	Namespace               types.String   `tfsdk:"namespace"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
 }
 
 type Tags struct {
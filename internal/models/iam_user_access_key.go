@@ -18,14 +18,26 @@ limitations under the License.
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type IAMUserAccessKeyResourceModel struct {
+	CreateDate      types.String   `tfsdk:"create_date"`
+	Id              types.String   `tfsdk:"id"`
+	Namespace       types.String   `tfsdk:"namespace"`
+	SecretAccessKey types.String   `tfsdk:"secret_access_key"`
+	Status          types.String   `tfsdk:"status"`
+	UserName        types.String   `tfsdk:"username"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+type IAMAccessKeyEphemeralModel struct {
 	CreateDate      types.String `tfsdk:"create_date"`
 	Id              types.String `tfsdk:"id"`
 	Namespace       types.String `tfsdk:"namespace"`
 	SecretAccessKey types.String `tfsdk:"secret_access_key"`
 	Status          types.String `tfsdk:"status"`
 	UserName        types.String `tfsdk:"username"`
+	RevokeOnClose   types.Bool   `tfsdk:"revoke_on_close"`
 }
@@ -17,7 +17,10 @@ limitations under the License.
 
 package models
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 // VDCCertificateDataSourceModel is the tfsdk model for the VDC certificate data source.
 type VDCCertificateDataSourceModel struct {
@@ -33,20 +36,26 @@ type ObjectCertificateDataSourceModel struct {
 
 // VDCCertificateResourceModel is the tfsdk model for the VDC certificate resource.
 type VDCCertificateResourceModel struct {
-	ID                      types.String `tfsdk:"id"`
-	PrivateKey              types.String `tfsdk:"private_key"`
-	CertificateChain        types.String `tfsdk:"certificate_chain"`
-	CurrentCertificateChain types.String `tfsdk:"current_certificate_chain"`
+	ID                      types.String   `tfsdk:"id"`
+	PrivateKey              types.String   `tfsdk:"private_key"`
+	PrivateKeyWO            types.String   `tfsdk:"private_key_wo"`
+	PrivateKeyWOVersion     types.Int64    `tfsdk:"private_key_wo_version"`
+	CertificateChain        types.String   `tfsdk:"certificate_chain"`
+	CurrentCertificateChain types.String   `tfsdk:"current_certificate_chain"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
 }
 
 // ObjectCertificateResourceModel is the tfsdk model for the Object certificate resource.
 type ObjectCertificateResourceModel struct {
-	ID                      types.String `tfsdk:"id"`
-	PrivateKey              types.String `tfsdk:"private_key"`
-	CertificateChain        types.String `tfsdk:"certificate_chain"`
-	SystemSelfsigned        types.Bool   `tfsdk:"system_selfsigned"`
-	IPAddresses             types.List   `tfsdk:"ip_addresses"`
-	CurrentCertificateChain types.String `tfsdk:"current_certificate_chain"`
+	ID                      types.String   `tfsdk:"id"`
+	PrivateKey              types.String   `tfsdk:"private_key"`
+	PrivateKeyWO            types.String   `tfsdk:"private_key_wo"`
+	PrivateKeyWOVersion     types.Int64    `tfsdk:"private_key_wo_version"`
+	CertificateChain        types.String   `tfsdk:"certificate_chain"`
+	SystemSelfsigned        types.Bool     `tfsdk:"system_selfsigned"`
+	IPAddresses             types.List     `tfsdk:"ip_addresses"`
+	CurrentCertificateChain types.String   `tfsdk:"current_certificate_chain"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
 }
 
 // KeystoreGetResponse represents the JSON response from GET /vdc/keystore or GET /object-cert/keystore.
@@ -66,11 +75,3 @@ type KeyAndCertificate struct {
 	PrivateKey       string `json:"private_key"`
 	CertificateChain string `json:"certificate_chain"`
 }
-
-// KeystoreErrorResponse represents an error response from the ObjectScale keystore API.
-type KeystoreErrorResponse struct {
-	Code        int    `json:"code"`
-	Description string `json:"description"`
-	Details     string `json:"details"`
-	Retryable   bool   `json:"retryable"`
-}
@@ -18,18 +18,22 @@ limitations under the License.
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // ManagementUserResourceModel maps the Management User resource data.
 type ManagementUserResourceModel struct {
-	ID                    types.String `tfsdk:"id"`
-	Type                  types.String `tfsdk:"type"`
-	Name                  types.String `tfsdk:"name"`
-	Password              types.String `tfsdk:"password"`
-	SystemAdministrator   types.Bool   `tfsdk:"system_administrator"`
-	SystemMonitor         types.Bool   `tfsdk:"system_monitor"`
-	SecurityAdministrator types.Bool   `tfsdk:"security_administrator"`
+	ID                    types.String   `tfsdk:"id"`
+	Type                  types.String   `tfsdk:"type"`
+	Name                  types.String   `tfsdk:"name"`
+	Password              types.String   `tfsdk:"password"`
+	PasswordWO            types.String   `tfsdk:"password_wo"`
+	PasswordWOVersion     types.Int64    `tfsdk:"password_wo_version"`
+	SystemAdministrator   types.Bool     `tfsdk:"system_administrator"`
+	SystemMonitor         types.Bool     `tfsdk:"system_monitor"`
+	SecurityAdministrator types.Bool     `tfsdk:"security_administrator"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
 }
 
 // ManagementUserDataSourceModel maps the Management User data source data.
@@ -18,6 +18,7 @@ limitations under the License.
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -161,6 +162,14 @@ type NamespaceResourceModel struct {
 	RootUserPassword types.String `tfsdk:"root_user_password"`
 	// current root user password
 	CurrentRootUserPassword types.String `tfsdk:"current_root_user_password"`
+	// write-only root user password and its version
+	RootUserPasswordWO        types.String `tfsdk:"root_user_password_wo"`
+	RootUserPasswordWOVersion types.Int64  `tfsdk:"root_user_password_wo_version"`
+	// write-only current root user password
+	CurrentRootUserPasswordWO types.String `tfsdk:"current_root_user_password_wo"`
+	// delete the entities of the namespace on destroy
+	ForceDestroy types.Bool     `tfsdk:"force_destroy"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 type NsResQuota struct {
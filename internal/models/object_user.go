@@ -18,16 +18,18 @@ limitations under the License.
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type ObjectUserResourceModel struct {
-	Tags      types.Set    `tfsdk:"tags"`
-	Name      types.String `tfsdk:"name"`
-	Namespace types.String `tfsdk:"namespace"`
-	Locked    types.Bool   `tfsdk:"locked"`
-	Created   types.String `tfsdk:"created"`
-	Id        types.String `tfsdk:"id"`
+	Tags      types.Set      `tfsdk:"tags"`
+	Name      types.String   `tfsdk:"name"`
+	Namespace types.String   `tfsdk:"namespace"`
+	Locked    types.Bool     `tfsdk:"locked"`
+	Created   types.String   `tfsdk:"created"`
+	Id        types.String   `tfsdk:"id"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ObjectUserTags struct {
@@ -69,6 +71,17 @@ type ObjectUserAccessKey struct {
 }
 
 type ObjectUserSecretKeyResourceModel struct {
+	Id                 types.String   `tfsdk:"id"`
+	SecretKey          types.String   `tfsdk:"secret_key"`
+	KeyTimestamp       types.String   `tfsdk:"key_timestamp"`
+	KeyExpiryTimestamp types.String   `tfsdk:"key_expiry_timestamp"`
+	UserName           types.String   `tfsdk:"username"`
+	Namespace          types.String   `tfsdk:"namespace"`
+	ExpiryInMins       types.String   `tfsdk:"expiry_in_mins"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+type ObjectUserSecretKeyEphemeralModel struct {
 	Id                 types.String `tfsdk:"id"`
 	SecretKey          types.String `tfsdk:"secret_key"`
 	KeyTimestamp       types.String `tfsdk:"key_timestamp"`
@@ -76,4 +89,5 @@ type ObjectUserSecretKeyResourceModel struct {
 	UserName           types.String `tfsdk:"username"`
 	Namespace          types.String `tfsdk:"namespace"`
 	ExpiryInMins       types.String `tfsdk:"expiry_in_mins"`
+	RevokeOnClose      types.Bool   `tfsdk:"revoke_on_close"`
 }
@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ObjectWebhookTargetResourceModel describes the resource data model.
+type ObjectWebhookTargetResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	URL               types.String   `tfsdk:"url"`
+	AuthToken         types.String   `tfsdk:"auth_token"`
+	CaCertificate     types.String   `tfsdk:"ca_certificate"`
+	Comment           types.String   `tfsdk:"comment"`
+	PreBackoffRetries types.Int64    `tfsdk:"pre_backoff_retries"`
+	BackoffRetries    types.Int64    `tfsdk:"backoff_retries"`
+	Enabled           types.Bool     `tfsdk:"enabled"`
+	Status            types.String   `tfsdk:"status"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ObjectWebhookTargetDataSourceModel describes the data source data model.
+type ObjectWebhookTargetDataSourceModel struct {
+	ID             types.String                        `tfsdk:"id"`
+	NamePrefix     types.String                        `tfsdk:"name_prefix"`
+	WebhookTargets []ObjectWebhookTargetDataSourceItem `tfsdk:"webhook_targets"`
+}
+
+type ObjectWebhookTargetDataSourceItem struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	URL               types.String `tfsdk:"url"`
+	CaCertificate     types.String `tfsdk:"ca_certificate"`
+	Comment           types.String `tfsdk:"comment"`
+	PreBackoffRetries types.Int64  `tfsdk:"pre_backoff_retries"`
+	BackoffRetries    types.Int64  `tfsdk:"backoff_retries"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	Status            types.String `tfsdk:"status"`
+}
@@ -17,7 +17,10 @@ limitations under the License.
 
 package models
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type ReplicationGroupDatasourceModel struct {
 	ID                types.String             `tfsdk:"id"`
@@ -102,10 +105,11 @@ type ReplicationGroupResourceModel struct {
 	ZoneMappings types.Set    `tfsdk:"zone_mappings"`
 	Type         types.String `tfsdk:"type"`
 	// Optional
-	Description        types.String `tfsdk:"description"`
-	EnableRebalancing  types.Bool   `tfsdk:"enable_rebalancing"`
-	AllowAllNamespaces types.Bool   `tfsdk:"allow_all_namespaces"`
-	FullRep            types.Bool   `tfsdk:"replicate_to_all_sites"`
+	Description        types.String   `tfsdk:"description"`
+	EnableRebalancing  types.Bool     `tfsdk:"enable_rebalancing"`
+	AllowAllNamespaces types.Bool     `tfsdk:"allow_all_namespaces"`
+	FullRep            types.Bool     `tfsdk:"replicate_to_all_sites"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 // ReplicationGroupResourceZoneMapping describes the resource zone mapping data model.
@@ -17,7 +17,10 @@ limitations under the License.
 
 package models
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type StoragePoolDataSourceModel struct {
 	ID           types.String                `tfsdk:"id"`
@@ -40,3 +43,23 @@ type StoragePoolDataSourceItem struct {
 	Label                types.String `tfsdk:"label"`
 	DriveTechnology      types.String `tfsdk:"drive_technology"`
 }
+
+// StoragePoolResourceModel describes the resource data model.
+type StoragePoolResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	// Optional
+	Description          types.String `tfsdk:"description"`
+	IsColdStorageEnabled types.Bool   `tfsdk:"is_cold_storage_enabled"`
+	IsProtected          types.Bool   `tfsdk:"is_protected"`
+	NumberOfDataBlocks   types.Int32  `tfsdk:"number_of_data_blocks"`
+	NumberOfCodeBlocks   types.Int32  `tfsdk:"number_of_code_blocks"`
+	WarningAlertAt       types.Int32  `tfsdk:"warning_alert_at"`
+	ErrorAlertAt         types.Int32  `tfsdk:"error_alert_at"`
+	CriticalAlertAt      types.Int32  `tfsdk:"critical_alert_at"`
+	Label                types.String `tfsdk:"label"`
+	DriveTechnology      types.String `tfsdk:"drive_technology"`
+	// Computed
+	Status   types.Int32    `tfsdk:"status"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
@@ -17,7 +17,10 @@ limitations under the License.
 
 package models
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 // VDC Ds Model.
 type VDCDataSourceModel struct {
@@ -66,3 +69,16 @@ type VdcDsItem struct {
 	// Indicated whether the resource is an internal resource
 	Internal types.Bool `tfsdk:"internal"`
 }
+
+// VDC Resource Model.
+type VDCResourceModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	Name                 types.String   `tfsdk:"name"`
+	InterVdcEndPoints    types.String   `tfsdk:"inter_vdc_end_points"`
+	InterVdcCmdEndPoints types.String   `tfsdk:"inter_vdc_cmd_end_points"`
+	ManagementEndPoints  types.String   `tfsdk:"management_end_points"`
+	SecretKey            types.String   `tfsdk:"secret_key"`
+	Local                types.Bool     `tfsdk:"local"`
+	PermanentlyFailed    types.Bool     `tfsdk:"permanently_failed"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policytypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*Document)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*Document)(nil)
+	_ xattr.ValidateableAttribute                = (*Document)(nil)
+)
+
+// Document is a policy document in JSON. Two documents are semantically equal when they have the same
+// normalized form, see Normalize, so that the formatting of ObjectScale does not cause differences.
+type Document struct {
+	basetypes.StringValue
+}
+
+// Type returns a DocumentType.
+func (v Document) Type(_ context.Context) attr.Type {
+	return DocumentType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Document) Equal(o attr.Value) bool {
+	other, ok := o.(Document)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given policy document is semantically equal to the current one.
+// A document which cannot be decoded is only equal to the same string.
+func (v Document) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Document)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+		return false, diags
+	}
+
+	if v.ValueString() == newValue.ValueString() {
+		return true, diags
+	}
+	current, err := Normalize(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	updated, err := Normalize(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return current == updated, diags
+}
+
+// ValidateAttribute checks that the policy document is a JSON object.
+func (v Document) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	var document map[string]any
+	if err := json.Unmarshal([]byte(v.ValueString()), &document); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Policy Document",
+			"A string value was provided that is not a valid JSON policy document: "+err.Error()+"\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+	}
+}
+
+// NewDocumentNull creates a Document with a null value.
+func NewDocumentNull() Document {
+	return Document{StringValue: basetypes.NewStringNull()}
+}
+
+// NewDocumentUnknown creates a Document with an unknown value.
+func NewDocumentUnknown() Document {
+	return Document{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewDocumentValue creates a Document with a known value.
+func NewDocumentValue(value string) Document {
+	return Document{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewDocumentPointerValue creates a Document with a null value if nil, or a known value.
+func NewDocumentPointerValue(value *string) Document {
+	return Document{StringValue: basetypes.NewStringPointerValue(value)}
+}
+
+// NewDocumentFromAPI creates a Document from a policy document returned by ObjectScale, which is URL-decoded
+// if needed, see Decode. A nil document is null.
+func NewDocumentFromAPI(value *string) Document {
+	if value == nil {
+		return NewDocumentNull()
+	}
+	return NewDocumentValue(Decode(*value))
+}
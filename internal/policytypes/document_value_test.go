@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policytypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocumentStringSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	current := NewDocumentValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"}]}`)
+
+	equal, diags := current.StringSemanticEquals(ctx, NewDocumentValue(`{"Statement":{"Resource":"*","Action":"s3:GetObject","Effect":"Allow"},"Version":"2012-10-17"}`))
+	assert.False(t, diags.HasError())
+	assert.True(t, equal)
+
+	equal, diags = current.StringSemanticEquals(ctx, NewDocumentValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`))
+	assert.False(t, diags.HasError())
+	assert.False(t, equal)
+
+	// documents which cannot be decoded are only equal to themselves
+	equal, diags = current.StringSemanticEquals(ctx, NewDocumentValue("not a policy"))
+	assert.False(t, diags.HasError())
+	assert.False(t, equal)
+	equal, diags = NewDocumentValue("not a policy").StringSemanticEquals(ctx, NewDocumentValue("not a policy"))
+	assert.False(t, diags.HasError())
+	assert.True(t, equal)
+
+	_, diags = current.StringSemanticEquals(ctx, basetypes.NewStringValue("{}"))
+	assert.True(t, diags.HasError())
+}
+
+func TestDocumentValidateAttribute(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		value   Document
+		invalid bool
+	}{
+		{NewDocumentValue(`{"Statement":[]}`), false},
+		{NewDocumentNull(), false},
+		{NewDocumentUnknown(), false},
+		{NewDocumentValue(`{"Statement":`), true},
+		{NewDocumentValue(`["s3:*"]`), true},
+	}
+	for _, tt := range tests {
+		var resp xattr.ValidateAttributeResponse
+		tt.value.ValidateAttribute(ctx, xattr.ValidateAttributeRequest{Path: path.Root("policy")}, &resp)
+		assert.Equal(t, tt.invalid, resp.Diagnostics.HasError(), "value %s", tt.value)
+	}
+}
+
+func TestDocumentType(t *testing.T) {
+	ctx := context.Background()
+	value, err := DocumentType{}.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, `{"Statement":[]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, NewDocumentValue(`{"Statement":[]}`), value)
+	assert.True(t, DocumentType{}.Equal(value.Type(ctx)))
+	assert.False(t, DocumentType{}.Equal(basetypes.StringType{}))
+}
@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policytypes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Decode returns a policy document in JSON. ObjectScale returns some of the policy documents URL-encoded,
+// as described in RFC 3986; those are decoded, and the other documents are returned unchanged.
+func Decode(document string) string {
+	trimmed := strings.TrimSpace(document)
+	if trimmed == "" || strings.HasPrefix(trimmed, "{") {
+		return document
+	}
+	decoded, err := url.QueryUnescape(trimmed)
+	if err != nil {
+		return document
+	}
+	return decoded
+}
+
+// Normalize returns the canonical JSON form of a policy document, in which:
+//   - the document is URL-decoded, see Decode, and the keys are sorted;
+//   - the Statement element is always a list, sorted;
+//   - the actions, resources, principals and condition values are always sorted lists of strings without duplicates,
+//     e.g. "Action": "s3:GetObject" is the same as "Action": ["s3:GetObject"];
+//   - the "*" principal is the same as {"AWS": "*"}.
+func Normalize(document string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(Decode(document)))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return "", fmt.Errorf("invalid policy document: %w", err)
+	}
+	if m, ok := value.(map[string]any); ok {
+		value = normalizeDocument(m)
+	}
+	return marshal(value)
+}
+
+// helper function to normalize the elements of a policy document.
+func normalizeDocument(document map[string]any) map[string]any {
+	statements, ok := document["Statement"]
+	if !ok {
+		return document
+	}
+	list, ok := statements.([]any)
+	if !ok {
+		list = []any{statements}
+	}
+	normalized := make([]any, 0, len(list))
+	for _, s := range list {
+		if m, ok := s.(map[string]any); ok {
+			normalized = append(normalized, normalizeStatement(m))
+		} else {
+			normalized = append(normalized, s)
+		}
+	}
+	// the order of the statements does not matter
+	slices.SortStableFunc(normalized, func(a, b any) int {
+		x, _ := marshal(a)
+		y, _ := marshal(b)
+		return strings.Compare(x, y)
+	})
+	document["Statement"] = normalized
+	return document
+}
+
+// helper function to normalize the elements of a policy statement.
+func normalizeStatement(statement map[string]any) map[string]any {
+	for key, value := range statement {
+		switch key {
+		case "Action", "NotAction", "Resource", "NotResource":
+			statement[key] = stringSet(value)
+		case "Principal", "NotPrincipal":
+			if value == "*" {
+				value = map[string]any{"AWS": "*"}
+			}
+			if principals, ok := value.(map[string]any); ok {
+				for t, ids := range principals {
+					principals[t] = stringSet(ids)
+				}
+			}
+			statement[key] = value
+		case "Condition":
+			if tests, ok := value.(map[string]any); ok {
+				for _, variables := range tests {
+					if variables, ok := variables.(map[string]any); ok {
+						for variable, values := range variables {
+							variables[variable] = stringSet(values)
+						}
+					}
+				}
+			}
+		}
+	}
+	return statement
+}
+
+// helper function to convert a string, or a list of scalars, to a sorted list of strings without duplicates.
+// Other values are returned unchanged.
+func stringSet(value any) any {
+	list, ok := value.([]any)
+	if !ok {
+		list = []any{value}
+	}
+	set := make([]string, 0, len(list))
+	for _, v := range list {
+		switch v := v.(type) {
+		case string:
+			set = append(set, v)
+		case json.Number:
+			set = append(set, v.String())
+		case bool:
+			set = append(set, fmt.Sprint(v))
+		default:
+			return value
+		}
+	}
+	slices.Sort(set)
+	return slices.Compact(set)
+}
+
+// helper function to encode a value in JSON, without escaping the characters <, > and &.
+func marshal(value any) (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policytypes
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	document := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`
+	assert.Equal(t, document, Decode(document))
+	assert.Equal(t, document, Decode(url.QueryEscape(document)))
+	assert.Equal(t, "", Decode(""))
+	assert.Equal(t, "%zz", Decode("%zz"))
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name  string
+		left  string
+		right string
+		equal bool
+	}{
+		{
+			name:  "whitespace and key order",
+			left:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			right: "{\n  \"Statement\": [\n    {\"Resource\": \"*\", \"Action\": \"s3:*\", \"Effect\": \"Allow\"}\n  ],\n  \"Version\": \"2012-10-17\"\n}",
+			equal: true,
+		},
+		{
+			name:  "single value and list",
+			left:  `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":["bucket1/*"]}]}`,
+			right: `{"Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"bucket1/*"}]}`,
+			equal: true,
+		},
+		{
+			name:  "order and duplicates of the values",
+			left:  `{"Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+			right: `{"Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+			equal: true,
+		},
+		{
+			name:  "single statement and order of the statements",
+			left:  `{"Statement":{"Sid":"A","Effect":"Allow","Action":"s3:*","Resource":"*"}}`,
+			right: `{"Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			equal: true,
+		},
+		{
+			name:  "order of the statements",
+			left:  `{"Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:*","Resource":"*"},{"Sid":"B","Effect":"Deny","Action":"iam:*","Resource":"*"}]}`,
+			right: `{"Statement":[{"Sid":"B","Effect":"Deny","Action":"iam:*","Resource":"*"},{"Sid":"A","Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			equal: true,
+		},
+		{
+			name:  "principals",
+			left:  `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":"urn:ecs:iam::ns1:user/u1"}}]}`,
+			right: `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":["urn:ecs:iam::ns1:user/u1"]}}]}`,
+			equal: true,
+		},
+		{
+			name:  "everyone",
+			left:  `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":"*"}]}`,
+			right: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":{"AWS":["*"]}}]}`,
+			equal: true,
+		},
+		{
+			name:  "conditions",
+			left:  `{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":true},"NumericLessThan":{"s3:max-keys":10}}}]}`,
+			right: `{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":["true"]},"NumericLessThan":{"s3:max-keys":"10"}}}]}`,
+			equal: true,
+		},
+		{
+			name:  "url-encoded",
+			left:  `{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			right: url.QueryEscape(`{"Statement": [{"Effect": "Allow", "Action": ["s3:*"], "Resource": "*"}]}`),
+			equal: true,
+		},
+		{
+			name:  "different effect",
+			left:  `{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			right: `{"Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*"}]}`,
+		},
+		{
+			name:  "different actions",
+			left:  `{"Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"}]}`,
+			right: `{"Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+		},
+		{
+			name:  "action and not action",
+			left:  `{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			right: `{"Statement":[{"Effect":"Allow","NotAction":"s3:*","Resource":"*"}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left, err := Normalize(tt.left)
+			assert.NoError(t, err)
+			right, err := Normalize(tt.right)
+			assert.NoError(t, err)
+			if tt.equal {
+				assert.Equal(t, left, right)
+			} else {
+				assert.NotEqual(t, left, right)
+			}
+		})
+	}
+
+	_, err := Normalize(`{"Statement":`)
+	assert.Error(t, err)
+}
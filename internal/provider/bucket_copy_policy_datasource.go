@@ -0,0 +1,292 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-objectscale/internal/clientgen"
+	"terraform-provider-objectscale/internal/helper"
+	"terraform-provider-objectscale/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BucketCopyPolicyDataSource{}
+
+func NewBucketCopyPolicyDataSource() datasource.DataSource {
+	return &BucketCopyPolicyDataSource{}
+}
+
+type BucketCopyPolicyDataSource struct {
+	datasourceProviderConfig
+}
+
+func (d *BucketCopyPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_copy_policy"
+}
+
+// datasource item schema.
+func (d *BucketCopyPolicyDataSource) itemSchema() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description:         "List of bucket copy policies fetched using this datasource.",
+		MarkdownDescription: "List of bucket copy policies fetched using this datasource.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"external_endpoint": schema.StringAttribute{
+					Description:         "S3 endpoint of the target.",
+					MarkdownDescription: "S3 endpoint of the target.",
+					Computed:            true,
+				},
+				"target_region": schema.StringAttribute{
+					Description:         "Region of the target bucket.",
+					MarkdownDescription: "Region of the target bucket.",
+					Computed:            true,
+				},
+				"target_bucket": schema.StringAttribute{
+					Description:         "Name of the target bucket.",
+					MarkdownDescription: "Name of the target bucket.",
+					Computed:            true,
+				},
+				"target_account": schema.StringAttribute{
+					Description:         "Namespace or account of the target bucket.",
+					MarkdownDescription: "Namespace or account of the target bucket.",
+					Computed:            true,
+				},
+				"target_role": schema.StringAttribute{
+					Description:         "IAM role assumed to write to the target bucket.",
+					MarkdownDescription: "IAM role assumed to write to the target bucket.",
+					Computed:            true,
+				},
+				"target_access_key": schema.StringAttribute{
+					Description:         "Access key used to write to the target bucket.",
+					MarkdownDescription: "Access key used to write to the target bucket.",
+					Computed:            true,
+				},
+				"policy_type": schema.StringAttribute{
+					Description:         "Type of the policy.",
+					MarkdownDescription: "Type of the policy.",
+					Computed:            true,
+				},
+				"days_after_last_write": schema.Int64Attribute{
+					Description:         "Number of days after the last write before an object is copied.",
+					MarkdownDescription: "Number of days after the last write before an object is copied.",
+					Computed:            true,
+				},
+				"minimum_size": schema.Int64Attribute{
+					Description:         "Minimum size in bytes of the objects to copy.",
+					MarkdownDescription: "Minimum size in bytes of the objects to copy.",
+					Computed:            true,
+				},
+				"tag_filter": schema.StringAttribute{
+					Description:         "Only the objects with this tag are copied.",
+					MarkdownDescription: "Only the objects with this tag are copied.",
+					Computed:            true,
+				},
+				"backup_read_target": schema.BoolAttribute{
+					Description:         "Whether reads of the objects missing from the source bucket are served from the target bucket.",
+					MarkdownDescription: "Whether reads of the objects missing from the source bucket are served from the target bucket.",
+					Computed:            true,
+				},
+				"sse_s3_enabled": schema.BoolAttribute{
+					Description:         "Whether the copied objects are encrypted with SSE-S3 in the target bucket.",
+					MarkdownDescription: "Whether the copied objects are encrypted with SSE-S3 in the target bucket.",
+					Computed:            true,
+				},
+				"detailed_log_enabled": schema.BoolAttribute{
+					Description:         "Whether a detailed log of the copy operations is written.",
+					MarkdownDescription: "Whether a detailed log of the copy operations is written.",
+					Computed:            true,
+				},
+				"detailed_log_errors_only": schema.BoolAttribute{
+					Description:         "Whether only the failed copy operations are logged.",
+					MarkdownDescription: "Whether only the failed copy operations are logged.",
+					Computed:            true,
+				},
+				"detailed_log_bucket": schema.StringAttribute{
+					Description:         "Bucket receiving the detailed log.",
+					MarkdownDescription: "Bucket receiving the detailed log.",
+					Computed:            true,
+				},
+				"detailed_log_prefix": schema.StringAttribute{
+					Description:         "Prefix of the detailed log objects.",
+					MarkdownDescription: "Prefix of the detailed log objects.",
+					Computed:            true,
+				},
+				"external_certs": schema.StringAttribute{
+					Description:         "PEM encoded certificates trusted to connect to the external endpoint.",
+					MarkdownDescription: "PEM encoded certificates trusted to connect to the external endpoint.",
+					Computed:            true,
+				},
+				"is_running": schema.BoolAttribute{
+					Description:         "Whether the policy is currently copying objects.",
+					MarkdownDescription: "Whether the policy is currently copying objects.",
+					Computed:            true,
+				},
+				"total_error_count": schema.Int64Attribute{
+					Description:         "Number of objects which failed to be copied.",
+					MarkdownDescription: "Number of objects which failed to be copied.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// Schema describes the data source arguments.
+func (d *BucketCopyPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource can be used to fetch the bucket copy (data movement) policies from Dell ObjectScale.",
+		Description:         "This datasource can be used to fetch the bucket copy (data movement) policies from Dell ObjectScale.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the datasource.",
+				MarkdownDescription: "Identifier of the datasource.",
+				Computed:            true,
+			},
+			"namespace": schema.StringAttribute{
+				Description:         "Namespace from which the copy policies are fetched. If none given, the namespace of the current user is used.",
+				MarkdownDescription: "Namespace from which the copy policies are fetched. If none given, the namespace of the current user is used.",
+				Optional:            true,
+			},
+			"bucket": schema.StringAttribute{
+				Description:         "Name of the bucket whose copy policy is fetched. If none given, all the copy policies of the namespace are fetched.",
+				MarkdownDescription: "Name of the bucket whose copy policy is fetched. If none given, all the copy policies of the namespace are fetched.",
+				Optional:            true,
+			},
+			"copy_policies": d.itemSchema(),
+		},
+	}
+}
+
+func (d *BucketCopyPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.BucketCopyPolicyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var policies []clientgen.BucketServiceListCopyPoliciesResponseBucketCopyPolicyInner
+
+	if bucket := helper.ValueToPointer[string](data.Bucket); bucket != nil {
+		// get by bucket
+		dsreq := d.client.GenClient.BucketApi.BucketServiceGetCopyPolicy(ctx, *bucket)
+		if namespace := helper.ValueToPointer[string](data.Namespace); namespace != nil {
+			dsreq = dsreq.Account(*namespace)
+		}
+		policy, _, err := dsreq.Execute()
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching copy policy of bucket: "+*bucket, helper.APIErrorDetail(err))
+			return
+		}
+		if policy.TargetBucket != nil && *policy.TargetBucket != "" {
+			policies = append(policies, d.fromGetResponse(*policy))
+		}
+	} else {
+		// list copy policies request
+		dsreq := d.client.GenClient.BucketApi.BucketServiceListCopyPolicies(ctx)
+		if namespace := helper.ValueToPointer[string](data.Namespace); namespace != nil {
+			dsreq = dsreq.Account(*namespace)
+		}
+		dsresp, _, err := dsreq.Execute()
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching bucket copy policies", helper.APIErrorDetail(err))
+			return
+		}
+		policies = dsresp.BucketCopyPolicy
+	}
+
+	// hardcoding a response value to save into the Terraform state.
+	data.ID = types.StringValue("bucket_copy_policy_datasource")
+	data.CopyPolicies = d.updateState(policies)
+
+	tflog.Trace(ctx, "read bucket copy policy data source done")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// helper function to convert the copy policy of a single bucket to a list item.
+func (d *BucketCopyPolicyDataSource) fromGetResponse(p clientgen.BucketServiceGetCopyPolicyResponse) clientgen.BucketServiceListCopyPoliciesResponseBucketCopyPolicyInner {
+	item := clientgen.BucketServiceListCopyPoliciesResponseBucketCopyPolicyInner{
+		DaysAfterLastWrite:    p.DaysAfterLastWrite,
+		MinimumSize:           p.MinimumSize,
+		ExternalEndpoint:      p.ExternalEndpoint,
+		TargetRegion:          p.TargetRegion,
+		TargetBucket:          p.TargetBucket,
+		TargetAccessKey:       p.TargetAccessKey,
+		TargetAccount:         p.TargetAccount,
+		TargetRole:            p.TargetRole,
+		TagFilter:             p.TagFilter,
+		PolicyType:            p.PolicyType,
+		BackupReadTarget:      p.BackupReadTarget,
+		SseS3Enabled:          p.SseS3Enabled,
+		DetailedLogEnabled:    p.DetailedLogEnabled,
+		DetailedLogErrorsOnly: p.DetailedLogErrorsOnly,
+		DetailedLogBucket:     p.DetailedLogBucket,
+		DetailedLogPrefix:     p.DetailedLogPrefix,
+		ExternalCerts:         p.ExternalCerts,
+	}
+	if s := p.BucketCopyPolicyStatus; s != nil {
+		item.BucketCopyPolicyStatus = &clientgen.BucketServiceListCopyPoliciesResponseBucketCopyPolicyInnerBucketCopyPolicyStatus{
+			LastScanStartTime: s.LastScanStartTime,
+			IsRunning:         s.IsRunning,
+			PolicyWatermark:   s.PolicyWatermark,
+			TotalErrorCount:   s.TotalErrorCount,
+		}
+	}
+	return item
+}
+
+func (d *BucketCopyPolicyDataSource) updateState(policies []clientgen.BucketServiceListCopyPoliciesResponseBucketCopyPolicyInner) []models.BucketCopyPolicyDataSourceItem {
+	return helper.SliceTransform(policies, func(v clientgen.BucketServiceListCopyPoliciesResponseBucketCopyPolicyInner) models.BucketCopyPolicyDataSourceItem {
+		item := models.BucketCopyPolicyDataSourceItem{
+			ExternalEndpoint:      helper.TfStringNN(v.ExternalEndpoint),
+			TargetRegion:          helper.TfStringNN(v.TargetRegion),
+			TargetBucket:          helper.TfStringNN(v.TargetBucket),
+			TargetAccount:         helper.TfStringNN(v.TargetAccount),
+			TargetRole:            helper.TfStringNN(v.TargetRole),
+			TargetAccessKey:       helper.TfStringNN(v.TargetAccessKey),
+			PolicyType:            helper.TfStringNN(v.PolicyType),
+			DaysAfterLastWrite:    helper.TfInt64NN(v.DaysAfterLastWrite),
+			MinimumSize:           helper.TfInt64NN(v.MinimumSize),
+			TagFilter:             helper.TfStringNN(v.TagFilter),
+			BackupReadTarget:      helper.TfBoolNN(v.BackupReadTarget),
+			SseS3Enabled:          helper.TfBoolNN(v.SseS3Enabled),
+			DetailedLogEnabled:    helper.TfBoolNN(v.DetailedLogEnabled),
+			DetailedLogErrorsOnly: helper.TfBoolNN(v.DetailedLogErrorsOnly),
+			DetailedLogBucket:     helper.TfStringNN(v.DetailedLogBucket),
+			DetailedLogPrefix:     helper.TfStringNN(v.DetailedLogPrefix),
+			ExternalCerts:         helper.TfStringNN(v.ExternalCerts),
+			IsRunning:             types.BoolValue(false),
+			TotalErrorCount:       types.Int64Value(0),
+		}
+		if s := v.BucketCopyPolicyStatus; s != nil {
+			item.IsRunning = helper.TfBoolNN(s.IsRunning)
+			item.TotalErrorCount = helper.TfInt64NN(s.TotalErrorCount)
+		}
+		return item
+	})
+}
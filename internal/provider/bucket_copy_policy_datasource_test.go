@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-objectscale/internal/clientgen"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBucketCopyPolicyDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+	defer testUserTokenCleanup(t)
+
+	var mockAPI *mockey.Mocker
+	unPatchFunc := func() {
+		if mockAPI != nil {
+			mockAPI.UnPatch()
+		}
+	}
+
+	copyPolicyConfig := bucketCopyPolicyBucketsConfig + `
+	resource "objectscale_bucket_copy_policy" "test" {
+		bucket = objectscale_bucket.source.name
+		namespace = "ns1"
+		target_bucket = objectscale_bucket.target.name
+		target_account = "ns1"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// get all in namespace
+				Config: copyPolicyConfig + `
+				data "objectscale_bucket_copy_policy" "all" {
+					namespace = "ns1"
+					depends_on = [objectscale_bucket_copy_policy.test]
+				}
+				`,
+				Check: resource.TestCheckResourceAttrSet("data.objectscale_bucket_copy_policy.all", "copy_policies.#"),
+			},
+			{
+				// get by bucket
+				Config: copyPolicyConfig + `
+				data "objectscale_bucket_copy_policy" "by_bucket" {
+					namespace = "ns1"
+					bucket = objectscale_bucket_copy_policy.test.bucket
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.objectscale_bucket_copy_policy.by_bucket", "copy_policies.#", "1"),
+					resource.TestCheckResourceAttr("data.objectscale_bucket_copy_policy.by_bucket", "copy_policies.0.target_bucket", "tfacc-copy-policy-target"),
+				),
+			},
+			{
+				// get by invalid bucket
+				Config: ProviderConfigForTesting + `
+				data "objectscale_bucket_copy_policy" "by_invalid_bucket" {
+					namespace = "ns1"
+					bucket = "invalid-bucket"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Error fetching copy policy of bucket: invalid-bucket`),
+			},
+			{
+				// list mock error
+				PreConfig: func() {
+					mockAPI = mockey.Mock((*clientgen.BucketApiService).BucketServiceListCopyPoliciesExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				Config: ProviderConfigForTesting + `
+				data "objectscale_bucket_copy_policy" "all" {
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Error fetching bucket copy policies`),
+			},
+			{
+				PreConfig: unPatchFunc,
+				Config:    copyPolicyConfig,
+			},
+		},
+	})
+}
@@ -0,0 +1,425 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"terraform-provider-objectscale/internal/clientgen"
+	"terraform-provider-objectscale/internal/helper"
+	"terraform-provider-objectscale/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BucketCopyPolicyResource{}
+var _ resource.ResourceWithImportState = &BucketCopyPolicyResource{}
+
+func NewBucketCopyPolicyResource() resource.Resource {
+	return &BucketCopyPolicyResource{}
+}
+
+// BucketCopyPolicyResource defines the resource implementation.
+type BucketCopyPolicyResource struct {
+	resourceProviderConfig
+}
+
+func (r *BucketCopyPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_copy_policy"
+}
+
+func (r *BucketCopyPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This resource manages the copy (data movement) policy of a bucket on Dell ObjectScale, which replicates the objects of the bucket to a target bucket.",
+		MarkdownDescription: "This resource manages the copy (data movement) policy of a bucket on Dell ObjectScale, which replicates the objects of the bucket to a target bucket.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the copy policy, in the format bucket_name:namespace.",
+				MarkdownDescription: "Identifier of the copy policy, in the format `bucket_name:namespace`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"bucket": schema.StringAttribute{
+				Description:         "Name of the source bucket. Cannot be updated.",
+				MarkdownDescription: "Name of the source bucket. Cannot be updated.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"namespace": schema.StringAttribute{
+				Description:         "Namespace of the source bucket. Cannot be updated.",
+				MarkdownDescription: "Namespace of the source bucket. Cannot be updated.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"external_endpoint": schema.StringAttribute{
+				Description:         "S3 endpoint of the target, ex. http://10.10.10.10:9020/. Cannot be updated.",
+				MarkdownDescription: "S3 endpoint of the target, ex. `http://10.10.10.10:9020/`. Cannot be updated.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"target_region": schema.StringAttribute{
+				Description:         "Region of the target bucket. Cannot be updated.",
+				MarkdownDescription: "Region of the target bucket. Cannot be updated.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"target_bucket": schema.StringAttribute{
+				Description:         "Name of the target bucket. Cannot be updated.",
+				MarkdownDescription: "Name of the target bucket. Cannot be updated.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"target_account": schema.StringAttribute{
+				Description:         "Namespace or account of the target bucket. Cannot be updated.",
+				MarkdownDescription: "Namespace or account of the target bucket. Cannot be updated.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"target_role": schema.StringAttribute{
+				Description:         "IAM role assumed to write to the target bucket. Cannot be updated.",
+				MarkdownDescription: "IAM role assumed to write to the target bucket. Cannot be updated.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"target_access_key": schema.StringAttribute{
+				Description:         "Access key used to write to the target bucket.",
+				MarkdownDescription: "Access key used to write to the target bucket.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"target_secret_key": schema.StringAttribute{
+				Description:         "Secret key of target_access_key. It is not returned by the array, so changes made outside of Terraform are not detected.",
+				MarkdownDescription: "Secret key of `target_access_key`. It is not returned by the array, so changes made outside of Terraform are not detected.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"policy_type": schema.StringAttribute{
+				Description:         "Type of the policy, ex. copy_only. Cannot be updated.",
+				MarkdownDescription: "Type of the policy, ex. `copy_only`. Cannot be updated.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"days_after_last_write": schema.Int64Attribute{
+				Description:         "Number of days after the last write before an object is copied.",
+				MarkdownDescription: "Number of days after the last write before an object is copied.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"minimum_size": schema.Int64Attribute{
+				Description:         "Minimum size in bytes of the objects to copy.",
+				MarkdownDescription: "Minimum size in bytes of the objects to copy.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"tag_filter": schema.StringAttribute{
+				Description:         "Only the objects with this tag are copied.",
+				MarkdownDescription: "Only the objects with this tag are copied.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"backup_read_target": schema.BoolAttribute{
+				Description:         "Whether reads of the objects missing from the source bucket are served from the target bucket.",
+				MarkdownDescription: "Whether reads of the objects missing from the source bucket are served from the target bucket.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"sse_s3_enabled": schema.BoolAttribute{
+				Description:         "Whether the copied objects are encrypted with SSE-S3 in the target bucket.",
+				MarkdownDescription: "Whether the copied objects are encrypted with SSE-S3 in the target bucket.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"detailed_log_enabled": schema.BoolAttribute{
+				Description:         "Whether a detailed log of the copy operations is written to detailed_log_bucket.",
+				MarkdownDescription: "Whether a detailed log of the copy operations is written to `detailed_log_bucket`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"detailed_log_errors_only": schema.BoolAttribute{
+				Description:         "Whether only the failed copy operations are logged.",
+				MarkdownDescription: "Whether only the failed copy operations are logged.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"detailed_log_bucket": schema.StringAttribute{
+				Description:         "Bucket receiving the detailed log.",
+				MarkdownDescription: "Bucket receiving the detailed log.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"detailed_log_prefix": schema.StringAttribute{
+				Description:         "Prefix of the detailed log objects.",
+				MarkdownDescription: "Prefix of the detailed log objects.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"external_certs": schema.StringAttribute{
+				Description:         "PEM encoded certificates trusted to connect to external_endpoint.",
+				MarkdownDescription: "PEM encoded certificates trusted to connect to `external_endpoint`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+// helper function to marshal GET response to tfsdk model.
+// The target secret key is never returned by the array, so the configured one is kept.
+func (r *BucketCopyPolicyResource) respToModel(policy *clientgen.BucketServiceGetCopyPolicyResponse, bucket, namespace string, secretKey types.String) models.BucketCopyPolicyResourceModel {
+	return models.BucketCopyPolicyResourceModel{
+		ID:                    types.StringValue(bucket + ":" + namespace),
+		Bucket:                types.StringValue(bucket),
+		Namespace:             types.StringValue(namespace),
+		ExternalEndpoint:      helper.TfStringNN(policy.ExternalEndpoint),
+		TargetRegion:          helper.TfStringNN(policy.TargetRegion),
+		TargetBucket:          helper.TfStringNN(policy.TargetBucket),
+		TargetAccount:         helper.TfStringNN(policy.TargetAccount),
+		TargetRole:            helper.TfStringNN(policy.TargetRole),
+		TargetAccessKey:       helper.TfStringNN(policy.TargetAccessKey),
+		TargetSecretKey:       secretKey,
+		PolicyType:            helper.TfStringNN(policy.PolicyType),
+		DaysAfterLastWrite:    helper.TfInt64NN(policy.DaysAfterLastWrite),
+		MinimumSize:           helper.TfInt64NN(policy.MinimumSize),
+		TagFilter:             helper.TfStringNN(policy.TagFilter),
+		BackupReadTarget:      helper.TfBoolNN(policy.BackupReadTarget),
+		SseS3Enabled:          helper.TfBoolNN(policy.SseS3Enabled),
+		DetailedLogEnabled:    helper.TfBoolNN(policy.DetailedLogEnabled),
+		DetailedLogErrorsOnly: helper.TfBoolNN(policy.DetailedLogErrorsOnly),
+		DetailedLogBucket:     helper.TfStringNN(policy.DetailedLogBucket),
+		DetailedLogPrefix:     helper.TfStringNN(policy.DetailedLogPrefix),
+		ExternalCerts:         helper.TfStringNN(policy.ExternalCerts),
+	}
+}
+
+// helper function to read the copy policy of a bucket.
+func (r *BucketCopyPolicyResource) getCopyPolicy(ctx context.Context, bucket, namespace string) (*clientgen.BucketServiceGetCopyPolicyResponse, error) {
+	policy, _, err := r.client.GenClient.BucketApi.BucketServiceGetCopyPolicy(ctx, bucket).Account(namespace).Execute()
+	return policy, err
+}
+
+// Create.
+func (r *BucketCopyPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "creating bucket copy policy")
+	var plan models.BucketCopyPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, namespace := plan.Bucket.ValueString(), plan.Namespace.ValueString()
+	_, _, err := r.client.GenClient.BucketApi.BucketServicePostCopyPolicy(ctx, bucket).Account(namespace).
+		BucketServicePostCopyPolicyRequest(clientgen.BucketServicePostCopyPolicyRequest{
+			ExternalEndpoint:      helper.ValueToPointer[string](plan.ExternalEndpoint),
+			TargetRegion:          helper.ValueToPointer[string](plan.TargetRegion),
+			TargetBucket:          plan.TargetBucket.ValueStringPointer(),
+			TargetAccount:         helper.ValueToPointer[string](plan.TargetAccount),
+			TargetRole:            helper.ValueToPointer[string](plan.TargetRole),
+			TargetAccessKey:       helper.ValueToPointer[string](plan.TargetAccessKey),
+			TargetSecretKey:       helper.ValueToPointer[string](plan.TargetSecretKey),
+			PolicyType:            helper.ValueToPointer[string](plan.PolicyType),
+			DaysAfterLastWrite:    helper.ValueToPointer[int64](plan.DaysAfterLastWrite),
+			MinimumSize:           helper.ValueToPointer[int64](plan.MinimumSize),
+			TagFilter:             helper.ValueToPointer[string](plan.TagFilter),
+			BackupReadTarget:      helper.ValueToPointer[bool](plan.BackupReadTarget),
+			SseS3Enabled:          helper.ValueToPointer[bool](plan.SseS3Enabled),
+			DetailedLogEnabled:    helper.ValueToPointer[bool](plan.DetailedLogEnabled),
+			DetailedLogErrorsOnly: helper.ValueToPointer[bool](plan.DetailedLogErrorsOnly),
+			DetailedLogBucket:     helper.ValueToPointer[string](plan.DetailedLogBucket),
+			DetailedLogPrefix:     helper.ValueToPointer[string](plan.DetailedLogPrefix),
+			ExternalCerts:         helper.ValueToPointer[string](plan.ExternalCerts),
+		}).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating bucket copy policy", helper.APIErrorDetail(err))
+		return
+	}
+
+	policy, err := r.getCopyPolicy(ctx, bucket, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading bucket copy policy state after create", helper.APIErrorDetail(err))
+		return
+	}
+
+	// Save data into Terraform state
+	state := r.respToModel(policy, bucket, namespace, plan.TargetSecretKey)
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read.
+func (r *BucketCopyPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.BucketCopyPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.getCopyPolicy(ctx, state.Bucket.ValueString(), state.Namespace.ValueString())
+	if err != nil {
+		if helper.RemoveNotFound(ctx, err, &resp.State, "copy policy of bucket "+state.Bucket.ValueString()) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading bucket copy policy state", helper.APIErrorDetail(err))
+		return
+	}
+
+	if policy.TargetBucket == nil || *policy.TargetBucket == "" {
+		// the policy was deleted outside of Terraform
+		helper.RemoveMissing(ctx, &resp.State, "copy policy of bucket "+state.Bucket.ValueString())
+		return
+	}
+
+	state2 := r.respToModel(policy, state.Bucket.ValueString(), state.Namespace.ValueString(), state.TargetSecretKey)
+	state2.Timeouts = state.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state2)...)
+}
+
+// Update.
+func (r *BucketCopyPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "updating bucket copy policy")
+	var plan models.BucketCopyPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, namespace := plan.Bucket.ValueString(), plan.Namespace.ValueString()
+	_, _, err := r.client.GenClient.BucketApi.BucketServicePutCopyPolicy(ctx, bucket).Account(namespace).
+		BucketServicePutCopyPolicyRequest(clientgen.BucketServicePutCopyPolicyRequest{
+			DaysAfterLastWrite:    helper.ValueToPointer[int64](plan.DaysAfterLastWrite),
+			MinimumSize:           helper.ValueToPointer[int64](plan.MinimumSize),
+			TagFilter:             helper.ValueToPointer[string](plan.TagFilter),
+			BackupReadTarget:      helper.ValueToPointer[bool](plan.BackupReadTarget),
+			SseS3Enabled:          helper.ValueToPointer[bool](plan.SseS3Enabled),
+			DetailedLogEnabled:    helper.ValueToPointer[bool](plan.DetailedLogEnabled),
+			DetailedLogErrorsOnly: helper.ValueToPointer[bool](plan.DetailedLogErrorsOnly),
+			DetailedLogBucket:     helper.ValueToPointer[string](plan.DetailedLogBucket),
+			DetailedLogPrefix:     helper.ValueToPointer[string](plan.DetailedLogPrefix),
+			ExternalCerts:         helper.ValueToPointer[string](plan.ExternalCerts),
+			TargetAccessKey:       helper.ValueToPointer[string](plan.TargetAccessKey),
+			TargetSecretKey:       helper.ValueToPointer[string](plan.TargetSecretKey),
+		}).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating bucket copy policy", helper.APIErrorDetail(err))
+		return
+	}
+
+	// Read updated data
+	policy, err := r.getCopyPolicy(ctx, bucket, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading bucket copy policy state after update", helper.APIErrorDetail(err))
+		return
+	}
+	state := r.respToModel(policy, bucket, namespace, plan.TargetSecretKey)
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete.
+func (r *BucketCopyPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "deleting bucket copy policy")
+	var state models.BucketCopyPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := r.client.GenClient.BucketApi.BucketServiceDeleteCopyPolicy(ctx, state.Bucket.ValueString()).Account(state.Namespace.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting bucket copy policy", helper.APIErrorDetail(err))
+	}
+}
+
+// ImportState.
+func (r *BucketCopyPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "importing bucket copy policy")
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Error importing bucket copy policy", "invalid format: expected 'bucket_name:namespace'")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), parts[1])...)
+}
@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-objectscale/internal/clientgen"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// source and target buckets of the copy policy.
+var bucketCopyPolicyBucketsConfig = ProviderConfigForTesting + `
+data "objectscale_replication_group" "all" {
+	name = "rg1"
+}
+resource "objectscale_bucket" "source" {
+	name = "tfacc-copy-policy-source"
+	owner = "admin1"
+	namespace = "ns1"
+	replication_group = data.objectscale_replication_group.all.replication_groups.0.id
+}
+resource "objectscale_bucket" "target" {
+	name = "tfacc-copy-policy-target"
+	owner = "admin1"
+	namespace = "ns1"
+	replication_group = data.objectscale_replication_group.all.replication_groups.0.id
+}
+`
+
+// Test to Create, Update, Import and Delete bucket copy policy.
+func TestAccBucketCopyPolicyRs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+	defer testUserTokenCleanup(t)
+
+	var mockAPI *mockey.Mocker
+	unPatchFunc := func() {
+		if mockAPI != nil {
+			mockAPI.UnPatch()
+		}
+	}
+
+	copyPolicyConfig := func(daysAfterLastWrite int) string {
+		return bucketCopyPolicyBucketsConfig + fmt.Sprintf(`
+		resource "objectscale_bucket_copy_policy" "test" {
+			bucket = objectscale_bucket.source.name
+			namespace = "ns1"
+			target_bucket = objectscale_bucket.target.name
+			target_account = "ns1"
+			policy_type = "copy_only"
+			days_after_last_write = %d
+		}
+		`, daysAfterLastWrite)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create mock error
+				PreConfig: func() {
+					mockAPI = mockey.Mock((*clientgen.BucketApiService).BucketServicePostCopyPolicyExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				Config:      copyPolicyConfig(0),
+				ExpectError: regexp.MustCompile("Error creating bucket copy policy"),
+			},
+			{
+				// Create
+				PreConfig: unPatchFunc,
+				Config:    copyPolicyConfig(0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objectscale_bucket_copy_policy.test", "id", "tfacc-copy-policy-source:ns1"),
+					resource.TestCheckResourceAttr("objectscale_bucket_copy_policy.test", "target_bucket", "tfacc-copy-policy-target"),
+					resource.TestCheckResourceAttr("objectscale_bucket_copy_policy.test", "policy_type", "copy_only"),
+					resource.TestCheckResourceAttr("objectscale_bucket_copy_policy.test", "days_after_last_write", "0"),
+				),
+			},
+			{
+				// Import
+				ResourceName:      "objectscale_bucket_copy_policy.test",
+				ImportState:       true,
+				ImportStateId:     "tfacc-copy-policy-source:ns1",
+				ImportStateVerify: true,
+			},
+			{
+				// import invalid
+				ResourceName:  "objectscale_bucket_copy_policy.test",
+				ImportState:   true,
+				ImportStateId: "invalid-id",
+				ExpectError:   regexp.MustCompile("Error importing bucket copy policy"),
+			},
+			{
+				// mock refresh error
+				PreConfig: func() {
+					mockAPI = mockey.Mock((*clientgen.BucketApiService).BucketServiceGetCopyPolicyExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				RefreshState: true,
+				ExpectError:  regexp.MustCompile("Error reading bucket copy policy state"),
+			},
+			{
+				// Update mock error
+				PreConfig: func() {
+					unPatchFunc()
+					mockAPI = mockey.Mock((*clientgen.BucketApiService).BucketServicePutCopyPolicyExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				Config:      copyPolicyConfig(2),
+				ExpectError: regexp.MustCompile("Error updating bucket copy policy"),
+			},
+			{
+				// Update
+				PreConfig: unPatchFunc,
+				Config:    copyPolicyConfig(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objectscale_bucket_copy_policy.test", "days_after_last_write", "2"),
+				),
+			},
+			{
+				// Delete mock error
+				PreConfig: func() {
+					mockAPI = mockey.Mock((*clientgen.BucketApiService).BucketServiceDeleteCopyPolicyExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				Config:      bucketCopyPolicyBucketsConfig,
+				ExpectError: regexp.MustCompile("Error deleting bucket copy policy"),
+			},
+			{
+				PreConfig: unPatchFunc,
+				Config:    bucketCopyPolicyBucketsConfig,
+			},
+		},
+	})
+}
@@ -387,7 +387,7 @@ func (d *BucketDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Buckets",
-			fmt.Sprintf("An error was encountered reading buckets from ObjectScale IAM: %s", err.Error()),
+			fmt.Sprintf("An error was encountered reading buckets from ObjectScale IAM: %s", helper.APIErrorDetail(err)),
 		)
 		return
 	}
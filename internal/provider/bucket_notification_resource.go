@@ -0,0 +1,374 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"terraform-provider-objectscale/internal/clientgen"
+	"terraform-provider-objectscale/internal/helper"
+	"terraform-provider-objectscale/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BucketNotificationResource{}
+var _ resource.ResourceWithImportState = &BucketNotificationResource{}
+
+func NewBucketNotificationResource() resource.Resource {
+	return &BucketNotificationResource{}
+}
+
+// bucketNotificationEventRegex matches the S3 event names, ex. s3:ObjectCreated:*.
+var bucketNotificationEventRegex = regexp.MustCompile(`^s3:[A-Za-z]+:([A-Za-z]+|\*)$`)
+
+// BucketNotificationResource defines the resource implementation.
+type BucketNotificationResource struct {
+	resourceProviderConfig
+}
+
+func (r *BucketNotificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_notification"
+}
+
+func (r *BucketNotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This resource manages the S3 event notification configuration of a bucket on Dell ObjectScale. The resource owns the whole configuration of the bucket, which is cleared on destroy.",
+		MarkdownDescription: "This resource manages the S3 event notification configuration of a bucket on Dell ObjectScale. The resource owns the whole configuration of the bucket, which is cleared on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the notification configuration, in the format bucket_name:namespace.",
+				MarkdownDescription: "Identifier of the notification configuration, in the format `bucket_name:namespace`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"bucket": schema.StringAttribute{
+				Description:         "Name of the bucket. Cannot be updated.",
+				MarkdownDescription: "Name of the bucket. Cannot be updated.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"namespace": schema.StringAttribute{
+				Description:         "Namespace of the bucket. Cannot be updated.",
+				MarkdownDescription: "Namespace of the bucket. Cannot be updated.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"topic_configurations": schema.ListNestedAttribute{
+				Description:         "Notifications sent to a target when events occur on the objects of the bucket.",
+				MarkdownDescription: "Notifications sent to a target when events occur on the objects of the bucket.",
+				Required:            true,
+				Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "Identifier of the configuration. Generated by the array if not set, in which case it may change when the configurations are updated.",
+							MarkdownDescription: "Identifier of the configuration. Generated by the array if not set, in which case it may change when the configurations are updated.",
+							Optional:            true,
+							Computed:            true,
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"topic_arn": schema.StringAttribute{
+							Description:         "ARN of the target receiving the notifications, ex. the ARN of an object webhook target.",
+							MarkdownDescription: "ARN of the target receiving the notifications, ex. the ARN of an object webhook target.",
+							Required:            true,
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"events": schema.SetAttribute{
+							Description:         "Events triggering a notification, ex. s3:ObjectCreated:* or s3:ObjectRemoved:Delete.",
+							MarkdownDescription: "Events triggering a notification, ex. `s3:ObjectCreated:*` or `s3:ObjectRemoved:Delete`.",
+							Required:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.RegexMatches(bucketNotificationEventRegex, "must be an S3 event, ex. s3:ObjectCreated:*")),
+							},
+						},
+						"filter_prefix": schema.StringAttribute{
+							Description:         "Only the objects whose key starts with this prefix trigger a notification.",
+							MarkdownDescription: "Only the objects whose key starts with this prefix trigger a notification.",
+							Optional:            true,
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"filter_suffix": schema.StringAttribute{
+							Description:         "Only the objects whose key ends with this suffix trigger a notification.",
+							MarkdownDescription: "Only the objects whose key ends with this suffix trigger a notification.",
+							Optional:            true,
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+// helper function to marshal GET response to tfsdk model.
+func (r *BucketNotificationResource) respToModel(ctx context.Context, config *clientgen.BucketServiceGetBucketNotificationConfigResponse, bucket, namespace string) (models.BucketNotificationResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	state := models.BucketNotificationResourceModel{
+		ID:                  types.StringValue(bucket + ":" + namespace),
+		Bucket:              types.StringValue(bucket),
+		Namespace:           types.StringValue(namespace),
+		TopicConfigurations: []models.BucketNotificationTopicConfig{},
+	}
+	for _, topic := range config.TopicConfiguration {
+		events, d := types.SetValueFrom(ctx, types.StringType, topic.Event)
+		diags.Append(d...)
+		item := models.BucketNotificationTopicConfig{
+			ID:           helper.TfStringNN(topic.Id),
+			TopicArn:     helper.TfStringNN(topic.Topic),
+			Events:       events,
+			FilterPrefix: types.StringNull(),
+			FilterSuffix: types.StringNull(),
+		}
+		if topic.Filter != nil && topic.Filter.S3Key != nil {
+			for _, rule := range topic.Filter.S3Key.FilterRule {
+				if rule.Name == nil {
+					continue
+				}
+				// the array may return the rule names capitalized
+				switch {
+				case strings.EqualFold(*rule.Name, "prefix"):
+					item.FilterPrefix = helper.TfStringNN(rule.Value)
+				case strings.EqualFold(*rule.Name, "suffix"):
+					item.FilterSuffix = helper.TfStringNN(rule.Value)
+				}
+			}
+		}
+		state.TopicConfigurations = append(state.TopicConfigurations, item)
+	}
+	return state, diags
+}
+
+// helper function to build the PUT request from the tfsdk model.
+func (r *BucketNotificationResource) modelToReq(ctx context.Context, plan models.BucketNotificationResourceModel) (clientgen.BucketServicePutBucketNotificationConfigRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	req := clientgen.BucketServicePutBucketNotificationConfigRequest{}
+	for _, topic := range plan.TopicConfigurations {
+		var events []string
+		diags.Append(topic.Events.ElementsAs(ctx, &events, false)...)
+		var rules []clientgen.BucketNotificationFilterRule
+		if helper.IsKnown(topic.FilterPrefix) {
+			rules = append(rules, clientgen.BucketNotificationFilterRule{Name: clientgen.PtrString("prefix"), Value: topic.FilterPrefix.ValueStringPointer()})
+		}
+		if helper.IsKnown(topic.FilterSuffix) {
+			rules = append(rules, clientgen.BucketNotificationFilterRule{Name: clientgen.PtrString("suffix"), Value: topic.FilterSuffix.ValueStringPointer()})
+		}
+		item := clientgen.BucketNotificationTopicConfiguration{
+			Id:    helper.ValueToPointer[string](topic.ID),
+			Topic: topic.TopicArn.ValueStringPointer(),
+			Event: events,
+		}
+		if len(rules) > 0 {
+			item.Filter = &clientgen.BucketNotificationFilter{
+				S3Key: &clientgen.BucketNotificationFilterS3Key{FilterRule: rules},
+			}
+		}
+		req.TopicConfiguration = append(req.TopicConfiguration, item)
+	}
+	return req, diags
+}
+
+// helper function to read the notification configuration of a bucket.
+func (r *BucketNotificationResource) getNotificationConfig(ctx context.Context, bucket, namespace string) (*clientgen.BucketServiceGetBucketNotificationConfigResponse, error) {
+	config, _, err := r.client.GenClient.BucketApi.BucketServiceGetBucketNotificationConfig(ctx, bucket).Namespace(namespace).Execute()
+	return config, err
+}
+
+// helper function to replace the notification configuration of a bucket.
+func (r *BucketNotificationResource) putNotificationConfig(ctx context.Context, bucket, namespace string, body clientgen.BucketServicePutBucketNotificationConfigRequest) error {
+	_, _, err := r.client.GenClient.BucketApi.BucketServicePutBucketNotificationConfig(ctx, bucket).
+		Namespace(namespace).
+		BucketServicePutBucketNotificationConfigRequest(body).
+		Execute()
+	return err
+}
+
+// Create.
+func (r *BucketNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "creating bucket notification")
+	var plan models.BucketNotificationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := r.modelToReq(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, namespace := plan.Bucket.ValueString(), plan.Namespace.ValueString()
+	if err := r.putNotificationConfig(ctx, bucket, namespace, body); err != nil {
+		resp.Diagnostics.AddError("Error creating bucket notification", helper.APIErrorDetail(err))
+		return
+	}
+
+	config, err := r.getNotificationConfig(ctx, bucket, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading bucket notification state after create", helper.APIErrorDetail(err))
+		return
+	}
+
+	// Save data into Terraform state
+	state, diags := r.respToModel(ctx, config, bucket, namespace)
+	resp.Diagnostics.Append(diags...)
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read.
+func (r *BucketNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.BucketNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, namespace := state.Bucket.ValueString(), state.Namespace.ValueString()
+	config, err := r.getNotificationConfig(ctx, bucket, namespace)
+	if err != nil {
+		if helper.RemoveNotFound(ctx, err, &resp.State, "notification configuration of bucket "+bucket) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading bucket notification state", helper.APIErrorDetail(err))
+		return
+	}
+
+	if len(config.TopicConfiguration) == 0 {
+		// the configuration was cleared outside of Terraform
+		helper.RemoveMissing(ctx, &resp.State, "notification configuration of bucket "+bucket)
+		return
+	}
+
+	state2, diags := r.respToModel(ctx, config, bucket, namespace)
+	resp.Diagnostics.Append(diags...)
+	state2.Timeouts = state.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state2)...)
+}
+
+// Update.
+func (r *BucketNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "updating bucket notification")
+	var plan models.BucketNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := r.modelToReq(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, namespace := plan.Bucket.ValueString(), plan.Namespace.ValueString()
+	if err := r.putNotificationConfig(ctx, bucket, namespace, body); err != nil {
+		resp.Diagnostics.AddError("Error updating bucket notification", helper.APIErrorDetail(err))
+		return
+	}
+
+	// Read updated data
+	config, err := r.getNotificationConfig(ctx, bucket, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading bucket notification state after update", helper.APIErrorDetail(err))
+		return
+	}
+	state, diags := r.respToModel(ctx, config, bucket, namespace)
+	resp.Diagnostics.Append(diags...)
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete.
+func (r *BucketNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "deleting bucket notification")
+	var state models.BucketNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// an empty configuration disables all the notifications of the bucket
+	err := r.putNotificationConfig(ctx, state.Bucket.ValueString(), state.Namespace.ValueString(), clientgen.BucketServicePutBucketNotificationConfigRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting bucket notification", helper.APIErrorDetail(err))
+	}
+}
+
+// ImportState.
+func (r *BucketNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "importing bucket notification")
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Error importing bucket notification", "invalid format: expected 'bucket_name:namespace'")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), parts[1])...)
+}
@@ -0,0 +1,230 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-objectscale/internal/clientgen"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// bucket receiving the notification configuration.
+var bucketNotificationBucketConfig = ProviderConfigForTesting + `
+data "objectscale_replication_group" "all" {
+	name = "rg1"
+}
+resource "objectscale_bucket" "test" {
+	name = "tfacc-notification-bucket"
+	owner = "admin1"
+	namespace = "ns1"
+	replication_group = data.objectscale_replication_group.all.replication_groups.0.id
+}
+`
+
+// Test to Create, Update, Import and Delete bucket notification.
+func TestAccBucketNotificationRs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+	defer testUserTokenCleanup(t)
+
+	var mockAPI *mockey.Mocker
+	unPatchFunc := func() {
+		if mockAPI != nil {
+			mockAPI.UnPatch()
+		}
+	}
+
+	notificationConfig := func(events string) string {
+		return bucketNotificationBucketConfig + fmt.Sprintf(`
+		resource "objectscale_bucket_notification" "test" {
+			bucket = objectscale_bucket.test.name
+			namespace = "ns1"
+			topic_configurations = [
+				{
+					id = "tfacc-notification"
+					topic_arn = "arn:aws:sns:::tfacc-webhook"
+					events = [%s]
+					filter_prefix = "images/"
+					filter_suffix = ".jpg"
+				}
+			]
+		}
+		`, events)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create mock error
+				PreConfig: func() {
+					mockAPI = mockey.Mock((*clientgen.BucketApiService).BucketServicePutBucketNotificationConfigExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				Config:      notificationConfig(`"s3:ObjectCreated:*"`),
+				ExpectError: regexp.MustCompile("Error creating bucket notification"),
+			},
+			{
+				// Create
+				PreConfig: unPatchFunc,
+				Config:    notificationConfig(`"s3:ObjectCreated:*"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objectscale_bucket_notification.test", "id", "tfacc-notification-bucket:ns1"),
+					resource.TestCheckResourceAttr("objectscale_bucket_notification.test", "topic_configurations.#", "1"),
+					resource.TestCheckResourceAttr("objectscale_bucket_notification.test", "topic_configurations.0.id", "tfacc-notification"),
+					resource.TestCheckResourceAttr("objectscale_bucket_notification.test", "topic_configurations.0.events.#", "1"),
+					resource.TestCheckResourceAttr("objectscale_bucket_notification.test", "topic_configurations.0.filter_prefix", "images/"),
+					resource.TestCheckResourceAttr("objectscale_bucket_notification.test", "topic_configurations.0.filter_suffix", ".jpg"),
+				),
+			},
+			{
+				// Import
+				ResourceName:      "objectscale_bucket_notification.test",
+				ImportState:       true,
+				ImportStateId:     "tfacc-notification-bucket:ns1",
+				ImportStateVerify: true,
+			},
+			{
+				// import invalid
+				ResourceName:  "objectscale_bucket_notification.test",
+				ImportState:   true,
+				ImportStateId: "invalid-id",
+				ExpectError:   regexp.MustCompile("Error importing bucket notification"),
+			},
+			{
+				// mock refresh error
+				PreConfig: func() {
+					mockAPI = mockey.Mock((*clientgen.BucketApiService).BucketServiceGetBucketNotificationConfigExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				RefreshState: true,
+				ExpectError:  regexp.MustCompile("Error reading bucket notification state"),
+			},
+			{
+				// Update mock error
+				PreConfig: func() {
+					unPatchFunc()
+					mockAPI = mockey.Mock((*clientgen.BucketApiService).BucketServicePutBucketNotificationConfigExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				Config:      notificationConfig(`"s3:ObjectCreated:*", "s3:ObjectRemoved:*"`),
+				ExpectError: regexp.MustCompile("Error updating bucket notification"),
+			},
+			{
+				// Update
+				PreConfig: unPatchFunc,
+				Config:    notificationConfig(`"s3:ObjectCreated:*", "s3:ObjectRemoved:*"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objectscale_bucket_notification.test", "topic_configurations.0.events.#", "2"),
+				),
+			},
+			{
+				// Drift, the configuration is cleared outside of Terraform
+				PreConfig: func() {
+					mockAPI = mockey.Mock((*clientgen.BucketApiService).BucketServiceGetBucketNotificationConfigExecute).Return(
+						&clientgen.BucketServiceGetBucketNotificationConfigResponse{}, nil, nil,
+					).Build()
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Delete mock error
+				PreConfig: func() {
+					unPatchFunc()
+					mockAPI = mockey.Mock((*clientgen.BucketApiService).BucketServicePutBucketNotificationConfigExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				Config:      bucketNotificationBucketConfig,
+				ExpectError: regexp.MustCompile("Error deleting bucket notification"),
+			},
+			{
+				PreConfig: unPatchFunc,
+				Config:    bucketNotificationBucketConfig,
+			},
+		},
+	})
+}
+
+func TestBucketNotificationModelRoundTrip(t *testing.T) {
+	r := &BucketNotificationResource{}
+	ctx := context.Background()
+	config := &clientgen.BucketServiceGetBucketNotificationConfigResponse{
+		TopicConfiguration: []clientgen.BucketNotificationTopicConfiguration{
+			{
+				Id:    clientgen.PtrString("images"),
+				Topic: clientgen.PtrString("arn:aws:sns:::webhook1"),
+				Event: []string{"s3:ObjectCreated:*", "s3:ObjectRemoved:Delete"},
+				Filter: &clientgen.BucketNotificationFilter{
+					S3Key: &clientgen.BucketNotificationFilterS3Key{
+						FilterRule: []clientgen.BucketNotificationFilterRule{
+							{Name: clientgen.PtrString("Prefix"), Value: clientgen.PtrString("images/")},
+						},
+					},
+				},
+			},
+			{
+				Id:    clientgen.PtrString("all"),
+				Topic: clientgen.PtrString("arn:aws:sns:::webhook2"),
+				Event: []string{"s3:ObjectRemoved:*"},
+			},
+		},
+	}
+
+	state, diags := r.respToModel(ctx, config, "bucket1", "ns1")
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if state.ID.ValueString() != "bucket1:ns1" || len(state.TopicConfigurations) != 2 {
+		t.Fatalf("unexpected state %+v", state)
+	}
+	first := state.TopicConfigurations[0]
+	if first.FilterPrefix.ValueString() != "images/" || !first.FilterSuffix.IsNull() || len(first.Events.Elements()) != 2 {
+		t.Errorf("unexpected topic configuration %+v", first)
+	}
+	if second := state.TopicConfigurations[1]; !second.FilterPrefix.IsNull() || !second.FilterSuffix.IsNull() {
+		t.Errorf("expected no filter, got %+v", second)
+	}
+
+	req, diags := r.modelToReq(ctx, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(req.TopicConfiguration) != 2 {
+		t.Fatalf("unexpected request %+v", req)
+	}
+	rules := req.TopicConfiguration[0].Filter.S3Key.FilterRule
+	if len(rules) != 1 || *rules[0].Name != "prefix" || *rules[0].Value != "images/" {
+		t.Errorf("unexpected filter rules %+v", rules)
+	}
+	if req.TopicConfiguration[1].Filter != nil {
+		t.Errorf("expected no filter, got %+v", req.TopicConfiguration[1].Filter)
+	}
+}
@@ -21,13 +21,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
+	"terraform-provider-objectscale/internal/client"
 	"terraform-provider-objectscale/internal/clientgen"
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
+	"terraform-provider-objectscale/internal/policytypes"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 
@@ -36,6 +41,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -396,6 +402,13 @@ func (r *BucketResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 				Computed:            true,
 			},
+			"force_destroy": schema.BoolAttribute{
+				Description:         "Whether all the objects of the bucket are deleted when the bucket is destroyed. When false, destroying a bucket that is not empty fails. When true, the array empties the bucket in the background and the provider waits until the bucket is deleted.",
+				MarkdownDescription: "Whether all the objects of the bucket are deleted when the bucket is destroyed. When `false`, destroying a bucket that is not empty fails. When `true`, the array empties the bucket in the background and the provider waits until the bucket is deleted.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"is_empty_bucket_in_progress": schema.BoolAttribute{
 				Description:         "Indicates if empty bucket operation is in progress.",
 				MarkdownDescription: "Indicates if empty bucket operation is in progress.",
@@ -457,6 +470,7 @@ func (r *BucketResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "Bucket policy in JSON format.",
 				Optional:            true,
 				Computed:            true,
+				CustomType:          policytypes.DocumentType{},
 			},
 			"user_acl": schema.SetNestedAttribute{
 				Description:         "List of user ACLs for the bucket.",
@@ -530,6 +544,15 @@ func (r *BucketResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				Read:              true,
+				Update:            true,
+				Delete:            true,
+				DeleteDescription: "Maximum time allowed for the delete, which waits for the array to empty the bucket, as a duration like 30s, 10m or 2h. Defaults to 1h.",
+			}),
+		},
 	}
 }
 
@@ -635,12 +658,18 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	// Build the request from the plan
 	reqBody := r.modelToJson(plan)
 
 	_, _, err := r.client.GenClient.BucketApi.BucketServiceCreateBucket(ctx).BucketServiceCreateBucketRequest(reqBody).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating bucket", err.Error())
+		resp.Diagnostics.AddError("Error creating bucket", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -765,12 +794,12 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 			).
 			Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error setting bucket ACL", err.Error())
+			resp.Diagnostics.AddError("Error setting bucket ACL", helper.APIErrorDetail(err))
 			_, _, err := r.client.GenClient.BucketApi.BucketServiceDeactivateBucket(ctx, plan.Name.ValueString()).Namespace(plan.Namespace.ValueString()).EmptyBucket("false").Execute()
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Error deleting Bucket",
-					err.Error(),
+					helper.APIErrorDetail(err),
 				)
 			}
 			return
@@ -782,12 +811,12 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		var policyMap map[string]interface{}
 		err := json.Unmarshal([]byte(plan.BucketPolicy.ValueString()), &policyMap)
 		if err != nil {
-			resp.Diagnostics.AddError("Error parsing bucket policy JSON", err.Error())
+			resp.Diagnostics.AddError("Error parsing bucket policy JSON", helper.APIErrorDetail(err))
 			_, _, err := r.client.GenClient.BucketApi.BucketServiceDeactivateBucket(ctx, plan.Name.ValueString()).Namespace(plan.Namespace.ValueString()).EmptyBucket("false").Execute()
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Error deleting Bucket",
-					err.Error(),
+					helper.APIErrorDetail(err),
 				)
 			}
 			return
@@ -798,12 +827,12 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 			Body(policyMap).
 			Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error setting bucket policy", err.Error())
+			resp.Diagnostics.AddError("Error setting bucket policy", helper.APIErrorDetail(err))
 			_, _, err := r.client.GenClient.BucketApi.BucketServiceDeactivateBucket(ctx, plan.Name.ValueString()).Namespace(plan.Namespace.ValueString()).EmptyBucket("false").Execute()
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Error deleting Bucket",
-					err.Error(),
+					helper.APIErrorDetail(err),
 				)
 			}
 			return
@@ -823,8 +852,14 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if data == nil {
+		resp.Diagnostics.AddError("Error Reading Buckets", "bucket "+plan.Name.ValueString()+" not found in namespace "+plan.Namespace.ValueString())
+		return
+	}
+	data.ForceDestroy = plan.ForceDestroy
 
 	// Save data into Terraform state
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -838,6 +873,12 @@ func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use setStateFromAPI to populate state from API after creation
 	aclFromPlan := len(state.UserAcl.Elements()) > 0 || len(state.GroupAcl.Elements()) > 0 || len(state.CustomGroupAcl.Elements()) > 0
 	data, diags := r.setStateFromAPI(
@@ -851,8 +892,14 @@ func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if data == nil {
+		helper.RemoveMissing(ctx, &resp.State, "bucket "+state.Name.ValueString())
+		return
+	}
+	data.ForceDestroy = state.ForceDestroy
 
 	// Save updated plan into Terraform state
+	data.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -872,6 +919,12 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	bucketName := state.Name.ValueString()
 	namespace := state.Namespace.ValueString()
 
@@ -906,7 +959,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 		_, _, err := r.client.GenClient.BucketApi.BucketServiceSetEventualReadsForBucket(ctx, bucketName).Namespace(namespace).Enabled(enabledStr).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating LocalObjectMetadataReads", err.Error())
+			resp.Diagnostics.AddError("Error updating LocalObjectMetadataReads", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -925,7 +978,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 			).
 			Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating bucket owner", err.Error())
+			resp.Diagnostics.AddError("Error updating bucket owner", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -946,7 +999,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 			).
 			Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating IsStaleAllowed", err.Error())
+			resp.Diagnostics.AddError("Error updating IsStaleAllowed", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -964,7 +1017,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 			).
 			Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating AutoCommitPeriod", err.Error())
+			resp.Diagnostics.AddError("Error updating AutoCommitPeriod", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -982,7 +1035,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 			).
 			Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating Retention", err.Error())
+			resp.Diagnostics.AddError("Error updating Retention", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -997,7 +1050,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 				},
 			).Namespace(namespace).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating VersioningStatus", err.Error())
+			resp.Diagnostics.AddError("Error updating VersioningStatus", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -1045,7 +1098,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 			).
 			Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating DefaultGroup or related permissions", err.Error())
+			resp.Diagnostics.AddError("Error updating DefaultGroup or related permissions", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -1097,7 +1150,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 				).
 				Execute()
 			if err != nil {
-				resp.Diagnostics.AddError("Error deleting tag", fmt.Sprintf("Tag: %s, Error: %s", key, err.Error()))
+				resp.Diagnostics.AddError("Error deleting tag", fmt.Sprintf("Tag: %s, Error: %s", key, helper.APIErrorDetail(err)))
 				return
 			}
 		}
@@ -1124,7 +1177,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 					).
 					Execute()
 				if err != nil {
-					resp.Diagnostics.AddError("Error updating tag", fmt.Sprintf("Tag: %s, Error: %s", key, err.Error()))
+					resp.Diagnostics.AddError("Error updating tag", fmt.Sprintf("Tag: %s, Error: %s", key, helper.APIErrorDetail(err)))
 					return
 				}
 			}
@@ -1146,7 +1199,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 				).
 				Execute()
 			if err != nil {
-				resp.Diagnostics.AddError("Error creating tag", fmt.Sprintf("Tag: %s, Error: %s", key, err.Error()))
+				resp.Diagnostics.AddError("Error creating tag", fmt.Sprintf("Tag: %s, Error: %s", key, helper.APIErrorDetail(err)))
 				return
 			}
 		}
@@ -1168,7 +1221,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 			).
 			Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating BlockSize or NotificationSize", fmt.Sprintf("BlockSize: %d, NotificationSize: %d, Error: %s", blockSize, notificationSize, err.Error()))
+			resp.Diagnostics.AddError("Error updating BlockSize or NotificationSize", fmt.Sprintf("BlockSize: %d, NotificationSize: %d, Error: %s", blockSize, notificationSize, helper.APIErrorDetail(err)))
 			return
 		}
 	}
@@ -1220,7 +1273,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 			Namespace(namespace).
 			Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating IsObjectLockEnabled", err.Error())
+			resp.Diagnostics.AddError("Error updating IsObjectLockEnabled", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -1233,7 +1286,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 				Namespace(namespace).
 				Execute()
 			if err != nil {
-				resp.Diagnostics.AddError("Error updating IsObjectLockWithAdoAllowed", err.Error())
+				resp.Diagnostics.AddError("Error updating IsObjectLockWithAdoAllowed", helper.APIErrorDetail(err))
 				return
 			}
 		}
@@ -1253,7 +1306,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 				Execute()
 		}
 		if err != nil {
-			resp.Diagnostics.AddError("Error Updating AdvancedMetadataSearch Status", err.Error())
+			resp.Diagnostics.AddError("Error Updating AdvancedMetadataSearch Status", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -1275,7 +1328,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 			).
 			Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating advanced metadata search target settings", err.Error())
+			resp.Diagnostics.AddError("Error updating advanced metadata search target settings", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -1287,7 +1340,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 			BucketServiceSetBucketAuditDeleteExpiration(ctx, bucketName).Namespace(namespace).Expiration(fmt.Sprintf("%d", auditDeleteExpiration)).
 			Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating AuditDeleteExpiration", err.Error())
+			resp.Diagnostics.AddError("Error updating AuditDeleteExpiration", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -1297,7 +1350,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 		var policyMap map[string]interface{}
 		err := json.Unmarshal([]byte(plan.BucketPolicy.ValueString()), &policyMap)
 		if err != nil {
-			resp.Diagnostics.AddError("Error parsing bucket policy JSON", err.Error())
+			resp.Diagnostics.AddError("Error parsing bucket policy JSON", helper.APIErrorDetail(err))
 			return
 		}
 		_, _, err = r.client.GenClient.BucketApi.
@@ -1306,7 +1359,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 			Body(policyMap).
 			Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error setting bucket policy", err.Error())
+			resp.Diagnostics.AddError("Error setting bucket policy", helper.APIErrorDetail(err))
 			return
 		}
 	} else {
@@ -1315,7 +1368,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 			Namespace(plan.Namespace.ValueString()).
 			Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error deleting bucket policy", err.Error())
+			resp.Diagnostics.AddError("Error deleting bucket policy", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -1336,7 +1389,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 			).
 			Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating DefaultRetention", err.Error())
+			resp.Diagnostics.AddError("Error updating DefaultRetention", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -1466,7 +1519,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 			).
 			Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating bucket ACL", err.Error())
+			resp.Diagnostics.AddError("Error updating bucket ACL", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -1486,7 +1539,13 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if data == nil {
+		resp.Diagnostics.AddError("Error Reading Buckets", "bucket "+plan.Name.ValueString()+" not found in namespace "+plan.Namespace.ValueString())
+		return
+	}
+	data.ForceDestroy = plan.ForceDestroy
 
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 }
@@ -1502,16 +1561,92 @@ func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	_, _, err := r.client.GenClient.BucketApi.BucketServiceDeactivateBucket(ctx, state.Name.ValueString()).Namespace(state.Namespace.ValueString()).EmptyBucket("true").Execute()
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, bucketDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	if !state.ForceDestroy.ValueBool() {
+		// the array refuses to delete a bucket which is not empty
+		_, _, err := r.client.GenClient.BucketApi.BucketServiceDeactivateBucket(ctx, state.Name.ValueString()).Namespace(state.Namespace.ValueString()).EmptyBucket("false").Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting Bucket",
+				fmt.Sprintf("%s\nIf the bucket is not empty, set force_destroy to true to delete the bucket with all its objects.", helper.APIErrorDetail(err)),
+			)
+		}
+		return
+	}
+
+	_, _, err := r.client.GenClient.BucketApi.BucketServiceDeactivateBucket(ctx, state.Name.ValueString()).Namespace(state.Namespace.ValueString()).EmptyBucket("true").Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting Bucket",
-			err.Error(),
+			helper.APIErrorDetail(err),
+		)
+		return
+	}
+
+	if err := waitForBucketEmptied(ctx, r.client, state.Name.ValueString(), state.Namespace.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Bucket",
+			helper.APIErrorDetail(err),
 		)
 	}
 }
 
+const (
+	// bucketDeleteTimeout is the default timeout of the delete of a bucket, which waits for the array to empty it.
+	bucketDeleteTimeout = 60 * time.Minute
+	// bucketEmptyPollInterval is the interval between two polls of the empty bucket status.
+	bucketEmptyPollInterval = 10 * time.Second
+)
+
+// waitForBucketEmptied polls the empty bucket status until the background task deleting
+// the objects of the bucket is done and the bucket is deleted, or the context is done.
+func waitForBucketEmptied(ctx context.Context, c *client.Client, name, namespace string) error {
+	for {
+		status, httpResp, err := c.GenClient.BucketApi.BucketServiceGetEmptyBucketStatus(ctx, name).Namespace(namespace).Execute()
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			// the bucket is deleted, or it was already empty and no task was started
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("timed out waiting for bucket %s to be emptied: %w", name, ctx.Err())
+			}
+			return fmt.Errorf("could not get empty bucket status of bucket %s: %w", name, err)
+		}
+
+		taskStatus := helper.TfStringNN(status.Status).ValueString()
+		entriesDeleted := helper.TfInt64NN(status.EntriesDeleted).ValueInt64()
+		switch taskStatus {
+		case "DONE":
+			return nil
+		case "FAILED", "ABORTED":
+			return fmt.Errorf("empty bucket task of bucket %s ended with status %s: %d objects deleted, %d objects under retention and %d other objects could not be deleted. %s",
+				name, taskStatus, entriesDeleted,
+				helper.TfInt64NN(status.FailedToDeleteDueToRetention).ValueInt64(),
+				helper.TfInt64NN(status.FailedToDeleteDueToOther).ValueInt64(),
+				helper.TfStringNN(status.Message).ValueString())
+		}
+
+		tflog.Info(ctx, "waiting for bucket to be emptied", map[string]interface{}{
+			"bucket":              name,
+			"status":              taskStatus,
+			"entries_deleted":     entriesDeleted,
+			"approx_object_count": helper.TfInt64NN(status.ApproxObjectCount).ValueInt64(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for bucket %s to be emptied, %d objects deleted so far: %w", name, entriesDeleted, ctx.Err())
+		case <-time.After(bucketEmptyPollInterval):
+		}
+	}
+}
+
 func (r *BucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Info(ctx, "importing Bucket")
 	parts := strings.SplitN(req.ID, ":", 2)
@@ -1533,7 +1668,13 @@ func (r *BucketResource) ImportState(ctx context.Context, req resource.ImportSta
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if data == nil {
+		resp.Diagnostics.AddError("Error importing Bucket", "bucket "+bucket_name+" not found in namespace "+namespace)
+		return
+	}
+	data.ForceDestroy = types.BoolValue(false)
 
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
 	// Save updated plan into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -1681,14 +1822,19 @@ func getBucketToModel(b clientgen.BucketServiceGetBucketInfoResponse) models.Buc
 	return m
 }
 
+// setStateFromAPI reads the bucket from the API.
+// It returns a nil model without diagnostics when the bucket does not exist.
 func (r *BucketResource) setStateFromAPI(ctx context.Context, name, namespace, bucketPolicy string, aclFromPlan bool) (*models.BucketResourceModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	bucketData, _, err := r.client.GenClient.BucketApi.BucketServiceGetBucketInfo(ctx, name).Namespace(namespace).Execute()
+	if client.IsNotFound(err) {
+		return nil, diags
+	}
 	if err != nil {
 		diags.AddError(
 			"Error Reading Buckets",
-			fmt.Sprintf("An error was encountered reading buckets from ObjectScale IAM: %s", err.Error()),
+			fmt.Sprintf("An error was encountered reading buckets from ObjectScale IAM: %s", helper.APIErrorDetail(err)),
 		)
 		return nil, diags
 	}
@@ -1702,7 +1848,7 @@ func (r *BucketResource) setStateFromAPI(ctx context.Context, name, namespace, b
 		if err != nil {
 			diags.AddError(
 				"Error Reading Bucket Policy",
-				fmt.Sprintf("An error was encountered reading bucket policy from ObjectScale IAM: %s", err.Error()),
+				fmt.Sprintf("An error was encountered reading bucket policy from ObjectScale IAM: %s", helper.APIErrorDetail(err)),
 			)
 			return nil, diags
 		}
@@ -1720,7 +1866,7 @@ func (r *BucketResource) setStateFromAPI(ctx context.Context, name, namespace, b
 		if err != nil {
 			diags.AddError(
 				"Error Reading Bucket ACL",
-				fmt.Sprintf("An error was encountered reading bucket ACL from ObjectScale IAM: %s", err.Error()),
+				fmt.Sprintf("An error was encountered reading bucket ACL from ObjectScale IAM: %s", helper.APIErrorDetail(err)),
 			)
 			return nil, diags
 		}
@@ -1755,9 +1901,9 @@ func (r *BucketResource) setStateFromAPI(ctx context.Context, name, namespace, b
 	data := getBucketToModel(*bucketData)
 	// Set BucketPolicy
 	if bucketPolicy != "" {
-		data.BucketPolicy = types.StringValue(bucketPolicy)
+		data.BucketPolicy = policytypes.NewDocumentValue(policytypes.Decode(bucketPolicy))
 	} else {
-		data.BucketPolicy = types.StringNull()
+		data.BucketPolicy = policytypes.NewDocumentNull()
 	}
 
 	// Set ACLs: if not set in plan, keep as in plan (to avoid diff drift)
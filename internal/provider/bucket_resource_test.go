@@ -18,10 +18,17 @@ limitations under the License.
 package provider
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"os"
 	"regexp"
+	"terraform-provider-objectscale/internal/client"
+	"terraform-provider-objectscale/internal/clientgen"
 	"testing"
+	"time"
 
+	"github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -209,6 +216,20 @@ func TestAccBucketResourceNegative(t *testing.T) {
 				`,
 				ExpectError: regexp.MustCompile(`Invalid System Metadata Name`),
 			},
+			// Invalid bucket policy, rejected at plan time before the bucket is created
+			{
+				Config: ProviderConfigForBucketTesting + `
+				resource "objectscale_bucket" "test" {
+					name = "example-bucket-2"
+					owner = "admin1"
+					namespace = "ns1"
+					replication_group = data.objectscale_replication_group.all.replication_groups.0.id
+					bucket_policy = "{\"Statement\": ["
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Policy Document`),
+			},
 		},
 	})
 }
@@ -1629,3 +1650,64 @@ func TestAccBucketResourceImport(t *testing.T) {
 		},
 	})
 }
+
+func TestBucketWaitForBucketEmptied(t *testing.T) {
+	c := &client.Client{
+		GenClient: &clientgen.APIClient{
+			BucketApi: &clientgen.BucketApiService{},
+		},
+	}
+	tests := []struct {
+		name    string
+		status  *clientgen.BucketServiceGetEmptyBucketStatusResponse
+		resp    *http.Response
+		err     error
+		wantErr string
+	}{
+		{
+			name: "bucket deleted",
+			resp: &http.Response{StatusCode: http.StatusNotFound},
+			err:  fmt.Errorf("404 Not Found"),
+		},
+		{
+			name:   "task done",
+			status: &clientgen.BucketServiceGetEmptyBucketStatusResponse{Status: getpointer("DONE")},
+		},
+		{
+			name: "task failed",
+			status: &clientgen.BucketServiceGetEmptyBucketStatusResponse{
+				Status:                       getpointer("FAILED"),
+				EntriesDeleted:               getpointer[int64](10),
+				FailedToDeleteDueToRetention: getpointer[int64](2),
+			},
+			wantErr: "ended with status FAILED: 10 objects deleted, 2 objects under retention",
+		},
+		{
+			name:    "status error",
+			err:     fmt.Errorf("mock error"),
+			wantErr: "could not get empty bucket status of bucket bucket1: mock error",
+		},
+		{
+			name:    "timeout",
+			status:  &clientgen.BucketServiceGetEmptyBucketStatusResponse{Status: getpointer("IN_PROGRESS")},
+			wantErr: "timed out waiting for bucket bucket1 to be emptied",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mockey.Mock((*clientgen.BucketApiService).BucketServiceGetEmptyBucketStatusExecute).
+				Return(tt.status, tt.resp, tt.err).Build()
+			defer m.UnPatch()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			err := waitForBucketEmptied(ctx, c, "bucket1", "ns1")
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !regexp.MustCompile(regexp.QuoteMeta(tt.wantErr)).MatchString(err.Error())) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-objectscale/internal/client"
+	"terraform-provider-objectscale/internal/clientgen"
+	"terraform-provider-objectscale/internal/testserver"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// newEphemeralServer returns a provider server configured against a simulated array, and a client of the same array.
+func newEphemeralServer(t *testing.T) (tfprotov6.ProviderServer, *client.Client) {
+	t.Helper()
+	s := testserver.New(map[string]string{"root": "password"})
+	t.Cleanup(s.Close)
+	c, err := client.NewClient(s.URL, "root", "password", true, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := context.Background()
+	schema, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	config := dynamicValue(t, schema.Provider.ValueType(), map[string]tftypes.Value{
+		"endpoint": tftypes.NewValue(tftypes.String, s.URL),
+		"username": tftypes.NewValue(tftypes.String, "root"),
+		"password": tftypes.NewValue(tftypes.String, "password"),
+		"insecure": tftypes.NewValue(tftypes.Bool, true),
+	})
+	resp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: config})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	return server, c
+}
+
+// dynamicValue returns an object of the given type with the given attributes, the others being null.
+func dynamicValue(t *testing.T, typ tftypes.Type, values map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+	object := typ.(tftypes.Object)
+	attributes := map[string]tftypes.Value{}
+	for name, attrType := range object.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attrType, nil)
+		if v, ok := values[name]; ok {
+			attributes[name] = v
+		}
+	}
+	dv, err := tfprotov6.NewDynamicValue(object, tftypes.NewValue(object, attributes))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return &dv
+}
+
+// openEphemeral opens the ephemeral resource with the given configuration, and returns its result and private data.
+func openEphemeral(t *testing.T, server tfprotov6.ProviderServer, typeName string, values map[string]tftypes.Value) (map[string]tftypes.Value, []byte) {
+	t.Helper()
+	ctx := context.Background()
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	typ := schemas.EphemeralResourceSchemas[typeName].ValueType()
+	resp, err := server.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: typeName,
+		Config:   dynamicValue(t, typ, values),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	result, err := resp.Result.Unmarshal(typ)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var attributes map[string]tftypes.Value
+	if err := result.As(&attributes); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return attributes, resp.Private
+}
+
+// closeEphemeral closes the ephemeral resource with the private data returned by its opening.
+func closeEphemeral(t *testing.T, server tfprotov6.ProviderServer, typeName string, private []byte) {
+	t.Helper()
+	resp, err := server.CloseEphemeralResource(context.Background(), &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: typeName,
+		Private:  private,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+}
+
+// stringAttribute returns the value of a string attribute of an ephemeral result.
+func stringAttribute(t *testing.T, attributes map[string]tftypes.Value, name string) string {
+	t.Helper()
+	var s string
+	if err := attributes[name].As(&s); err != nil {
+		t.Fatalf("unexpected error reading %s: %v", name, err)
+	}
+	return s
+}
+
+func TestIAMAccessKeyEphemeralResource(t *testing.T) {
+	server, c := newEphemeralServer(t)
+	ctx := context.Background()
+	listKeys := func() []string {
+		resp, _, err := c.GenClient.IamApi.IamServiceListAccessKeys(ctx).UserName("user_001").XEmcNamespace("ns1").Execute()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var ids []string
+		for _, k := range resp.ListAccessKeysResult.AccessKeyMetadata {
+			ids = append(ids, *k.AccessKeyId)
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name   string
+		revoke tftypes.Value
+		kept   bool
+	}{
+		{"revoked by default", tftypes.NewValue(tftypes.Bool, nil), false},
+		{"revoked", tftypes.NewValue(tftypes.Bool, true), false},
+		{"kept", tftypes.NewValue(tftypes.Bool, false), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, private := openEphemeral(t, server, "objectscale_iam_access_key", map[string]tftypes.Value{
+				"username":        tftypes.NewValue(tftypes.String, "user_001"),
+				"namespace":       tftypes.NewValue(tftypes.String, "ns1"),
+				"revoke_on_close": tt.revoke,
+			})
+			id := stringAttribute(t, result, "id")
+			assert.NotEmpty(t, stringAttribute(t, result, "secret_access_key"))
+			assert.Equal(t, "Active", stringAttribute(t, result, "status"))
+			assert.Contains(t, listKeys(), id)
+
+			closeEphemeral(t, server, "objectscale_iam_access_key", private)
+			if tt.kept {
+				assert.Contains(t, listKeys(), id)
+				_, _, err := c.GenClient.IamApi.IamServiceDeleteAccessKey(ctx).AccessKeyId(id).UserName("user_001").XEmcNamespace("ns1").Execute()
+				assert.NoError(t, err)
+			} else {
+				assert.NotContains(t, listKeys(), id)
+			}
+		})
+	}
+}
+
+func TestObjectUserSecretKeyEphemeralResource(t *testing.T) {
+	server, c := newEphemeralServer(t)
+	ctx := context.Background()
+	_, _, err := c.GenClient.UserManagementApi.UserManagementServiceAddUser(ctx).
+		UserManagementServiceAddUserRequest(clientgen.UserManagementServiceAddUserRequest{User: "object_user", Namespace: "ns1"}).
+		Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	listKeys := func() []string {
+		resp, _, err := c.GenClient.UserSecretKeyApi.UserSecretKeyServiceGetKeysForUser(ctx, "object_user").Execute()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var ids []string
+		for _, id := range []*string{resp.SecretKey1Id, resp.SecretKey2Id} {
+			if id != nil && *id != "" {
+				ids = append(ids, *id)
+			}
+		}
+		return ids
+	}
+
+	// the key is revoked on close by default, which frees the slot for the next run
+	for range 3 {
+		result, private := openEphemeral(t, server, "objectscale_object_user_secret_key", map[string]tftypes.Value{
+			"username":  tftypes.NewValue(tftypes.String, "object_user"),
+			"namespace": tftypes.NewValue(tftypes.String, "ns1"),
+		})
+		id := stringAttribute(t, result, "id")
+		assert.NotEmpty(t, stringAttribute(t, result, "secret_key"))
+		assert.Equal(t, []string{id}, listKeys())
+
+		closeEphemeral(t, server, "objectscale_object_user_secret_key", private)
+		assert.Empty(t, listKeys())
+	}
+
+	result, private := openEphemeral(t, server, "objectscale_object_user_secret_key", map[string]tftypes.Value{
+		"username":        tftypes.NewValue(tftypes.String, "object_user"),
+		"namespace":       tftypes.NewValue(tftypes.String, "ns1"),
+		"revoke_on_close": tftypes.NewValue(tftypes.Bool, false),
+	})
+	closeEphemeral(t, server, "objectscale_object_user_secret_key", private)
+	assert.Equal(t, []string{stringAttribute(t, result, "id")}, listKeys())
+}
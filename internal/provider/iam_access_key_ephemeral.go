@@ -0,0 +1,177 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"terraform-provider-objectscale/internal/client"
+	"terraform-provider-objectscale/internal/helper"
+	"terraform-provider-objectscale/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &IAMAccessKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &IAMAccessKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &IAMAccessKeyEphemeralResource{}
+
+// accessKeyPrivateKey is the private data key under which the access key to revoke on close is kept.
+const accessKeyPrivateKey = "access_key"
+
+func NewIAMAccessKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &IAMAccessKeyEphemeralResource{}
+}
+
+// IAMAccessKeyEphemeralResource defines the ephemeral resource implementation.
+type IAMAccessKeyEphemeralResource struct {
+	ephemeralProviderConfig
+}
+
+// iamAccessKeyPrivate identifies the access key to revoke on close.
+type iamAccessKeyPrivate struct {
+	UserName  string `json:"username"`
+	Namespace string `json:"namespace"`
+	Id        string `json:"id"`
+}
+
+func (e *IAMAccessKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_access_key"
+}
+
+func (e *IAMAccessKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This ephemeral resource creates a new S3 compatible access key for a Dell ObjectScale IAM user for the duration of a Terraform run, without storing it in the state or plan. " +
+			"A new key is created each time Terraform opens the ephemeral resource, and revoked when Terraform closes it, unless `revoke_on_close` is `false`.",
+		Description: "This ephemeral resource creates a new S3 compatible access key for a Dell ObjectScale IAM user for the duration of a Terraform run, without storing it in the state or plan. " +
+			"A new key is created each time Terraform opens the ephemeral resource, and revoked when Terraform closes it, unless revoke_on_close is false.",
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Description:         "Name of the user to which the key is attached. Required.",
+				MarkdownDescription: "Name of the user to which the key is attached. Required.",
+				Required:            true,
+			},
+			"namespace": schema.StringAttribute{
+				Description:         "Namespace to which the user belongs to.",
+				MarkdownDescription: "Namespace to which the user belongs to.",
+				Required:            true,
+			},
+			"revoke_on_close": schema.BoolAttribute{
+				Description: "Whether the access key is deleted when Terraform closes the ephemeral resource, at the end of each plan and apply. " +
+					"Set it to false when the key is written to an external store, e.g. a vault, that outlives the run. Defaults to true.",
+				MarkdownDescription: "Whether the access key is deleted when Terraform closes the ephemeral resource, at the end of each plan and apply. " +
+					"Set it to `false` when the key is written to an external store, e.g. a vault, that outlives the run. Defaults to `true`.",
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the access key that is generated by ObjectScale.",
+				MarkdownDescription: "Identifier of the access key that is generated by ObjectScale.",
+				Computed:            true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Description:         "Secret access key associated with the user.",
+				MarkdownDescription: "Secret access key associated with the user.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"status": schema.StringAttribute{
+				Description:         "Status of the access key attached to the user.",
+				MarkdownDescription: "Status of the access key attached to the user.",
+				Computed:            true,
+			},
+			"create_date": schema.StringAttribute{
+				Description:         "Creation date of the access key.",
+				MarkdownDescription: "Creation date of the access key.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *IAMAccessKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	tflog.Info(ctx, "opening ephemeral access key")
+	var data models.IAMAccessKeyEphemeralModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, _, err := e.client.GenClient.IamApi.IamServiceCreateAccessKey(ctx).
+		UserName(data.UserName.ValueString()).
+		XEmcNamespace(data.Namespace.ValueString()).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating access key for the user", helper.APIErrorDetail(err))
+		return
+	}
+	if created.CreateAccessKeyResult == nil || created.CreateAccessKeyResult.AccessKey == nil {
+		resp.Diagnostics.AddError("Error creating access key for the user", "The response of ObjectScale contains no access key.")
+		return
+	}
+
+	key := created.CreateAccessKeyResult.AccessKey
+	data.Id = helper.TfString(key.AccessKeyId)
+	data.SecretAccessKey = helper.TfString(key.SecretAccessKey)
+	data.Status = helper.TfString(key.Status)
+	data.CreateDate = helper.TfString(key.CreateDate)
+
+	if data.RevokeOnClose.IsNull() || data.RevokeOnClose.ValueBool() {
+		private, err := json.Marshal(iamAccessKeyPrivate{
+			UserName:  data.UserName.ValueString(),
+			Namespace: data.Namespace.ValueString(),
+			Id:        data.Id.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error saving the access key to revoke", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, accessKeyPrivateKey, private)...)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *IAMAccessKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, accessKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var key iamAccessKeyPrivate
+	if err := json.Unmarshal(private, &key); err != nil {
+		resp.Diagnostics.AddError("Error reading the access key to revoke", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "revoking ephemeral access key "+key.Id)
+	_, _, err := e.client.GenClient.IamApi.IamServiceDeleteAccessKey(ctx).
+		AccessKeyId(key.Id).
+		UserName(key.UserName).
+		XEmcNamespace(key.Namespace).
+		Execute()
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error revoking access key "+key.Id, helper.APIErrorDetail(err))
+	}
+}
@@ -19,7 +19,9 @@ package provider
 
 import (
 	"context"
+	"terraform-provider-objectscale/internal/helper"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -40,9 +42,10 @@ type IAMGroupMembershipResource struct {
 
 // IAMGroupMembershipResourceModel describes the resource data model.
 type IAMGroupMembershipResourceModel struct {
-	GroupName types.String `tfsdk:"name"`
-	Namespace types.String `tfsdk:"namespace"`
-	User      types.String `tfsdk:"user"`
+	GroupName types.String   `tfsdk:"name"`
+	Namespace types.String   `tfsdk:"namespace"`
+	User      types.String   `tfsdk:"user"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *IAMGroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -72,6 +75,9 @@ func (r *IAMGroupMembershipResource) Schema(ctx context.Context, req resource.Sc
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Delete: true}),
+		},
 	}
 }
 
@@ -85,16 +91,22 @@ func (r *IAMGroupMembershipResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, _, err := r.client.GenClient.IamApi.IamServiceAddUserToGroup(ctx).GroupName(plan.GroupName.ValueString()).XEmcNamespace(plan.Namespace.ValueString()).UserName(plan.User.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error adding user to group", err.Error())
+		resp.Diagnostics.AddError("Error adding user to group", helper.APIErrorDetail(err))
 		return
 	}
 
 	// Read full membership list
 	members, _, err := r.client.GenClient.IamApi.IamServiceGetGroup(ctx).GroupName(plan.GroupName.ValueString()).XEmcNamespace(plan.Namespace.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading Group", err.Error())
+		resp.Diagnostics.AddError("Error reading Group", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -112,6 +124,7 @@ func (r *IAMGroupMembershipResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	// Save data into Terraform state
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -123,10 +136,19 @@ func (r *IAMGroupMembershipResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	members, _, err := r.client.GenClient.IamApi.IamServiceGetGroup(ctx).GroupName(state.GroupName.ValueString()).XEmcNamespace(state.Namespace.ValueString()).Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading Group", err.Error())
+		if helper.RemoveNotFound(ctx, err, &resp.State, "group "+state.GroupName.ValueString()) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Group", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -161,10 +183,16 @@ func (r *IAMGroupMembershipResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// API call: remove USER from GROUP
 	_, _, err := r.client.GenClient.IamApi.IamServiceRemoveUserFromGroup(ctx).GroupName(state.GroupName.ValueString()).XEmcNamespace(state.Namespace.ValueString()).UserName(state.User.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Remove user failed", err.Error())
+		resp.Diagnostics.AddError("Remove user failed", helper.APIErrorDetail(err))
 		return
 	}
 
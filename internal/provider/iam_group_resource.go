@@ -25,6 +25,8 @@ import (
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -90,6 +92,9 @@ func (r *IAMGroupResource) Schema(ctx context.Context, req resource.SchemaReques
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Delete: true}),
+		},
 	}
 }
 
@@ -104,9 +109,15 @@ func (r *IAMGroupResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	iam_group, _, err := r.client.GenClient.IamApi.IamServiceCreateGroup(ctx).GroupName(plan.GroupName.ValueString()).XEmcNamespace(plan.Namespace.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Group", err.Error())
+		resp.Diagnostics.AddError("Error creating Group", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -119,6 +130,7 @@ func (r *IAMGroupResource) Create(ctx context.Context, req resource.CreateReques
 	}, plan.Namespace)
 
 	// Save data into Terraform state
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -132,10 +144,19 @@ func (r *IAMGroupResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	iam_group, _, err := r.client.GenClient.IamApi.IamServiceGetGroup(ctx).GroupName(state.GroupName.ValueString()).XEmcNamespace(state.Namespace.ValueString()).Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading Group", err.Error())
+		if helper.RemoveNotFound(ctx, err, &resp.State, "group "+state.GroupName.ValueString()) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Group", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -148,6 +169,7 @@ func (r *IAMGroupResource) Read(ctx context.Context, req resource.ReadRequest, r
 		Path:       iam_group.GetGroupResult.Group.Path,
 	}, state.Namespace)
 	// Save updated plan into Terraform state
+	data.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -182,12 +204,18 @@ func (r *IAMGroupResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, _, err := r.client.GenClient.IamApi.IamServiceDeleteGroup(ctx).GroupName(state.GroupName.ValueString()).XEmcNamespace(state.Namespace.ValueString()).Execute()
 
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting IAM Group",
-			err.Error(),
+			helper.APIErrorDetail(err),
 		)
 	}
 }
@@ -203,7 +231,7 @@ func (r *IAMGroupResource) ImportState(ctx context.Context, req resource.ImportS
 	namespace := parts[1]
 	iam_group, _, err := r.client.GenClient.IamApi.IamServiceGetGroup(ctx).GroupName(group_name).XEmcNamespace(namespace).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading Group", err.Error())
+		resp.Diagnostics.AddError("Error reading Group", helper.APIErrorDetail(err))
 		return
 	}
 	data := r.getModel(&clientgen.IamServiceCreateGroupResponseCreateGroupResultGroup{
@@ -213,6 +241,7 @@ func (r *IAMGroupResource) ImportState(ctx context.Context, req resource.ImportS
 		CreateDate: iam_group.GetGroupResult.Group.CreateDate,
 		Path:       iam_group.GetGroupResult.Group.Path,
 	}, types.StringValue(namespace))
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
 	// Save updated plan into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
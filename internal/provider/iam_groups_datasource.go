@@ -133,7 +133,7 @@ func (d *IAMGroupsDataSource) Read(ctx context.Context, req datasource.ReadReque
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error retrieving groups for user",
-				fmt.Sprintf("Unable to retrieve groups for user %s: %s", userName, err.Error()),
+				fmt.Sprintf("Unable to retrieve groups for user %s: %s", userName, helper.APIErrorDetail(err)),
 			)
 			return
 		}
@@ -149,7 +149,7 @@ func (d *IAMGroupsDataSource) Read(ctx context.Context, req datasource.ReadReque
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error retrieving IAM group",
-				fmt.Sprintf("Failed to retrieve group %s: %s", groupName, err.Error()),
+				fmt.Sprintf("Failed to retrieve group %s: %s", groupName, helper.APIErrorDetail(err)),
 			)
 			return
 		}
@@ -163,7 +163,7 @@ func (d *IAMGroupsDataSource) Read(ctx context.Context, req datasource.ReadReque
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error retrieving IAM groups",
-				fmt.Sprintf("Failed to retrieve groups: %s", err.Error()),
+				fmt.Sprintf("Failed to retrieve groups: %s", helper.APIErrorDetail(err)),
 			)
 			return
 		}
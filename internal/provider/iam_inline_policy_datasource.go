@@ -3,12 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"terraform-provider-objectscale/internal/client"
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
+	"terraform-provider-objectscale/internal/policytypes"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -86,7 +86,7 @@ func (d *IAMInlinePolicyDataSource) Schema(ctx context.Context, req datasource.S
 							Description:         "Policy document in JSON format.",
 							MarkdownDescription: "Policy document in JSON format.",
 							Required:            true,
-							CustomType:          jsontypes.NormalizedType{},
+							CustomType:          policytypes.DocumentType{},
 						},
 					},
 				},
@@ -157,17 +157,17 @@ func (d *IAMInlinePolicyDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	if err != nil {
-		if strings.Contains(err.Error(), `"Code":"NoSuchEntity"`) {
+		if client.IsNotFound(err) {
 			resp.Diagnostics.AddError(
 				"Namespace does not exist or Entity does not exist.",
-				err.Error(),
+				helper.APIErrorDetail(err),
 			)
 			return
 		}
 
 		resp.Diagnostics.AddError(
 			"Error reading IAM inline policies",
-			err.Error(),
+			helper.APIErrorDetail(err),
 		)
 		return
 	}
@@ -214,7 +214,7 @@ func (d *IAMInlinePolicyDataSource) listUserInlinePolicies(ctx context.Context,
 
 		policies = append(policies, models.IAMInlinePolicyModel{
 			Name:     helper.TfString(&name),
-			Document: jsontypes.NewNormalizedValue(doc),
+			Document: policytypes.NewDocumentValue(policytypes.Decode(doc)),
 		})
 	}
 
@@ -255,7 +255,7 @@ func (d *IAMInlinePolicyDataSource) listGroupInlinePolicies(ctx context.Context,
 
 		policies = append(policies, models.IAMInlinePolicyModel{
 			Name:     helper.TfString(&name),
-			Document: jsontypes.NewNormalizedValue(doc),
+			Document: policytypes.NewDocumentValue(policytypes.Decode(doc)),
 		})
 	}
 
@@ -299,7 +299,7 @@ func (d *IAMInlinePolicyDataSource) listRoleInlinePolicies(ctx context.Context,
 
 		policies = append(policies, models.IAMInlinePolicyModel{
 			Name:     helper.TfString(&name),
-			Document: jsontypes.NewNormalizedValue(doc),
+			Document: policytypes.NewDocumentValue(policytypes.Decode(doc)),
 		})
 	}
 
@@ -23,8 +23,9 @@ import (
 	"strings"
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
+	"terraform-provider-objectscale/internal/policytypes"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -54,7 +55,7 @@ func (r *IAMInlinePolicyResource) Metadata(_ context.Context, req resource.Metad
 }
 
 // Schema defines the schema for the resource.
-func (r *IAMInlinePolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IAMInlinePolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description:         "This resource manages IAM inline policies for Dell ObjectScale entities (user, group, or role).",
 		MarkdownDescription: "This resource manages IAM inline policies for Dell ObjectScale entities (user, group, or role).",
@@ -117,12 +118,15 @@ func (r *IAMInlinePolicyResource) Schema(_ context.Context, _ resource.SchemaReq
 							Description:         "Policy document in JSON format.",
 							MarkdownDescription: "Policy document in JSON format.",
 							Required:            true,
-							CustomType:          jsontypes.NormalizedType{},
+							CustomType:          policytypes.DocumentType{},
 						},
 					},
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -137,6 +141,12 @@ func (r *IAMInlinePolicyResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Determine namespace
 	namespace := state.Namespace.ValueString()
 
@@ -170,7 +180,10 @@ func (r *IAMInlinePolicyResource) Read(ctx context.Context, req resource.ReadReq
 
 			listResp, _, err := listReq.Execute()
 			if err != nil {
-				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to list policies: %s", err.Error()))
+				if helper.RemoveNotFound(ctx, err, &resp.State, "inline policies of "+entityType+" "+entityName) {
+					return
+				}
+				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to list policies: %s", helper.APIErrorDetail(err)))
 				return
 			}
 
@@ -194,7 +207,10 @@ func (r *IAMInlinePolicyResource) Read(ctx context.Context, req resource.ReadReq
 
 			listResp, _, err := listReq.Execute()
 			if err != nil {
-				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to list policies: %s", err.Error()))
+				if helper.RemoveNotFound(ctx, err, &resp.State, "inline policies of "+entityType+" "+entityName) {
+					return
+				}
+				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to list policies: %s", helper.APIErrorDetail(err)))
 				return
 			}
 
@@ -218,7 +234,10 @@ func (r *IAMInlinePolicyResource) Read(ctx context.Context, req resource.ReadReq
 
 			listResp, _, err := listReq.Execute()
 			if err != nil {
-				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to list policies: %s", err.Error()))
+				if helper.RemoveNotFound(ctx, err, &resp.State, "inline policies of "+entityType+" "+entityName) {
+					return
+				}
+				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to list policies: %s", helper.APIErrorDetail(err)))
 				return
 			}
 
@@ -244,7 +263,7 @@ func (r *IAMInlinePolicyResource) Read(ctx context.Context, req resource.ReadReq
 				PolicyName(policyName).
 				Execute()
 			if err != nil {
-				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to get policy %s: %s", policyName, err.Error()))
+				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to get policy %s: %s", policyName, helper.APIErrorDetail(err)))
 				return
 			}
 			policyDoc = *getResp.GetUserPolicyResult.PolicyDocument
@@ -256,7 +275,7 @@ func (r *IAMInlinePolicyResource) Read(ctx context.Context, req resource.ReadReq
 				PolicyName(policyName).
 				Execute()
 			if err != nil {
-				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to get policy %s: %s", policyName, err.Error()))
+				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to get policy %s: %s", policyName, helper.APIErrorDetail(err)))
 				return
 			}
 			policyDoc = *getResp.GetGroupPolicyResult.PolicyDocument
@@ -268,7 +287,7 @@ func (r *IAMInlinePolicyResource) Read(ctx context.Context, req resource.ReadReq
 				PolicyName(policyName).
 				Execute()
 			if err != nil {
-				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to get policy %s: %s", policyName, err.Error()))
+				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to get policy %s: %s", policyName, helper.APIErrorDetail(err)))
 				return
 			}
 			policyDoc = *getResp.GetRolePolicyResult.PolicyDocument
@@ -276,7 +295,7 @@ func (r *IAMInlinePolicyResource) Read(ctx context.Context, req resource.ReadReq
 
 		policies = append(policies, models.IAMInlinePolicyModel{
 			Name:     types.StringValue(policyName),
-			Document: jsontypes.NewNormalizedValue(policyDoc),
+			Document: policytypes.NewDocumentValue(policytypes.Decode(policyDoc)),
 		})
 	}
 	if policies == nil {
@@ -285,6 +304,7 @@ func (r *IAMInlinePolicyResource) Read(ctx context.Context, req resource.ReadReq
 
 	// Update state
 	state.Policies = policies
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -298,12 +318,19 @@ func (r *IAMInlinePolicyResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updatedModel, err := helper.ApplyPolicies(r.client, ctx, plan, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Create Error", err.Error())
+		resp.Diagnostics.AddError("Create Error", helper.APIErrorDetail(err))
 		return
 	}
 
+	updatedModel.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, updatedModel)
 	resp.Diagnostics.Append(diags...)
 }
@@ -317,6 +344,12 @@ func (r *IAMInlinePolicyResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state models.IAMInlinePolicyResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -359,10 +392,11 @@ func (r *IAMInlinePolicyResource) Update(ctx context.Context, req resource.Updat
 
 	updatedModel, err := helper.ApplyPolicies(r.client, ctx, plan, &state)
 	if err != nil {
-		resp.Diagnostics.AddError("Update Error", err.Error())
+		resp.Diagnostics.AddError("Update Error", helper.APIErrorDetail(err))
 		return
 	}
 
+	updatedModel.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, updatedModel)
 	resp.Diagnostics.Append(diags...)
 }
@@ -378,10 +412,16 @@ func (r *IAMInlinePolicyResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	state.Policies = []models.IAMInlinePolicyModel{}
 	_, err := helper.ApplyPolicies(r.client, ctx, state, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Delete Error", err.Error())
+		resp.Diagnostics.AddError("Delete Error", helper.APIErrorDetail(err))
 		return
 	}
 
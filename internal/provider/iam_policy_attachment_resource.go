@@ -24,6 +24,7 @@ import (
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -53,7 +54,7 @@ func (r *IAMPolicyAttachmentResource) Metadata(_ context.Context, req resource.M
 }
 
 // Schema defines the schema for the resource.
-func (r *IAMPolicyAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IAMPolicyAttachmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description:         "This resource attaches an IAM policy to a target principal (user, group, or role) in Dell ObjectScale.",
 		MarkdownDescription: "This resource attaches an IAM policy to a target principal (user, group, or role) in Dell ObjectScale.",
@@ -108,6 +109,9 @@ func (r *IAMPolicyAttachmentResource) Schema(_ context.Context, _ resource.Schem
 				ElementType:         types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -122,6 +126,12 @@ func (r *IAMPolicyAttachmentResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Determine namespace
 	namespace := state.Namespace.ValueString()
 
@@ -155,7 +165,10 @@ func (r *IAMPolicyAttachmentResource) Read(ctx context.Context, req resource.Rea
 
 			listResp, _, err := listReq.Execute()
 			if err != nil {
-				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to list policy arns: %s", err.Error()))
+				if helper.RemoveNotFound(ctx, err, &resp.State, "policy attachments of "+entityType+" "+entityName) {
+					return
+				}
+				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to list policy arns: %s", helper.APIErrorDetail(err)))
 				return
 			}
 
@@ -181,7 +194,10 @@ func (r *IAMPolicyAttachmentResource) Read(ctx context.Context, req resource.Rea
 
 			listResp, _, err := listReq.Execute()
 			if err != nil {
-				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to list policy arns: %s", err.Error()))
+				if helper.RemoveNotFound(ctx, err, &resp.State, "policy attachments of "+entityType+" "+entityName) {
+					return
+				}
+				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to list policy arns: %s", helper.APIErrorDetail(err)))
 				return
 			}
 
@@ -207,7 +223,10 @@ func (r *IAMPolicyAttachmentResource) Read(ctx context.Context, req resource.Rea
 
 			listResp, _, err := listReq.Execute()
 			if err != nil {
-				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to list policy arns: %s", err.Error()))
+				if helper.RemoveNotFound(ctx, err, &resp.State, "policy attachments of "+entityType+" "+entityName) {
+					return
+				}
+				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Failed to list policy arns: %s", helper.APIErrorDetail(err)))
 				return
 			}
 
@@ -249,12 +268,19 @@ func (r *IAMPolicyAttachmentResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updatedModel, err := helper.ApplyPolicyARNs(r.client, ctx, plan, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Create Error", err.Error())
+		resp.Diagnostics.AddError("Create Error", helper.APIErrorDetail(err))
 		return
 	}
 
+	updatedModel.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, updatedModel)
 	resp.Diagnostics.Append(diags...)
 }
@@ -268,6 +294,12 @@ func (r *IAMPolicyAttachmentResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state models.IAMPolicyAttachmentResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -310,10 +342,11 @@ func (r *IAMPolicyAttachmentResource) Update(ctx context.Context, req resource.U
 
 	updatedModel, err := helper.ApplyPolicyARNs(r.client, ctx, plan, &state)
 	if err != nil {
-		resp.Diagnostics.AddError("Update Error", err.Error())
+		resp.Diagnostics.AddError("Update Error", helper.APIErrorDetail(err))
 		return
 	}
 
+	updatedModel.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, updatedModel)
 	resp.Diagnostics.Append(diags...)
 }
@@ -329,6 +362,12 @@ func (r *IAMPolicyAttachmentResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	emptySet, diags := types.SetValueFrom(ctx, types.StringType, []string{})
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -338,7 +377,7 @@ func (r *IAMPolicyAttachmentResource) Delete(ctx context.Context, req resource.D
 
 	_, err := helper.ApplyPolicyARNs(r.client, ctx, state, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Delete Error", err.Error())
+		resp.Diagnostics.AddError("Delete Error", helper.APIErrorDetail(err))
 		return
 	}
 
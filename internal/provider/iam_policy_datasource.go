@@ -19,10 +19,10 @@ package provider
 
 import (
 	"context"
-	"net/url"
 	"terraform-provider-objectscale/internal/clientgen"
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
+	"terraform-provider-objectscale/internal/policytypes"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -132,13 +132,32 @@ func (d *IAMPolicyDataSource) itemSchema() schema.ListNestedAttribute {
 								Computed:            true,
 							},
 							"document": schema.StringAttribute{
-								Description:         "The policy document, URL-encoded compliant with RFC 3986.",
-								MarkdownDescription: "The policy document, URL-encoded compliant with RFC 3986.",
+								Description:         "The policy document in JSON.",
+								MarkdownDescription: "The policy document in JSON.",
 								Computed:            true,
+								CustomType:          policytypes.DocumentType{},
 							},
 						},
 					},
 				},
+				"attached_users": schema.ListAttribute{
+					Description:         "Names of the users that the policy is attached to.",
+					MarkdownDescription: "Names of the users that the policy is attached to.",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"attached_groups": schema.ListAttribute{
+					Description:         "Names of the groups that the policy is attached to.",
+					MarkdownDescription: "Names of the groups that the policy is attached to.",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"attached_roles": schema.ListAttribute{
+					Description:         "Names of the roles that the policy is attached to.",
+					MarkdownDescription: "Names of the roles that the policy is attached to.",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
 			},
 		},
 	}
@@ -219,7 +238,7 @@ func (d *IAMPolicyDataSource) Read(ctx context.Context, req datasource.ReadReque
 		NsResp, _, err := d.client.GenClient.IamApi.IamServiceGetPolicy(ctx).XEmcNamespace(namespace).
 			PolicyArn(*arn).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error fetching IAM policies with ARN: "+*arn, err.Error())
+			resp.Diagnostics.AddError("Error fetching IAM policies with ARN: "+*arn, helper.APIErrorDetail(err))
 			return
 		}
 		allPolicyResp = append(allPolicyResp, *NsResp.GetPolicyResult.Policy)
@@ -229,7 +248,7 @@ func (d *IAMPolicyDataSource) Read(ctx context.Context, req datasource.ReadReque
 			UserName(*user)
 		dsresp, err := helper.GetAllInstances(dsreq)
 		if err != nil {
-			resp.Diagnostics.AddError("Error listing IAM policies attached to user: "+*user, err.Error())
+			resp.Diagnostics.AddError("Error listing IAM policies attached to user: "+*user, helper.APIErrorDetail(err))
 			return
 		}
 		allPolicyResp = helper.SliceTransform(dsresp, d.attachedToMain)
@@ -240,7 +259,7 @@ func (d *IAMPolicyDataSource) Read(ctx context.Context, req datasource.ReadReque
 			GroupName(*group)
 		dsresp, err := helper.GetAllInstances(dsreq)
 		if err != nil {
-			resp.Diagnostics.AddError("Error listing IAM policies attached to group: "+*group, err.Error())
+			resp.Diagnostics.AddError("Error listing IAM policies attached to group: "+*group, helper.APIErrorDetail(err))
 			return
 		}
 		allPolicyResp = helper.SliceTransform(dsresp, d.attachedToMain)
@@ -251,7 +270,7 @@ func (d *IAMPolicyDataSource) Read(ctx context.Context, req datasource.ReadReque
 			RoleName(*role)
 		dsresp, err := helper.GetAllInstances(dsreq)
 		if err != nil {
-			resp.Diagnostics.AddError("Error listing IAM policies attached to role: "+*role, err.Error())
+			resp.Diagnostics.AddError("Error listing IAM policies attached to role: "+*role, helper.APIErrorDetail(err))
 			return
 		}
 		allPolicyResp = helper.SliceTransform(dsresp, d.attachedToMain)
@@ -261,7 +280,7 @@ func (d *IAMPolicyDataSource) Read(ctx context.Context, req datasource.ReadReque
 		dsreq := d.client.GenClient.IamApi.IamServiceListPolicies(ctx).XEmcNamespace(namespace)
 		dsresp, err := helper.GetAllInstances(dsreq)
 		if err != nil {
-			resp.Diagnostics.AddError("Error listing IAM policies", err.Error())
+			resp.Diagnostics.AddError("Error listing IAM policies", helper.APIErrorDetail(err))
 			return
 		}
 		allPolicyResp = dsresp
@@ -271,7 +290,7 @@ func (d *IAMPolicyDataSource) Read(ctx context.Context, req datasource.ReadReque
 		// get full details of attached policies
 		poulatedPolicyResp, verr := d.populateAttachedPolicies(ctx, namespace, allPolicyResp)
 		if verr != nil {
-			resp.Diagnostics.AddError("Error fetching details of attached IAM policies", verr.Error())
+			resp.Diagnostics.AddError("Error fetching details of attached IAM policies", helper.APIErrorDetail(verr))
 			return
 		}
 		allPolicyResp = poulatedPolicyResp
@@ -280,7 +299,13 @@ func (d *IAMPolicyDataSource) Read(ctx context.Context, req datasource.ReadReque
 	// populate version details for all policies
 	allPolicyRespWithVersions, verr := d.populateVersions(ctx, namespace, allPolicyResp)
 	if verr != nil {
-		resp.Diagnostics.AddError("Error fetching IAM policy versions", verr.Error())
+		resp.Diagnostics.AddError("Error fetching IAM policy versions", helper.APIErrorDetail(verr))
+		return
+	}
+
+	// populate the users, groups and roles each policy is attached to
+	if eerr := d.populateEntities(ctx, namespace, allPolicyRespWithVersions); eerr != nil {
+		resp.Diagnostics.AddError("Error listing entities attached to IAM policies", helper.APIErrorDetail(eerr))
 		return
 	}
 
@@ -327,23 +352,26 @@ func (d IAMPolicyDataSource) updateState(iam_policys []iamPolicyDsResult) []mode
 					Document:         d.decodeDocument(vv.Document),
 				}
 			}),
+			AttachedUsers: helper.SliceTransform(v.Entities.PolicyUsers, func(u clientgen.IamPolicyUser) types.String {
+				return helper.TfStringNN(u.UserName)
+			}),
+			AttachedGroups: helper.SliceTransform(v.Entities.PolicyGroups, func(g clientgen.IamPolicyGroup) types.String {
+				return helper.TfStringNN(g.GroupName)
+			}),
+			AttachedRoles: helper.SliceTransform(v.Entities.PolicyRoles, func(r clientgen.IamPolicyRole) types.String {
+				return helper.TfStringNN(r.RoleName)
+			}),
 		}
 	})
 }
 
 // Policy Version API returns document in URL encoded format
 // This function decodes the document.
-func (d IAMPolicyDataSource) decodeDocument(in *string) types.String {
+func (d IAMPolicyDataSource) decodeDocument(in *string) policytypes.Document {
 	if in == nil {
-		return types.StringValue("")
+		return policytypes.NewDocumentValue("")
 	}
-	ins := *in
-	// Decode the document, which is a URL-encoded compliant with RFC 3986 json string
-	document, err := url.QueryUnescape(ins)
-	if err != nil {
-		return types.StringValue(ins)
-	}
-	return types.StringValue(document)
+	return policytypes.NewDocumentFromAPI(in)
 }
 
 // attached policy APIs return basic data
@@ -365,6 +393,7 @@ func (d IAMPolicyDataSource) populateAttachedPolicies(ctx context.Context, names
 type iamPolicyDsResult struct {
 	Policy   clientgen.IamPolicy
 	Versions []clientgen.IamPolicyVersion
+	Entities clientgen.IamServiceListEntitiesForPolicyResponseListEntitiesForPolicyResult
 }
 
 // Populate version details for each policy.
@@ -384,3 +413,15 @@ func (d IAMPolicyDataSource) populateVersions(ctx context.Context, namespace str
 	}
 	return ret, nil
 }
+
+// Populate the users, groups and roles each policy is attached to.
+func (d IAMPolicyDataSource) populateEntities(ctx context.Context, namespace string, iam_policys []iamPolicyDsResult) error {
+	for i, v := range iam_policys {
+		entities, err := helper.ListEntitiesForPolicy(d.client, ctx, namespace, *v.Policy.Arn)
+		if err != nil {
+			return err
+		}
+		iam_policys[i].Entities = entities
+	}
+	return nil
+}
@@ -83,6 +83,20 @@ func TestAcc_IamPolicyDataSource(t *testing.T) {
 				`,
 				ExpectError: regexp.MustCompile(`Error fetching IAM policy versions`),
 			},
+			{
+				// mocked list entities error
+				PreConfig: func() {
+					upM.UnPatch()
+					upM = mockey.Mock((*clientgen.IamApiService).IamServiceListEntitiesForPolicyExecute).
+						Return(nil, nil, fmt.Errorf("{}")).Build()
+				},
+				Config: ProviderConfigForTesting + `
+				data "objectscale_iam_policy" "all" {
+					namespace = "ns1"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Error listing entities attached to IAM policies`),
+			},
 			{
 				// get by arn
 				// atleast one policy in ns1 must exist
@@ -132,6 +146,11 @@ func TestAcc_IamPolicyDataSource(t *testing.T) {
 						"policies.0.policy_name",
 						"IAMReadOnlyAccess",
 					),
+					resource.TestCheckTypeSetElemAttr(
+						"data.objectscale_iam_policy.iam_policy",
+						"policies.0.attached_users.*",
+						"testaccpreq",
+					),
 				),
 			},
 			{
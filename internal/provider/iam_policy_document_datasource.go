@@ -0,0 +1,321 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"terraform-provider-objectscale/internal/helper"
+	"terraform-provider-objectscale/internal/models"
+	"terraform-provider-objectscale/internal/policytypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IAMPolicyDocumentDataSource{}
+
+func NewIAMPolicyDocumentDataSource() datasource.DataSource {
+	return &IAMPolicyDocumentDataSource{}
+}
+
+// IAMPolicyDocumentDataSource renders IAM policy documents. It does not call the ObjectScale API.
+type IAMPolicyDocumentDataSource struct{}
+
+func (d *IAMPolicyDocumentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_policy_document"
+}
+
+// principals block schema.
+func (d *IAMPolicyDocumentDataSource) principalsSchema(description string) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		Description:         description,
+		MarkdownDescription: description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Description:         "Type of the principals, e.g. AWS for users and roles, Federated for SAML providers, or * for everyone.",
+					MarkdownDescription: "Type of the principals, e.g. `AWS` for users and roles, `Federated` for SAML providers, or `*` for everyone.",
+					Required:            true,
+				},
+				"identifiers": schema.SetAttribute{
+					Description:         "Identifiers of the principals, e.g. ARNs of users and roles, or * for everyone.",
+					MarkdownDescription: "Identifiers of the principals, e.g. ARNs of users and roles, or `*` for everyone.",
+					ElementType:         types.StringType,
+					Required:            true,
+				},
+			},
+		},
+	}
+}
+
+// statement block schema.
+func (d *IAMPolicyDocumentDataSource) statementSchema() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description:         "Statements of the policy document.",
+		MarkdownDescription: "Statements of the policy document.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"sid": schema.StringAttribute{
+					Description:         "Identifier of the statement. Statements with a Sid replace the statements with the same Sid of the source policy documents, and are replaced by the ones of the override policy documents.",
+					MarkdownDescription: "Identifier of the statement. Statements with a Sid replace the statements with the same Sid of the source policy documents, and are replaced by the ones of the override policy documents.",
+					Optional:            true,
+				},
+				"effect": schema.StringAttribute{
+					Description:         "Whether the statement allows or denies the actions. Valid values are Allow and Deny. Defaults to Allow.",
+					MarkdownDescription: "Whether the statement allows or denies the actions. Valid values are `Allow` and `Deny`. Defaults to `Allow`.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("Allow", "Deny"),
+					},
+				},
+				"actions": schema.SetAttribute{
+					Description:         "Actions the statement applies to, e.g. s3:GetObject or iam:*.",
+					MarkdownDescription: "Actions the statement applies to, e.g. `s3:GetObject` or `iam:*`.",
+					ElementType:         types.StringType,
+					Optional:            true,
+					Validators: []validator.Set{
+						setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("not_actions")),
+					},
+				},
+				"not_actions": schema.SetAttribute{
+					Description:         "Actions the statement does not apply to.",
+					MarkdownDescription: "Actions the statement does not apply to.",
+					ElementType:         types.StringType,
+					Optional:            true,
+				},
+				"resources": schema.SetAttribute{
+					Description:         "Resources the statement applies to, e.g. the ARN of a bucket.",
+					MarkdownDescription: "Resources the statement applies to, e.g. the ARN of a bucket.",
+					ElementType:         types.StringType,
+					Optional:            true,
+					Validators: []validator.Set{
+						setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("not_resources")),
+					},
+				},
+				"not_resources": schema.SetAttribute{
+					Description:         "Resources the statement does not apply to.",
+					MarkdownDescription: "Resources the statement does not apply to.",
+					ElementType:         types.StringType,
+					Optional:            true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"principals":     d.principalsSchema("Principals the statement applies to. Used in bucket policies and role trust policies."),
+				"not_principals": d.principalsSchema("Principals the statement does not apply to."),
+				"condition": schema.SetNestedBlock{
+					Description:         "Conditions under which the statement applies.",
+					MarkdownDescription: "Conditions under which the statement applies.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"test": schema.StringAttribute{
+								Description:         "Condition operator, e.g. StringEquals or IpAddress.",
+								MarkdownDescription: "Condition operator, e.g. `StringEquals` or `IpAddress`.",
+								Required:            true,
+							},
+							"variable": schema.StringAttribute{
+								Description:         "Condition key the operator is applied to, e.g. aws:SourceIp.",
+								MarkdownDescription: "Condition key the operator is applied to, e.g. `aws:SourceIp`.",
+								Required:            true,
+							},
+							"values": schema.ListAttribute{
+								Description:         "Values the condition key is compared with. The condition is met when any of the values matches.",
+								MarkdownDescription: "Values the condition key is compared with. The condition is met when any of the values matches.",
+								ElementType:         types.StringType,
+								Required:            true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Schema describes the data source arguments.
+func (d *IAMPolicyDocumentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This data source renders an IAM policy document in JSON from its statements, to be used in the policies of the IAM resources and in bucket policies.",
+		Description:         "This data source renders an IAM policy document in JSON from its statements, to be used in the policies of the IAM resources and in bucket policies.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier, the hash of the policy document.",
+				MarkdownDescription: "Identifier, the hash of the policy document.",
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				Description:         "Version of the IAM policy language. Defaults to 2012-10-17.",
+				MarkdownDescription: "Version of the IAM policy language. Defaults to `2012-10-17`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("2008-10-17", "2012-10-17"),
+				},
+			},
+			"policy_id": schema.StringAttribute{
+				Description:         "Identifier of the policy document, rendered as its Id element.",
+				MarkdownDescription: "Identifier of the policy document, rendered as its `Id` element.",
+				Optional:            true,
+			},
+			"source_policy_documents": schema.ListAttribute{
+				Description: "JSON policy documents whose statements are merged in the policy document, in order, before the statements. " +
+					"The statements of the source documents must have unique Sids.",
+				MarkdownDescription: "JSON policy documents whose statements are merged in the policy document, in order, before the statements. " +
+					"The statements of the source documents must have unique Sids.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"override_policy_documents": schema.ListAttribute{
+				Description: "JSON policy documents whose statements are merged in the policy document, in order, after the statements. " +
+					"A statement of an override document replaces the statement with the same Sid, or is appended.",
+				MarkdownDescription: "JSON policy documents whose statements are merged in the policy document, in order, after the statements. " +
+					"A statement of an override document replaces the statement with the same Sid, or is appended.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"json": schema.StringAttribute{
+				Description:         "Policy document in indented JSON.",
+				MarkdownDescription: "Policy document in indented JSON.",
+				Computed:            true,
+				CustomType:          policytypes.DocumentType{},
+			},
+			"minified_json": schema.StringAttribute{
+				Description:         "Policy document in minified JSON.",
+				MarkdownDescription: "Policy document in minified JSON.",
+				Computed:            true,
+				CustomType:          policytypes.DocumentType{},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"statement": d.statementSchema(),
+		},
+	}
+}
+
+func (d *IAMPolicyDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Rendering IAM policy document")
+	var data models.IAMPolicyDocumentDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	document := &helper.PolicyDocument{
+		Version: data.Version.ValueString(),
+		Id:      data.PolicyId.ValueString(),
+	}
+	for _, s := range data.Statements {
+		document.Statements = append(document.Statements, d.statement(ctx, s, &resp.Diagnostics))
+	}
+	sources := d.parseDocuments(data.SourcePolicyDocuments, "source_policy_documents", &resp.Diagnostics)
+	overrides := d.parseDocuments(data.OverridePolicyDocuments, "override_policy_documents", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	merged, err := helper.MergePolicyDocuments(sources, document, overrides)
+	if err != nil {
+		resp.Diagnostics.AddError("Error merging IAM policy documents", err.Error())
+		return
+	}
+	indented, minified, err := helper.MarshalPolicyDocument(merged)
+	if err != nil {
+		resp.Diagnostics.AddError("Error rendering IAM policy document", err.Error())
+		return
+	}
+
+	hash := sha256.Sum256([]byte(minified))
+	data.Id = types.StringValue(hex.EncodeToString(hash[:]))
+	data.Json = policytypes.NewDocumentValue(indented)
+	data.MinifiedJson = policytypes.NewDocumentValue(minified)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// helper function to convert a statement block to a policy statement.
+func (d *IAMPolicyDocumentDataSource) statement(ctx context.Context, in models.IAMPolicyDocumentStatementModel, diags *diag.Diagnostics) *helper.PolicyStatement {
+	values := func(set types.Set) []string {
+		var ret []string
+		diags.Append(set.ElementsAs(ctx, &ret, false)...)
+		return ret
+	}
+	principals := func(in []models.IAMPolicyDocumentPrincipalModel) []helper.PolicyPrincipal {
+		var ret []helper.PolicyPrincipal
+		for _, p := range in {
+			ret = append(ret, helper.PolicyPrincipal{Type: p.Type.ValueString(), Identifiers: values(p.Identifiers)})
+		}
+		return ret
+	}
+
+	out := &helper.PolicyStatement{
+		Sid:           in.Sid.ValueString(),
+		Effect:        "Allow",
+		Actions:       helper.PolicyValues(values(in.Actions)),
+		NotActions:    helper.PolicyValues(values(in.NotActions)),
+		Resources:     helper.PolicyValues(values(in.Resources)),
+		NotResources:  helper.PolicyValues(values(in.NotResources)),
+		Principals:    helper.PolicyPrincipals(principals(in.Principals)),
+		NotPrincipals: helper.PolicyPrincipals(principals(in.NotPrincipals)),
+	}
+	if !in.Effect.IsNull() {
+		out.Effect = in.Effect.ValueString()
+	}
+	var conditions []helper.PolicyCondition
+	for _, c := range in.Conditions {
+		conditions = append(conditions, helper.PolicyCondition{
+			Test:     c.Test.ValueString(),
+			Variable: c.Variable.ValueString(),
+			Values:   helper.ValueToList[string](c.Values),
+		})
+	}
+	out.Conditions = helper.PolicyConditions(conditions)
+	return out
+}
+
+// helper function to parse the JSON policy documents of an attribute.
+func (d *IAMPolicyDocumentDataSource) parseDocuments(in types.List, attribute string, diags *diag.Diagnostics) []*helper.PolicyDocument {
+	var documents []*helper.PolicyDocument
+	for i, element := range in.Elements() {
+		document := helper.ValueToPointer[string](element)
+		if document == nil {
+			continue
+		}
+		parsed, err := helper.ParsePolicyDocument(*document)
+		if err != nil {
+			diags.AddAttributeError(path.Root(attribute).AtListIndex(i), "Invalid IAM policy document",
+				fmt.Sprintf("The policy document %d of %s is not valid JSON: %s", i+1, attribute, err.Error()))
+			continue
+		}
+		documents = append(documents, parsed)
+	}
+	return documents
+}
@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIAMPolicyDocumentDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// 1. Render statements
+			{
+				Config: ProviderConfigForTesting + `
+					data "objectscale_iam_policy_document" "read" {
+						statement {
+							sid       = "ReadObjects"
+							actions   = ["s3:GetObject", "s3:ListBucket"]
+							resources = ["arn:aws:s3:::bucket1", "arn:aws:s3:::bucket1/*"]
+							principals {
+								type        = "AWS"
+								identifiers = ["urn:ecs:iam::ns1:user/sample_user_1"]
+							}
+							condition {
+								test     = "IpAddress"
+								variable = "aws:SourceIp"
+								values   = ["10.0.0.0/8"]
+							}
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.objectscale_iam_policy_document.read", "id"),
+					resource.TestCheckResourceAttr("data.objectscale_iam_policy_document.read", "minified_json",
+						`{"Version":"2012-10-17","Statement":[{"Sid":"ReadObjects","Effect":"Allow",`+
+							`"Action":["s3:GetObject","s3:ListBucket"],"Resource":["arn:aws:s3:::bucket1","arn:aws:s3:::bucket1/*"],`+
+							`"Principal":{"AWS":"urn:ecs:iam::ns1:user/sample_user_1"},"Condition":{"IpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}]}`),
+				),
+			},
+			// 2. Merge source and override documents
+			{
+				Config: ProviderConfigForTesting + `
+					data "objectscale_iam_policy_document" "source" {
+						statement {
+							sid       = "List"
+							actions   = ["s3:ListBucket"]
+							resources = ["*"]
+						}
+						statement {
+							sid       = "Read"
+							actions   = ["s3:GetObject"]
+							resources = ["*"]
+						}
+					}
+
+					data "objectscale_iam_policy_document" "merged" {
+						source_policy_documents   = [data.objectscale_iam_policy_document.source.json]
+						override_policy_documents = [jsonencode({
+							Statement = [{ Sid = "Read", Effect = "Deny", Action = "s3:GetObject", Resource = "*" }]
+						})]
+						statement {
+							sid       = "List"
+							actions   = ["s3:ListAllMyBuckets"]
+							resources = ["*"]
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.objectscale_iam_policy_document.merged", "minified_json",
+						`{"Version":"2012-10-17","Statement":[`+
+							`{"Sid":"List","Effect":"Allow","Action":"s3:ListAllMyBuckets","Resource":"*"},`+
+							`{"Sid":"Read","Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`),
+				),
+			},
+			// 3. Invalid source document
+			{
+				Config: ProviderConfigForTesting + `
+					data "objectscale_iam_policy_document" "invalid" {
+						source_policy_documents = ["{"]
+					}
+				`,
+				ExpectError: regexp.MustCompile("Invalid IAM policy document"),
+			},
+		},
+	})
+}
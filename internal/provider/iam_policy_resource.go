@@ -17,15 +17,18 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"terraform-provider-objectscale/internal/clientgen"
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
+	"terraform-provider-objectscale/internal/policytypes"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -46,7 +49,7 @@ func (r *IAMPolicyResource) Metadata(_ context.Context, req resource.MetadataReq
 	resp.TypeName = req.ProviderTypeName + "_iam_policy"
 }
 
-func (r *IAMPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IAMPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description:         "This resource manages an Dell ObjectScale IAM policy.",
 		MarkdownDescription: "This resource manages an Dell ObjectScale IAM policy.",
@@ -60,7 +63,7 @@ func (r *IAMPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description:         "The Policy Document defining the IAM Policy versions in JSON format.",
 				MarkdownDescription: "The Policy Document defining the IAM Policy versions in JSON format.",
 				Required:            true,
-				CustomType:          jsontypes.NormalizedType{},
+				CustomType:          policytypes.DocumentType{},
 			},
 			"namespace": schema.StringAttribute{
 				Description:         "The namespace in which to create the IAM Policy.",
@@ -91,6 +94,17 @@ func (r *IAMPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				MarkdownDescription: "The creation date of the IAM Policy.",
 				Computed:            true,
 			},
+
+			"force_detach_on_destroy": schema.BoolAttribute{
+				Description:         "Whether the policy is detached from all its users, groups and roles, and its non-default versions deleted, when the policy is destroyed. When false, destroying a policy that is still attached fails.",
+				MarkdownDescription: "Whether the policy is detached from all its users, groups and roles, and its non-default versions deleted, when the policy is destroyed. When `false`, destroying a policy that is still attached fails.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
 		},
 	}
 }
@@ -104,6 +118,12 @@ func (r *IAMPolicyResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	creq := r.client.GenClient.IamApi.IamServiceCreatePolicy(ctx).
 		PolicyName(plan.PolicyName.ValueString()).
 		PolicyDocument(plan.PolicyDocument.ValueString()).
@@ -114,7 +134,7 @@ func (r *IAMPolicyResource) Create(ctx context.Context, req resource.CreateReque
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating IAM Policy",
-			"Could not create IAM Policy: "+err.Error(),
+			"Could not create IAM Policy: "+helper.APIErrorDetail(err),
 		)
 		return
 	}
@@ -128,12 +148,14 @@ func (r *IAMPolicyResource) Create(ctx context.Context, req resource.CreateReque
 	}, plan.PolicyDocument, plan.Namespace)
 
 	// save into state
+	data.ForceDetach = plan.ForceDetach
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *IAMPolicyResource) getModel(
 	iam_policy *clientgen.IamServiceCreatePolicyResponseCreatePolicyResultPolicy,
-	policyDocument jsontypes.Normalized,
+	policyDocument policytypes.Document,
 	namespace types.String) models.IamPolicyResourceModel {
 
 	return models.IamPolicyResourceModel{
@@ -157,13 +179,22 @@ func (r *IAMPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	iam_policy, _, err := r.client.GenClient.IamApi.IamServiceGetPolicy(ctx).
 		PolicyArn(state.Arn.ValueString()).
 		XEmcNamespace(state.Namespace.ValueString()).
 		Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading IAM Policy", err.Error())
+		if helper.RemoveNotFound(ctx, err, &resp.State, "policy "+state.Arn.ValueString()) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading IAM Policy", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -174,11 +205,14 @@ func (r *IAMPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 		Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading IAM Policy", err.Error())
+		if helper.RemoveNotFound(ctx, err, &resp.State, "policy "+state.Arn.ValueString()) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading IAM Policy", helper.APIErrorDetail(err))
 		return
 	}
 
-	var policyDocument jsontypes.Normalized = jsontypes.NewNormalizedValue(IAMPolicyDataSource{}.decodeDocument(iam_policy_document.GetPolicyVersionResult.PolicyVersion.Document).ValueString())
+	policyDocument := IAMPolicyDataSource{}.decodeDocument(iam_policy_document.GetPolicyVersionResult.PolicyVersion.Document)
 
 	data := r.getModel(&clientgen.IamServiceCreatePolicyResponseCreatePolicyResultPolicy{
 		PolicyName:       iam_policy.GetPolicyResult.Policy.PolicyName,
@@ -187,8 +221,14 @@ func (r *IAMPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 		DefaultVersionId: iam_policy.GetPolicyResult.Policy.DefaultVersionId,
 		Description:      iam_policy.GetPolicyResult.Policy.Description,
 	}, policyDocument, state.Namespace)
+	data.ForceDetach = state.ForceDetach
+	if data.ForceDetach.IsNull() {
+		// imported policy
+		data.ForceDetach = types.BoolValue(false)
+	}
 
 	// Save updated plan into Terraform state
+	data.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -203,11 +243,25 @@ func (r *IAMPolicyResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if helper.IsChangedNN(plan.PolicyName, state.PolicyName) || helper.IsChangedNN(plan.Description, state.Description) || helper.IsChangedNN(plan.Namespace, state.Namespace) {
 		resp.Diagnostics.AddError("Unexpected Update Parameter : Only Policy Document is updateable", "Invalid Update")
 		return
 	}
 
+	if plan.PolicyDocument.Equal(state.PolicyDocument) {
+		// only force_detach_on_destroy changed, no new policy version is needed
+		state.ForceDetach = plan.ForceDetach
+		state.Timeouts = plan.Timeouts
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
 	listreq := r.client.GenClient.IamApi.IamServiceListPolicyVersions(ctx).
 		PolicyArn(state.Arn.ValueString()).
 		XEmcNamespace(plan.Namespace.ValueString())
@@ -216,7 +270,7 @@ func (r *IAMPolicyResource) Update(ctx context.Context, req resource.UpdateReque
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error fetching IAM Policy Versions",
-			err.Error(),
+			helper.APIErrorDetail(err),
 		)
 		return
 	}
@@ -232,7 +286,7 @@ func (r *IAMPolicyResource) Update(ctx context.Context, req resource.UpdateReque
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Error deleting non-default IAM Policy Version : "+*v.VersionId,
-					err.Error(),
+					helper.APIErrorDetail(err),
 				)
 				return
 			}
@@ -249,7 +303,7 @@ func (r *IAMPolicyResource) Update(ctx context.Context, req resource.UpdateReque
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating new IAM Policy Version",
-			err.Error(),
+			helper.APIErrorDetail(err),
 		)
 		return
 	}
@@ -268,6 +322,8 @@ func (r *IAMPolicyResource) Update(ctx context.Context, req resource.UpdateReque
 	}, plan.PolicyDocument, plan.Namespace)
 
 	// save into state
+	data.ForceDetach = plan.ForceDetach
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -280,21 +336,66 @@ func (r *IAMPolicyResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ForceDetach.ValueBool() {
+		if err := r.detachAndPruneVersions(ctx, state.Namespace.ValueString(), state.Arn.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting IAM Policy",
+				"Could not detach IAM Policy before deleting it: "+helper.APIErrorDetail(err),
+			)
+			return
+		}
+	}
+
 	dreq := r.client.GenClient.IamApi.IamServiceDeletePolicy(ctx).
 		PolicyArn(state.Arn.ValueString()).
 		XEmcNamespace(state.Namespace.ValueString())
 
 	_, _, err := dreq.Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error deleting IAM Policy",
-			"Could not delete IAM Policy: "+err.Error(),
-		)
+		detail := "Could not delete IAM Policy: " + helper.APIErrorDetail(err)
+		if !state.ForceDetach.ValueBool() {
+			detail += "\nIf the policy is still attached, set force_detach_on_destroy to true to detach it from all its users, groups and roles before deleting it."
+		}
+		resp.Diagnostics.AddError("Error deleting IAM Policy", detail)
 		return
 	}
 
 }
 
+// detachAndPruneVersions detaches the policy from every user, group and role,
+// then deletes its non-default versions, so that the policy itself can be deleted.
+func (r *IAMPolicyResource) detachAndPruneVersions(ctx context.Context, namespace, arn string) error {
+	if err := helper.DetachPolicyFromAllEntities(r.client, ctx, namespace, arn); err != nil {
+		return err
+	}
+	versions, err := helper.GetAllInstances(r.client.GenClient.IamApi.IamServiceListPolicyVersions(ctx).
+		PolicyArn(arn).
+		XEmcNamespace(namespace))
+	if err != nil {
+		return fmt.Errorf("failed to list versions of policy arn %s: %w", arn, err)
+	}
+	for _, v := range versions {
+		if v.IsDefaultVersion != nil && *v.IsDefaultVersion {
+			continue
+		}
+		_, _, err := r.client.GenClient.IamApi.IamServiceDeletePolicyVersion(ctx).
+			PolicyArn(arn).
+			VersionId(*v.VersionId).
+			XEmcNamespace(namespace).
+			Execute()
+		if err != nil {
+			return fmt.Errorf("failed to delete version %s of policy arn %s: %w", *v.VersionId, arn, err)
+		}
+	}
+	return nil
+}
+
 // Import state function.
 func (r *IAMPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.SplitN(req.ID, "#", 2)
@@ -16,13 +16,17 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
+	"terraform-provider-objectscale/internal/client"
 	"terraform-provider-objectscale/internal/clientgen"
+	"terraform-provider-objectscale/internal/testserver"
 	"testing"
 
 	"github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 // Test to Create and Update User Resource.
@@ -350,3 +354,121 @@ func TestAccIamPolicyResource(t *testing.T) {
 	})
 	apiMocker.UnPatch()
 }
+
+// Test to destroy a policy which is still attached.
+func TestAccIamPolicyResourceForceDetach(t *testing.T) {
+	defer testUserTokenCleanup(t)
+	var deleteM, detachM *mockey.Mocker
+	unPatchFunc := func() {
+		for _, m := range []*mockey.Mocker{deleteM, detachM} {
+			if m != nil {
+				m.UnPatch()
+			}
+		}
+	}
+
+	policyConfig := func(forceDetach bool) string {
+		return ProviderConfigForTesting + fmt.Sprintf(`
+		resource "objectscale_iam_policy" "testacc_policy" {
+			name                    = "testacc_policy_force_detach"
+			namespace               = "ns1"
+			force_detach_on_destroy = %t
+			policy_document = jsonencode({
+				Version = "2012-10-17"
+				Statement = [{
+					Action   = ["s3:ListBucket"]
+					Resource = "*"
+					Effect   = "Allow"
+				}]
+			})
+		}
+		`, forceDetach)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// create
+				Config: policyConfig(false),
+				Check:  resource.TestCheckResourceAttr("objectscale_iam_policy.testacc_policy", "force_detach_on_destroy", "false"),
+			},
+			{
+				// destroy error, the policy is attached
+				PreConfig: func() {
+					deleteM = mockey.Mock((*clientgen.IamApiService).IamServiceDeletePolicyExecute).
+						Return(nil, nil, fmt.Errorf("policy is attached")).Build()
+				},
+				Config:      ProviderConfigForTesting,
+				ExpectError: regexp.MustCompile(`set force_detach_on_destroy to true`),
+			},
+			{
+				// enable force detach, without a new policy version
+				PreConfig: unPatchFunc,
+				Config:    policyConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("objectscale_iam_policy.testacc_policy", "force_detach_on_destroy", "true"),
+					resource.TestCheckResourceAttr("objectscale_iam_policy.testacc_policy", "version_id", "v1"),
+				),
+			},
+			{
+				// destroy error, the policy cannot be detached
+				PreConfig: func() {
+					detachM = mockey.Mock((*IAMPolicyResource).detachAndPruneVersions).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting,
+				ExpectError: regexp.MustCompile(`Could not detach IAM Policy before deleting it: mock error`),
+			},
+			{
+				// destroy
+				PreConfig: unPatchFunc,
+				Config:    ProviderConfigForTesting,
+			},
+		},
+	})
+}
+
+func TestIamPolicyDetachAndPruneVersions(t *testing.T) {
+	s := testserver.New(map[string]string{"root": "password"})
+	t.Cleanup(s.Close)
+	c, err := client.NewClient(s.URL, "root", "password", true, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := context.Background()
+	api := c.GenClient.IamApi
+	doc := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`
+
+	created, _, err := api.IamServiceCreatePolicy(ctx).PolicyName("p1").PolicyDocument(doc).XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	arn := *created.CreatePolicyResult.Policy.Arn
+	_, _, err = api.IamServiceCreatePolicyVersion(ctx).PolicyArn(arn).PolicyDocument(doc).SetAsDefault(true).XEmcNamespace("ns1").Execute()
+	assert.NoError(t, err)
+	_, _, err = api.IamServiceAttachUserPolicy(ctx).UserName("user_001").PolicyArn(arn).XEmcNamespace("ns1").Execute()
+	assert.NoError(t, err)
+	_, _, err = api.IamServiceAttachGroupPolicy(ctx).GroupName("group_008").PolicyArn(arn).XEmcNamespace("ns1").Execute()
+	assert.NoError(t, err)
+	_, _, err = api.IamServiceAttachRolePolicy(ctx).RoleName("roleTest1").PolicyArn(arn).XEmcNamespace("ns1").Execute()
+	assert.NoError(t, err)
+
+	// an attached policy cannot be deleted
+	_, _, err = api.IamServiceDeletePolicy(ctx).PolicyArn(arn).XEmcNamespace("ns1").Execute()
+	assert.Error(t, err)
+
+	r := &IAMPolicyResource{resourceProviderConfig: resourceProviderConfig{client: c}}
+	assert.NoError(t, r.detachAndPruneVersions(ctx, "ns1", arn))
+
+	policy, _, err := api.IamServiceGetPolicy(ctx).PolicyArn(arn).XEmcNamespace("ns1").Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), *policy.GetPolicyResult.Policy.AttachmentCount)
+	versions, _, err := api.IamServiceListPolicyVersions(ctx).PolicyArn(arn).XEmcNamespace("ns1").Execute()
+	assert.NoError(t, err)
+	if assert.Len(t, versions.ListPolicyVersionsResult.Versions, 1) {
+		assert.Equal(t, "v2", *versions.ListPolicyVersionsResult.Versions[0].VersionId)
+	}
+	_, _, err = api.IamServiceDeletePolicy(ctx).PolicyArn(arn).XEmcNamespace("ns1").Execute()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,395 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-objectscale/internal/clientgen"
+	"terraform-provider-objectscale/internal/helper"
+	"terraform-provider-objectscale/internal/models"
+	"terraform-provider-objectscale/internal/policytypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IAMPolicySimulationDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &IAMPolicySimulationDataSource{}
+
+func NewIAMPolicySimulationDataSource() datasource.DataSource {
+	return &IAMPolicySimulationDataSource{}
+}
+
+// IAMPolicySimulationDataSource simulates the policies of an IAM principal, or custom policy documents.
+type IAMPolicySimulationDataSource struct {
+	datasourceProviderConfig
+}
+
+func (d *IAMPolicySimulationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_policy_simulation"
+}
+
+// context entries block schema.
+func (d *IAMPolicySimulationDataSource) contextSchema() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description:         "Values of the condition keys used when evaluating the conditions of the policies.",
+		MarkdownDescription: "Values of the condition keys used when evaluating the conditions of the policies.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"key": schema.StringAttribute{
+					Description:         "Condition key, e.g. aws:SourceIp.",
+					MarkdownDescription: "Condition key, e.g. `aws:SourceIp`.",
+					Required:            true,
+				},
+				"type": schema.StringAttribute{
+					Description:         "Type of the values, e.g. string, numeric, boolean, ip or date, or a list type like stringList.",
+					MarkdownDescription: "Type of the values, e.g. `string`, `numeric`, `boolean`, `ip` or `date`, or a list type like `stringList`.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(
+							"string", "stringList", "numeric", "numericList", "boolean", "booleanList",
+							"ip", "ipList", "binary", "binaryList", "date", "dateList",
+						),
+					},
+				},
+				"values": schema.ListAttribute{
+					Description:         "Values of the condition key.",
+					MarkdownDescription: "Values of the condition key.",
+					ElementType:         types.StringType,
+					Required:            true,
+				},
+			},
+		},
+	}
+}
+
+// evaluation results schema.
+func (d *IAMPolicySimulationDataSource) resultsSchema() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description:         "Decision of the simulation for each action and resource.",
+		MarkdownDescription: "Decision of the simulation for each action and resource.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"action_name": schema.StringAttribute{
+					Description:         "Name of the simulated action.",
+					MarkdownDescription: "Name of the simulated action.",
+					Computed:            true,
+				},
+				"resource_arn": schema.StringAttribute{
+					Description:         "ARN of the resource the action was simulated on, or * for all resources.",
+					MarkdownDescription: "ARN of the resource the action was simulated on, or `*` for all resources.",
+					Computed:            true,
+				},
+				"decision": schema.StringAttribute{
+					Description:         "Decision of the simulation: allowed, explicitDeny or implicitDeny.",
+					MarkdownDescription: "Decision of the simulation: `allowed`, `explicitDeny` or `implicitDeny`.",
+					Computed:            true,
+				},
+				"allowed": schema.BoolAttribute{
+					Description:         "Whether the action is allowed.",
+					MarkdownDescription: "Whether the action is allowed.",
+					Computed:            true,
+				},
+				"matched_statements": schema.ListNestedAttribute{
+					Description:         "Policies whose statements the decision is based on.",
+					MarkdownDescription: "Policies whose statements the decision is based on.",
+					Computed:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"source_policy_id": schema.StringAttribute{
+								Description:         "Identifier of the policy, e.g. the name of an inline or managed policy.",
+								MarkdownDescription: "Identifier of the policy, e.g. the name of an inline or managed policy.",
+								Computed:            true,
+							},
+							"source_policy_type": schema.StringAttribute{
+								Description:         "Type of the policy, e.g. user, group, role or user-managed.",
+								MarkdownDescription: "Type of the policy, e.g. `user`, `group`, `role` or `user-managed`.",
+								Computed:            true,
+							},
+						},
+					},
+				},
+				"missing_context_values": schema.ListAttribute{
+					Description:         "Condition keys used by the policies but missing from the context, whose statements were not evaluated.",
+					MarkdownDescription: "Condition keys used by the policies but missing from the `context`, whose statements were not evaluated.",
+					ElementType:         types.StringType,
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// Schema describes the data source arguments and results.
+func (d *IAMPolicySimulationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source simulates the IAM policies of a user, group or role, or custom policy documents, " +
+			"and returns whether the given actions are allowed on the given resources.",
+		MarkdownDescription: "This data source simulates the IAM policies of a user, group or role, or custom policy documents, " +
+			"and returns whether the given actions are allowed on the given resources.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"namespace": schema.StringAttribute{
+				Description:         "Name of the namespace.",
+				MarkdownDescription: "Name of the namespace.",
+				Required:            true,
+			},
+			"policy_source_arn": schema.StringAttribute{
+				Description:         "ARN of the user, group or role whose policies are simulated. The policies of a user include the ones of its groups. When not set, only the policy_documents are simulated.",
+				MarkdownDescription: "ARN of the user, group or role whose policies are simulated. The policies of a user include the ones of its groups. When not set, only the `policy_documents` are simulated.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"policy_documents": schema.ListAttribute{
+				Description:         "JSON policy documents to simulate, in addition to the policies of the policy_source_arn if set.",
+				MarkdownDescription: "JSON policy documents to simulate, in addition to the policies of the `policy_source_arn` if set.",
+				ElementType:         policytypes.DocumentType{},
+				Optional:            true,
+			},
+			"permissions_boundary_policy_documents": schema.ListAttribute{
+				Description:         "JSON policy documents used as the permissions boundary, instead of the permissions boundary of the policy_source_arn.",
+				MarkdownDescription: "JSON policy documents used as the permissions boundary, instead of the permissions boundary of the `policy_source_arn`.",
+				ElementType:         policytypes.DocumentType{},
+				Optional:            true,
+			},
+			"caller_arn": schema.StringAttribute{
+				Description:         "ARN of the user the actions are simulated for, used in the policy variables like ${aws:username}.",
+				MarkdownDescription: "ARN of the user the actions are simulated for, used in the policy variables like `${aws:username}`.",
+				Optional:            true,
+			},
+			"action_names": schema.ListAttribute{
+				Description:         "Actions to simulate, e.g. s3:GetObject.",
+				MarkdownDescription: "Actions to simulate, e.g. `s3:GetObject`.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"resource_arns": schema.ListAttribute{
+				Description:         "ARNs of the resources to simulate the actions on. Defaults to all resources.",
+				MarkdownDescription: "ARNs of the resources to simulate the actions on. Defaults to all resources.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"results": d.resultsSchema(),
+			"all_allowed": schema.BoolAttribute{
+				Description:         "Whether all the actions are allowed on all the resources.",
+				MarkdownDescription: "Whether all the actions are allowed on all the resources.",
+				Computed:            true,
+			},
+			"context_keys": schema.ListAttribute{
+				Description:         "Condition keys used by the simulated policies.",
+				MarkdownDescription: "Condition keys used by the simulated policies.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"context": d.contextSchema(),
+		},
+	}
+}
+
+func (d *IAMPolicySimulationDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		// simulate either the policies of a principal or custom policy documents
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("policy_source_arn"),
+			path.MatchRoot("policy_documents"),
+		),
+	}
+}
+
+func (d *IAMPolicySimulationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.IAMPolicySimulationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var policies, boundaries []string
+	resp.Diagnostics.Append(data.PolicyDocuments.ElementsAs(ctx, &policies, false)...)
+	resp.Diagnostics.Append(data.PermissionsBoundaryPolicyDocuments.ElementsAs(ctx, &boundaries, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := iamPolicySimulationInput{
+		namespace:  data.Namespace.ValueString(),
+		callerArn:  helper.ValueToPointer[string](data.CallerArn),
+		actions:    helper.ValueToList[string](data.ActionNames),
+		resources:  helper.ValueToList[string](data.ResourceArns),
+		policies:   policies,
+		boundaries: boundaries,
+		context: helper.SliceTransform(data.Context, func(v models.IAMPolicySimulationContextModel) clientgen.IamContextEntry {
+			return clientgen.IamContextEntry{
+				ContextKeyName:   v.Key.ValueStringPointer(),
+				ContextKeyType:   v.Type.ValueStringPointer(),
+				ContextKeyValues: helper.ValueToList[string](v.Values),
+			}
+		}),
+	}
+
+	var results []clientgen.IamEvaluationResult
+	var contextKeys []string
+	var err error
+	if arn := helper.ValueToPointer[string](data.PolicySourceArn); arn != nil {
+		results, err = d.simulatePrincipalPolicy(ctx, in, *arn)
+		if err != nil {
+			resp.Diagnostics.AddError("Error simulating the IAM policies of: "+*arn, helper.APIErrorDetail(err))
+			return
+		}
+		contextKeys, err = d.principalContextKeys(ctx, in, *arn)
+	} else {
+		results, err = d.simulateCustomPolicy(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError("Error simulating the IAM policy documents", helper.APIErrorDetail(err))
+			return
+		}
+		contextKeys, err = d.customContextKeys(ctx, in)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the context keys of the IAM policies", helper.APIErrorDetail(err))
+		return
+	}
+
+	data.ID = types.StringValue("iam_policy_simulation")
+	data.Results = d.updateState(results)
+	data.AllAllowed = types.BoolValue(len(data.Results) > 0)
+	for _, r := range data.Results {
+		if !r.Allowed.ValueBool() {
+			data.AllAllowed = types.BoolValue(false)
+		}
+	}
+	data.ContextKeys = helper.ListNotNull(contextKeys, types.StringValue)
+
+	tflog.Trace(ctx, "read iam_policy_simulation data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// iamPolicySimulationInput holds the parameters shared by the principal and custom policy simulations.
+type iamPolicySimulationInput struct {
+	namespace  string
+	callerArn  *string
+	actions    []string
+	resources  []string
+	policies   []string
+	boundaries []string
+	context    []clientgen.IamContextEntry
+}
+
+func (d *IAMPolicySimulationDataSource) simulatePrincipalPolicy(ctx context.Context, in iamPolicySimulationInput, arn string) ([]clientgen.IamEvaluationResult, error) {
+	simreq := d.client.GenClient.IamApi.IamServiceSimulatePrincipalPolicy(ctx).XEmcNamespace(in.namespace).
+		PolicySourceArn(arn).
+		ActionNamesMemberN(in.actions).
+		ResourceArnsMemberN(in.resources).
+		PolicyInputListMemberN(in.policies).
+		PermissionsBoundaryPolicyInputListMemberN(in.boundaries).
+		ContextEntriesMemberN(in.context)
+	if in.callerArn != nil {
+		simreq = simreq.CallerArn(*in.callerArn)
+	}
+	return helper.GetAllInstances(simreq)
+}
+
+func (d *IAMPolicySimulationDataSource) simulateCustomPolicy(ctx context.Context, in iamPolicySimulationInput) ([]clientgen.IamEvaluationResult, error) {
+	simreq := d.client.GenClient.IamApi.IamServiceSimulateCustomPolicy(ctx).XEmcNamespace(in.namespace).
+		ActionNamesMemberN(in.actions).
+		ResourceArnsMemberN(in.resources).
+		PolicyInputListMemberN(in.policies).
+		PermissionsBoundaryPolicyInputListMemberN(in.boundaries).
+		ContextEntriesMemberN(in.context)
+	if in.callerArn != nil {
+		simreq = simreq.CallerArn(*in.callerArn)
+	}
+	return helper.GetAllInstances(simreq)
+}
+
+func (d *IAMPolicySimulationDataSource) principalContextKeys(ctx context.Context, in iamPolicySimulationInput, arn string) ([]string, error) {
+	keysresp, _, err := d.client.GenClient.IamApi.IamServiceGetContextKeysForPrincipalPolicy(ctx).XEmcNamespace(in.namespace).
+		PolicySourceArn(arn).
+		PolicyInputListMemberN(in.policies).
+		Execute()
+	if err != nil || keysresp.GetContextKeysForPrincipalPolicyResult == nil {
+		return nil, err
+	}
+	return keysresp.GetContextKeysForPrincipalPolicyResult.ContextKeyNames, nil
+}
+
+func (d *IAMPolicySimulationDataSource) customContextKeys(ctx context.Context, in iamPolicySimulationInput) ([]string, error) {
+	keysresp, _, err := d.client.GenClient.IamApi.IamServiceGetContextKeysForCustomPolicy(ctx).XEmcNamespace(in.namespace).
+		PolicyInputListMemberN(in.policies).
+		Execute()
+	if err != nil || keysresp.GetContextKeysForCustomPolicyResult == nil {
+		return nil, err
+	}
+	return keysresp.GetContextKeysForCustomPolicyResult.ContextKeyNames, nil
+}
+
+// updateState returns one result per action and resource. The results of an action on several resources
+// may be returned as resource specific results of a single evaluation result.
+func (d *IAMPolicySimulationDataSource) updateState(results []clientgen.IamEvaluationResult) []models.IAMPolicySimulationResultModel {
+	out := []models.IAMPolicySimulationResultModel{}
+	for _, r := range results {
+		if len(r.ResourceSpecificResults) == 0 {
+			out = append(out, d.resultState(r.EvalActionName, r.EvalResourceName, r.EvalDecision, r.MatchedStatements, r.MissingContextValues))
+			continue
+		}
+		for _, rr := range r.ResourceSpecificResults {
+			out = append(out, d.resultState(r.EvalActionName, rr.EvalResourceName, rr.EvalResourceDecision, rr.MatchedStatements, rr.MissingContextValues))
+		}
+	}
+	return out
+}
+
+func (d *IAMPolicySimulationDataSource) resultState(action, resource, decision *string, matched []clientgen.IamStatement, missing []string) models.IAMPolicySimulationResultModel {
+	return models.IAMPolicySimulationResultModel{
+		ActionName:  helper.TfStringNN(action),
+		ResourceArn: helper.TfStringNN(resource),
+		Decision:    helper.TfStringNN(decision),
+		Allowed:     types.BoolValue(decision != nil && *decision == "allowed"),
+		MatchedStatements: helper.SliceTransform(matched, func(v clientgen.IamStatement) models.IAMPolicySimulationMatchedStatementModel {
+			return models.IAMPolicySimulationMatchedStatementModel{
+				SourcePolicyID:   helper.TfStringNN(v.SourcePolicyId),
+				SourcePolicyType: helper.TfStringNN(v.SourcePolicyType),
+			}
+		}),
+		MissingContextValues: helper.ListNotNull(missing, types.StringValue),
+	}
+}
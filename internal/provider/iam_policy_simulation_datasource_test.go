@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIAMPolicySimulationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// 1. Simulate the policies of a user, allowed by the inline policy of its group
+			{
+				Config: ProviderConfigForTesting + `
+					resource "objectscale_iam_policy" "deny_put" {
+						name            = "tfacc_simulation_deny_put"
+						namespace       = "ns1"
+						policy_document = jsonencode({
+							Version   = "2012-10-17"
+							Statement = [{ Effect = "Deny", Action = "s3:PutObject", Resource = "*" }]
+						})
+					}
+
+					resource "objectscale_iam_policy_attachment" "deny_put" {
+						namespace   = "ns1"
+						username    = "user_001"
+						policy_arns = [objectscale_iam_policy.deny_put.arn]
+					}
+
+					data "objectscale_iam_policy_simulation" "user" {
+						namespace         = "ns1"
+						policy_source_arn = "urn:ecs:iam::ns1:user/user_001"
+						action_names      = ["s3:ListBucket", "s3:PutObject", "s3:DeleteObject"]
+						depends_on        = [objectscale_iam_policy_attachment.deny_put]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.objectscale_iam_policy_simulation.user", "all_allowed", "false"),
+					resource.TestCheckResourceAttr("data.objectscale_iam_policy_simulation.user", "results.#", "3"),
+					resource.TestCheckResourceAttr("data.objectscale_iam_policy_simulation.user", "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr("data.objectscale_iam_policy_simulation.user", "results.0.allowed", "true"),
+					resource.TestCheckResourceAttr("data.objectscale_iam_policy_simulation.user", "results.0.matched_statements.0.source_policy_type", "group"),
+					resource.TestCheckResourceAttr("data.objectscale_iam_policy_simulation.user", "results.1.decision", "explicitDeny"),
+					resource.TestCheckResourceAttr("data.objectscale_iam_policy_simulation.user", "results.1.matched_statements.0.source_policy_id", "tfacc_simulation_deny_put"),
+					resource.TestCheckResourceAttr("data.objectscale_iam_policy_simulation.user", "results.2.decision", "implicitDeny"),
+				),
+			},
+			// 2. Simulate a custom policy document with a condition
+			{
+				Config: ProviderConfigForTesting + `
+					data "objectscale_iam_policy_simulation" "custom" {
+						namespace        = "ns1"
+						policy_documents = [jsonencode({
+							Version   = "2012-10-17"
+							Statement = [{
+								Effect    = "Allow"
+								Action    = "s3:*"
+								Resource  = "arn:aws:s3:::bucket1/*"
+								Condition = { IpAddress = { "aws:SourceIp" = "10.0.0.0/8" } }
+							}]
+						})]
+						action_names  = ["s3:GetObject"]
+						resource_arns = ["arn:aws:s3:::bucket1/key"]
+						context {
+							key    = "aws:SourceIp"
+							type   = "ip"
+							values = ["10.0.0.1"]
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.objectscale_iam_policy_simulation.custom", "all_allowed", "true"),
+					resource.TestCheckResourceAttr("data.objectscale_iam_policy_simulation.custom", "results.0.resource_arn", "arn:aws:s3:::bucket1/key"),
+					resource.TestCheckResourceAttr("data.objectscale_iam_policy_simulation.custom", "context_keys.#", "1"),
+					resource.TestCheckResourceAttr("data.objectscale_iam_policy_simulation.custom", "context_keys.0", "aws:SourceIp"),
+				),
+			},
+			// 3. Neither a principal nor policy documents
+			{
+				Config: ProviderConfigForTesting + `
+					data "objectscale_iam_policy_simulation" "invalid" {
+						namespace    = "ns1"
+						action_names = ["s3:GetObject"]
+					}
+				`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// 4. Unknown principal
+			{
+				Config: ProviderConfigForTesting + `
+					data "objectscale_iam_policy_simulation" "missing" {
+						namespace         = "ns1"
+						policy_source_arn = "urn:ecs:iam::ns1:user/tfacc_missing"
+						action_names      = ["s3:GetObject"]
+					}
+				`,
+				ExpectError: regexp.MustCompile("Error simulating the IAM policies"),
+			},
+		},
+	})
+}
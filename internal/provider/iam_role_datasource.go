@@ -7,6 +7,7 @@ import (
 	"terraform-provider-objectscale/internal/clientgen"
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
+	"terraform-provider-objectscale/internal/policytypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -107,6 +108,7 @@ func (d *IAMRoleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 
 						"assume_role_policy": schema.StringAttribute{
 							Computed:            true,
+							CustomType:          policytypes.DocumentType{},
 							Description:         "The trust policy document defining who can assume the role.",
 							MarkdownDescription: "The trust policy document defining who can assume the role.",
 						},
@@ -179,7 +181,7 @@ func (d *IAMRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error retrieving IAM role",
-				fmt.Sprintf("Failed retrieving role %s: %s", roleName, err.Error()),
+				fmt.Sprintf("Failed retrieving role %s: %s", roleName, helper.APIErrorDetail(err)),
 			)
 			return
 		}
@@ -190,7 +192,7 @@ func (d *IAMRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		allRoles, err := d.listAllRoles(ctx, ns)
 		if err != nil {
 			resp.Diagnostics.AddError(
-				"Error listing IAM roles", err.Error(),
+				"Error listing IAM roles", helper.APIErrorDetail(err),
 			)
 			return
 		}
@@ -232,7 +234,7 @@ func (d *IAMRoleDataSource) getRole(ctx context.Context, namespace, roleName str
 		RoleId:              helper.TfString(r.RoleId),
 		RoleName:            helper.TfString(r.RoleName),
 		Arn:                 helper.TfString(r.Arn),
-		AssumeRolePolicy:    helper.TfString(r.AssumeRolePolicyDocument),
+		AssumeRolePolicy:    policytypes.NewDocumentFromAPI(r.AssumeRolePolicyDocument),
 		Path:                helper.TfString(r.Path),
 		Description:         helper.TfString(r.Description),
 		CreateDate:          helper.TfString(r.CreateDate),
@@ -271,7 +273,7 @@ func (d *IAMRoleDataSource) listAllRoles(ctx context.Context, namespace string)
 			RoleId:              helper.TfString(r.RoleId),
 			RoleName:            helper.TfString(r.RoleName),
 			Arn:                 helper.TfString(r.Arn),
-			AssumeRolePolicy:    helper.TfString(r.AssumeRolePolicyDocument),
+			AssumeRolePolicy:    policytypes.NewDocumentFromAPI(r.AssumeRolePolicyDocument),
 			Path:                helper.TfString(r.Path),
 			Description:         helper.TfString(r.Description),
 			CreateDate:          helper.TfString(r.CreateDate),
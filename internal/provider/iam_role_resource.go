@@ -24,8 +24,10 @@ import (
 	"terraform-provider-objectscale/internal/clientgen"
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
+	"terraform-provider-objectscale/internal/policytypes"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -72,7 +74,7 @@ func (r *IAMRoleResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Description:         "The trust relationship policy document that grants an entity permission to assume the role.",
 				MarkdownDescription: "The trust relationship policy document that grants an entity permission to assume the role.",
 				Required:            true,
-				CustomType:          jsontypes.NormalizedType{},
+				CustomType:          policytypes.DocumentType{},
 			},
 
 			"max_session_duration": schema.Int32Attribute{
@@ -131,6 +133,9 @@ func (r *IAMRoleResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -151,6 +156,12 @@ func (r *IAMRoleResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	creq := r.client.GenClient.IamApi.IamServiceCreateRole(ctx).
 		RoleName(plan.Name.ValueString()).
 		XEmcNamespace(plan.Namespace.ValueString()).
@@ -170,7 +181,7 @@ func (r *IAMRoleResource) Create(ctx context.Context, req resource.CreateRequest
 
 	_, _, err := creq.Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Role", err.Error())
+		resp.Diagnostics.AddError("Error creating Role", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -180,7 +191,7 @@ func (r *IAMRoleResource) Create(ctx context.Context, req resource.CreateRequest
 		Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading role", err.Error())
+		resp.Diagnostics.AddError("Error reading role", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -194,6 +205,7 @@ func (r *IAMRoleResource) Create(ctx context.Context, req resource.CreateRequest
 	}, plan.Namespace)
 
 	// Save data into Terraform state
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -207,13 +219,22 @@ func (r *IAMRoleResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	iam_role, _, err := r.client.GenClient.IamApi.IamServiceGetRole(ctx).
 		RoleName(state.Name.ValueString()).
 		XEmcNamespace(state.Namespace.ValueString()).
 		Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading role", err.Error())
+		if helper.RemoveNotFound(ctx, err, &resp.State, "role "+state.Name.ValueString()) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading role", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -227,6 +248,7 @@ func (r *IAMRoleResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}, state.Namespace)
 
 	// Save data into Terraform state
+	data.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -245,14 +267,7 @@ func (r *IAMRoleResource) getModel(
 		permissionsBoundaryType = types.StringValue("")
 	}
 
-	var assumerolepolicyDocument jsontypes.Normalized
-
-	if iam_role.AssumeRolePolicyDocument != nil {
-		assumerolepolicyDocument =
-			jsontypes.NewNormalizedValue(*iam_role.AssumeRolePolicyDocument)
-	} else {
-		assumerolepolicyDocument = jsontypes.NewNormalizedNull()
-	}
+	assumerolepolicyDocument := policytypes.NewDocumentFromAPI(iam_role.AssumeRolePolicyDocument)
 
 	return models.IAMRoleResourceModel{
 
@@ -284,6 +299,12 @@ func (r *IAMRoleResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if helper.IsChangedNN(plan.MaxSessionDuration, state.MaxSessionDuration) || helper.IsChangedNN(plan.Description, state.Description) {
 
 		updReq := r.client.GenClient.IamApi.IamServiceUpdateRole(ctx).
@@ -299,7 +320,7 @@ func (r *IAMRoleResource) Update(ctx context.Context, req resource.UpdateRequest
 
 		_, _, err := updReq.Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating Role", err.Error())
+			resp.Diagnostics.AddError("Error updating Role", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -351,7 +372,7 @@ func (r *IAMRoleResource) Update(ctx context.Context, req resource.UpdateRequest
 					XEmcNamespace(plan.Namespace.ValueString()).
 					Execute()
 				if err != nil {
-					resp.Diagnostics.AddError("Error deleting permission boundary", err.Error())
+					resp.Diagnostics.AddError("Error deleting permission boundary", helper.APIErrorDetail(err))
 					return
 				}
 			} else {
@@ -361,7 +382,7 @@ func (r *IAMRoleResource) Update(ctx context.Context, req resource.UpdateRequest
 					PermissionsBoundary(plan.PermissionsBoundaryArn.ValueString()).
 					Execute()
 				if err != nil {
-					resp.Diagnostics.AddError("Error updating permission boundary", err.Error())
+					resp.Diagnostics.AddError("Error updating permission boundary", helper.APIErrorDetail(err))
 					return
 				}
 			}
@@ -376,7 +397,7 @@ func (r *IAMRoleResource) Update(ctx context.Context, req resource.UpdateRequest
 			PolicyDocument(plan.AssumeRolePolicyDocument.ValueString()).
 			Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating role policy", err.Error())
+			resp.Diagnostics.AddError("Error updating role policy", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -387,7 +408,7 @@ func (r *IAMRoleResource) Update(ctx context.Context, req resource.UpdateRequest
 		Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading role", err.Error())
+		resp.Diagnostics.AddError("Error reading role", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -401,6 +422,7 @@ func (r *IAMRoleResource) Update(ctx context.Context, req resource.UpdateRequest
 	}, plan.Namespace)
 
 	// Save data into Terraform state
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -415,12 +437,18 @@ func (r *IAMRoleResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, _, err := r.client.GenClient.IamApi.IamServiceDeleteRole(ctx).RoleName(state.Name.ValueString()).XEmcNamespace(state.Namespace.ValueString()).Execute()
 
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting IAM Role",
-			err.Error(),
+			helper.APIErrorDetail(err),
 		)
 	}
 }
@@ -440,7 +468,7 @@ func (r *IAMRoleResource) ImportState(ctx context.Context, req resource.ImportSt
 		Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading role", err.Error())
+		resp.Diagnostics.AddError("Error reading role", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -453,6 +481,7 @@ func (r *IAMRoleResource) ImportState(ctx context.Context, req resource.ImportSt
 		Tags:                     iam_role.GetRoleResult.Role.Tags,
 	}, types.StringValue(namespace))
 
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -82,12 +82,12 @@ func (d *IAMSAMLProviderDataSource) Read(ctx context.Context, req datasource.Rea
 		arn := state.SAMLProviderArn.ValueString()
 		parsed, err := helper.ParseSAMLProviderARN(arn)
 		if err != nil {
-			resp.Diagnostics.AddError("Invalid saml_provider_arn", err.Error())
+			resp.Diagnostics.AddError("Invalid saml_provider_arn", helper.APIErrorDetail(err))
 			return
 		}
 		getRes, _, err := d.client.GenClient.IamApi.IamServiceGetSAMLProvider(ctx).SAMLProviderArn(arn).XEmcNamespace(state.Namespace.ValueString()).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error getting SAML provider by ARN", classifyDiag(err).Error())
+			resp.Diagnostics.AddError("Error getting SAML provider by ARN", helper.APIErrorDetail(err))
 			return
 		}
 		state.ID = state.SAMLProviderArn
@@ -105,7 +105,7 @@ func (d *IAMSAMLProviderDataSource) Read(ctx context.Context, req datasource.Rea
 		req := d.client.GenClient.IamApi.IamServiceListSAMLProviders(ctx).XEmcNamespace(state.Namespace.ValueString())
 		allProviders, err := helper.GetAllInstances(req)
 		if err != nil {
-			resp.Diagnostics.AddError("Error listing SAML providers", classifyDiag(err).Error())
+			resp.Diagnostics.AddError("Error listing SAML providers", helper.APIErrorDetail(err))
 			return
 		}
 
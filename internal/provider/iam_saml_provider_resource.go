@@ -18,12 +18,13 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
+	"terraform-provider-objectscale/internal/client"
 	"terraform-provider-objectscale/internal/clientgen"
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -54,7 +55,7 @@ func (r *IAMSAMLProviderResource) Metadata(_ context.Context, req resource.Metad
 }
 
 // Schema returns the resource schema.
-func (r *IAMSAMLProviderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IAMSAMLProviderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description:         "Manages an ObjectScale IAM SAML Identity Provider (external IdP) registration.",
 		MarkdownDescription: "Manages an ObjectScale IAM SAML Identity Provider (external IdP) registration.",
@@ -99,6 +100,9 @@ func (r *IAMSAMLProviderResource) Schema(_ context.Context, _ resource.SchemaReq
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -110,6 +114,12 @@ func (r *IAMSAMLProviderResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	name := plan.Name.ValueString()
 	metadata := plan.SAMLMetadataDocument.ValueString()
 	namespace := plan.Namespace.ValueString()
@@ -117,18 +127,19 @@ func (r *IAMSAMLProviderResource) Create(ctx context.Context, req resource.Creat
 	tflog.Debug(ctx, "creating SAML IdP", map[string]interface{}{"name": name, "namespace": namespace})
 	createRes, _, err := r.client.GenClient.IamApi.IamServiceCreateSAMLProvider(ctx).Name(name).SAMLMetadataDocument(metadata).XEmcNamespace(namespace).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("CreateSAMLProvider failed", classifyDiag(err).Error())
+		resp.Diagnostics.AddError("CreateSAMLProvider failed", helper.APIErrorDetail(err))
 		return
 	}
 
 	getRes, _, err := r.client.GenClient.IamApi.IamServiceGetSAMLProvider(ctx).SAMLProviderArn(*createRes.CreateSAMLProviderResult.SAMLProviderArn).XEmcNamespace(namespace).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("GetSAMLProvider after create failed", classifyDiag(err).Error())
+		resp.Diagnostics.AddError("GetSAMLProvider after create failed", helper.APIErrorDetail(err))
 		return
 	}
 
 	arn := helper.TfStringNN(createRes.CreateSAMLProviderResult.SAMLProviderArn)
 	data := r.getModel(getRes, arn)
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -140,17 +151,24 @@ func (r *IAMSAMLProviderResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	getRes, _, err := r.client.GenClient.IamApi.IamServiceGetSAMLProvider(ctx).SAMLProviderArn(state.Arn.ValueString()).XEmcNamespace(state.Namespace.ValueString()).Execute()
 	if err != nil {
-		if helper.IsSAMLNotFound(err) {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("GetSAMLProvider failed", classifyDiag(err).Error())
+		resp.Diagnostics.AddError("GetSAMLProvider failed", helper.APIErrorDetail(err))
 		return
 	}
 
 	data := r.getModel(getRes, state.Arn)
+	data.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -163,23 +181,30 @@ func (r *IAMSAMLProviderResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	arn := state.Arn.ValueString()
 	metadata := plan.SAMLMetadataDocument.ValueString()
 	namespace := state.Namespace.ValueString()
 
 	_, _, err := r.client.GenClient.IamApi.IamServiceUpdateSAMLProvider(ctx).SAMLProviderArn(arn).SAMLMetadataDocument(metadata).XEmcNamespace(namespace).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("UpdateSAMLProvider failed", classifyDiag(err).Error())
+		resp.Diagnostics.AddError("UpdateSAMLProvider failed", helper.APIErrorDetail(err))
 		return
 	}
 
 	getRes, _, err := r.client.GenClient.IamApi.IamServiceGetSAMLProvider(ctx).SAMLProviderArn(arn).XEmcNamespace(namespace).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("GetSAMLProvider after update failed", classifyDiag(err).Error())
+		resp.Diagnostics.AddError("GetSAMLProvider after update failed", helper.APIErrorDetail(err))
 		return
 	}
 
 	data := r.getModel(getRes, state.Arn)
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -190,9 +215,15 @@ func (r *IAMSAMLProviderResource) Delete(ctx context.Context, req resource.Delet
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	_, _, err := r.client.GenClient.IamApi.IamServiceDeleteSAMLProvider(ctx).SAMLProviderArn(state.Arn.ValueString()).XEmcNamespace(state.Namespace.ValueString()).Execute()
-	if err != nil && !helper.IsSAMLNotFound(err) {
-		resp.Diagnostics.AddError("DeleteSAMLProvider failed", classifyDiag(err).Error())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("DeleteSAMLProvider failed", helper.APIErrorDetail(err))
 		return
 	}
 }
@@ -201,7 +232,7 @@ func (r *IAMSAMLProviderResource) Delete(ctx context.Context, req resource.Delet
 func (r *IAMSAMLProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parsed, err := helper.ParseSAMLProviderARN(req.ID)
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		resp.Diagnostics.AddError("Invalid import ID", helper.APIErrorDetail(err))
 		return
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
@@ -212,24 +243,6 @@ func (r *IAMSAMLProviderResource) ImportState(ctx context.Context, req resource.
 	}
 }
 
-// classifyDiag wraps an error in a more helpful diagnostic message.
-func classifyDiag(err error) error {
-	switch helper.ClassifyError(err) {
-	case helper.SAMLErrBadRequest:
-		return fmt.Errorf("validation error from ObjectScale: %w", err)
-	case helper.SAMLErrUnauthorized:
-		return fmt.Errorf("invalid or expired auth token: %w", err)
-	case helper.SAMLErrForbidden:
-		return fmt.Errorf("insufficient permissions: %w", err)
-	case helper.SAMLErrNotFound:
-		return fmt.Errorf("resource not found: %w", err)
-	case helper.SAMLErrConflict:
-		return fmt.Errorf("provider already exists: %w", err)
-	default:
-		return err
-	}
-}
-
 func (r *IAMSAMLProviderResource) getModel(
 	getRes *clientgen.IamServiceGetSAMLProviderResponse,
 	arn types.String) models.IAMSAMLProviderResourceModel {
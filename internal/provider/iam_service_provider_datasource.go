@@ -65,7 +65,7 @@ func (d *IAMServiceProviderDataSource) Schema(_ context.Context, _ datasource.Sc
 func (d *IAMServiceProviderDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	getRes, _, err := d.client.GenClient.IamProviderApi.ServiceProviderGet(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("GetServiceProvider failed", classifyDiag(err).Error())
+		resp.Diagnostics.AddError("GetServiceProvider failed", helper.APIErrorDetail(err))
 		return
 	}
 	sp := getRes.GetServiceProviderResult.ServiceProvider
@@ -68,12 +68,12 @@ func (d *IAMServiceProviderMetadataDataSource) Schema(_ context.Context, _ datas
 func (d *IAMServiceProviderMetadataDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	rawXML, _, err := d.client.GenClient.IamProviderApi.ServiceProviderGetMetadata(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("GetServiceProviderMetadata failed", classifyDiag(err).Error())
+		resp.Diagnostics.AddError("GetServiceProviderMetadata failed", helper.APIErrorDetail(err))
 		return
 	}
 	parsed, err := helper.ParseSPMetadata(rawXML)
 	if err != nil {
-		resp.Diagnostics.AddError("Parse SP metadata failed", err.Error())
+		resp.Diagnostics.AddError("Parse SP metadata failed", helper.APIErrorDetail(err))
 		return
 	}
 	values := make([]attr.Value, 0, len(parsed.NameIDFormats))
@@ -19,15 +19,19 @@ package provider
 import (
 	"context"
 
+	"terraform-provider-objectscale/internal/client"
 	"terraform-provider-objectscale/internal/clientgen"
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -49,7 +53,7 @@ func (r *IAMServiceProviderResource) Metadata(_ context.Context, req resource.Me
 	resp.TypeName = req.ProviderTypeName + "_iam_service_provider"
 }
 
-func (r *IAMServiceProviderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IAMServiceProviderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description:         "Manages the ObjectScale SAML Service Provider configuration (singleton per cluster).",
 		MarkdownDescription: "Manages the ObjectScale SAML Service Provider configuration (singleton per cluster).",
@@ -73,16 +77,25 @@ func (r *IAMServiceProviderResource) Schema(_ context.Context, _ resource.Schema
 				Description: "KeyStore entry alias.",
 			},
 			"key_password": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				Description: "KeyStore password.",
+				Description: "KeyStore password. Exactly one of `key_password` and `key_password_wo` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("key_password_wo")),
+				},
 			},
+			"key_password_wo_version": helper.WriteOnlyVersionAttribute("key_password"),
+			"key_password_wo": helper.WriteOnlyAttribute("key_password",
+				"KeyStore password.", true),
 			"uuid":          schema.StringAttribute{Computed: true, Description: "Entity Id component."},
 			"unique_id":     schema.StringAttribute{Computed: true, Description: "KeyStore unique ID."},
 			"etag":          schema.StringAttribute{Computed: true, Description: "Optimistic concurrency tag."},
 			"create_time":   schema.StringAttribute{Computed: true, Description: "ISO 8601 creation timestamp."},
 			"last_modified": schema.StringAttribute{Computed: true, Description: "ISO 8601 last-modified timestamp."},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -92,33 +105,45 @@ func (r *IAMServiceProviderResource) Create(ctx context.Context, req resource.Cr
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	if err := helper.ValidateSPDNS(plan.DNS.ValueString()); err != nil {
 		resp.Diagnostics.AddAttributeError(path.Root("dns"), "Invalid SP DNS", err.Error())
 		return
 	}
+	keyPassword, diags := helper.SecretValue(ctx, plan.KeyPassword, req.Config, "key_password")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Debug(ctx, "creating SP config", map[string]interface{}{"dns": plan.DNS.ValueString()})
-	body := r.buildRequestBody(&plan)
+	body := r.buildRequestBody(&plan, keyPassword.ValueString())
 	_, _, err := r.client.GenClient.IamProviderApi.ServiceProviderCreate(ctx).IamServiceProviderControllerProcessCreateServiceProviderRequest(body).Execute()
 	if err != nil {
 		// Singleton: if it already exists, update in place.
-		if helper.ClassifyError(err) == helper.SAMLErrConflict {
+		if client.KindOf(err) == client.ErrorConflict {
 			tflog.Info(ctx, "SP already exists, updating in place")
-			updateBody := r.buildUpdateBody(&plan)
+			updateBody := r.buildUpdateBody(&plan, keyPassword.ValueString())
 			if _, _, uErr := r.client.GenClient.IamProviderApi.ServiceProviderUpdate(ctx).IamServiceProviderControllerProcessUpdateServiceProviderRequest(updateBody).Execute(); uErr != nil {
-				resp.Diagnostics.AddError("UpdateServiceProvider (upsert) failed", classifyDiag(uErr).Error())
+				resp.Diagnostics.AddError("UpdateServiceProvider (upsert) failed", helper.APIErrorDetail(uErr))
 				return
 			}
 		} else {
-			resp.Diagnostics.AddError("CreateServiceProvider failed", classifyDiag(err).Error())
+			resp.Diagnostics.AddError("CreateServiceProvider failed", helper.APIErrorDetail(err))
 			return
 		}
 	}
 	getRes, _, err := r.client.GenClient.IamProviderApi.ServiceProviderGet(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("GetServiceProvider after create failed", classifyDiag(err).Error())
+		resp.Diagnostics.AddError("GetServiceProvider after create failed", helper.APIErrorDetail(err))
 		return
 	}
 	data := r.getModel(getRes, plan)
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -128,16 +153,23 @@ func (r *IAMServiceProviderResource) Read(ctx context.Context, req resource.Read
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	getRes, _, err := r.client.GenClient.IamProviderApi.ServiceProviderGet(ctx).Execute()
 	if err != nil {
-		if helper.IsSAMLNotFound(err) {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("GetServiceProvider failed", classifyDiag(err).Error())
+		resp.Diagnostics.AddError("GetServiceProvider failed", helper.APIErrorDetail(err))
 		return
 	}
 	data := r.getModel(getRes, state)
+	data.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -147,23 +179,47 @@ func (r *IAMServiceProviderResource) Update(ctx context.Context, req resource.Up
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	body := r.buildUpdateBody(&plan)
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// the update replaces the whole configuration, so the write-only password is sent on every update
+	keyPassword, diags := helper.SecretValue(ctx, plan.KeyPassword, req.Config, "key_password")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	body := r.buildUpdateBody(&plan, keyPassword.ValueString())
 	if _, _, err := r.client.GenClient.IamProviderApi.ServiceProviderUpdate(ctx).IamServiceProviderControllerProcessUpdateServiceProviderRequest(body).Execute(); err != nil {
-		resp.Diagnostics.AddError("UpdateServiceProvider failed", classifyDiag(err).Error())
+		resp.Diagnostics.AddError("UpdateServiceProvider failed", helper.APIErrorDetail(err))
 		return
 	}
 	getRes, _, err := r.client.GenClient.IamProviderApi.ServiceProviderGet(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("GetServiceProvider after update failed", classifyDiag(err).Error())
+		resp.Diagnostics.AddError("GetServiceProvider after update failed", helper.APIErrorDetail(err))
 		return
 	}
 	data := r.getModel(getRes, plan)
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *IAMServiceProviderResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
-	if _, _, err := r.client.GenClient.IamProviderApi.ServiceProviderDelete(ctx).Execute(); err != nil && !helper.IsSAMLNotFound(err) {
-		resp.Diagnostics.AddError("DeleteServiceProvider failed", classifyDiag(err).Error())
+func (r *IAMServiceProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.IAMServiceProviderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if _, _, err := r.client.GenClient.IamProviderApi.ServiceProviderDelete(ctx).Execute(); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("DeleteServiceProvider failed", helper.APIErrorDetail(err))
 		return
 	}
 }
@@ -189,21 +245,23 @@ func (r *IAMServiceProviderResource) getModel(
 		KeyAlias:     helper.TfStringNN(sp.KeyAlias),
 		JavaKeystore: prior.JavaKeystore,
 		KeyPassword:  prior.KeyPassword,
+
+		KeyPasswordWOVersion: prior.KeyPasswordWOVersion,
 	}
 	if sp.JavaKeystore != nil && *sp.JavaKeystore != "" {
 		m.JavaKeystore = helper.TfStringNN(sp.JavaKeystore)
 	}
-	if sp.KeyPassword != nil && *sp.KeyPassword != "" {
+	// the password set by key_password_wo must not land in the state
+	if sp.KeyPassword != nil && *sp.KeyPassword != "" && prior.KeyPasswordWOVersion.IsNull() {
 		m.KeyPassword = helper.TfStringNN(sp.KeyPassword)
 	}
 	return m
 }
 
-func (r *IAMServiceProviderResource) buildRequestBody(plan *models.IAMServiceProviderResourceModel) clientgen.IamServiceProviderControllerProcessCreateServiceProviderRequest {
+func (r *IAMServiceProviderResource) buildRequestBody(plan *models.IAMServiceProviderResourceModel, pwd string) clientgen.IamServiceProviderControllerProcessCreateServiceProviderRequest {
 	dns := plan.DNS.ValueString()
 	jks := plan.JavaKeystore.ValueString()
 	alias := plan.KeyAlias.ValueString()
-	pwd := plan.KeyPassword.ValueString()
 	return clientgen.IamServiceProviderControllerProcessCreateServiceProviderRequest{
 		ServiceProvider: &clientgen.ServiceProvider{
 			Dns:          &dns,
@@ -214,11 +272,10 @@ func (r *IAMServiceProviderResource) buildRequestBody(plan *models.IAMServicePro
 	}
 }
 
-func (r *IAMServiceProviderResource) buildUpdateBody(plan *models.IAMServiceProviderResourceModel) clientgen.IamServiceProviderControllerProcessUpdateServiceProviderRequest {
+func (r *IAMServiceProviderResource) buildUpdateBody(plan *models.IAMServiceProviderResourceModel, pwd string) clientgen.IamServiceProviderControllerProcessUpdateServiceProviderRequest {
 	dns := plan.DNS.ValueString()
 	jks := plan.JavaKeystore.ValueString()
 	alias := plan.KeyAlias.ValueString()
-	pwd := plan.KeyPassword.ValueString()
 	return clientgen.IamServiceProviderControllerProcessUpdateServiceProviderRequest{
 		ServiceProvider: &clientgen.ServiceProvider{
 			Dns:          &dns,
@@ -24,6 +24,8 @@ import (
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
@@ -100,6 +102,9 @@ func (r *IAMUserAccessKeyResource) Schema(ctx context.Context, req resource.Sche
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -114,12 +119,18 @@ func (r *IAMUserAccessKeyResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	create_access_key, _, err := r.client.GenClient.IamApi.IamServiceCreateAccessKey(ctx).
 		UserName(plan.UserName.ValueString()).
 		XEmcNamespace(plan.Namespace.ValueString()).
 		Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating acess key for the user", err.Error())
+		resp.Diagnostics.AddError("Error creating acess key for the user", helper.APIErrorDetail(err))
 		return
 	}
 	// ---- fetch access keys ----
@@ -130,7 +141,7 @@ func (r *IAMUserAccessKeyResource) Create(ctx context.Context, req resource.Crea
 		Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading user access key", err.Error())
+		resp.Diagnostics.AddError("Error reading user access key", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -152,6 +163,7 @@ func (r *IAMUserAccessKeyResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Save data into Terraform state
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -166,6 +178,12 @@ func (r *IAMUserAccessKeyResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	kResp, _, err := r.client.GenClient.IamApi.
 		IamServiceListAccessKeys(ctx).
 		UserName(state.UserName.ValueString()).
@@ -173,7 +191,10 @@ func (r *IAMUserAccessKeyResource) Read(ctx context.Context, req resource.ReadRe
 		Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading user access key", err.Error())
+		if helper.RemoveNotFound(ctx, err, &resp.State, "access key "+state.Id.ValueString()) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading user access key", helper.APIErrorDetail(err))
 		return
 	}
 	var data models.IAMUserAccessKeyResourceModel
@@ -192,7 +213,13 @@ func (r *IAMUserAccessKeyResource) Read(ctx context.Context, req resource.ReadRe
 			}
 		}
 	}
+	if data.Id.IsNull() {
+		// the key was deleted outside of Terraform
+		helper.RemoveMissing(ctx, &resp.State, "access key "+state.Id.ValueString())
+		return
+	}
 	// Save updated plan into Terraform state
+	data.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -208,6 +235,12 @@ func (r *IAMUserAccessKeyResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if helper.IsChangedNN(plan.Status, state.Status) {
 		_, _, err := r.client.GenClient.IamApi.IamServiceUpdateAccessKey(ctx).
 			AccessKeyId(state.Id.ValueString()).
@@ -217,7 +250,7 @@ func (r *IAMUserAccessKeyResource) Update(ctx context.Context, req resource.Upda
 			Execute()
 
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating access key status", err.Error())
+			resp.Diagnostics.AddError("Error updating access key status", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -229,7 +262,7 @@ func (r *IAMUserAccessKeyResource) Update(ctx context.Context, req resource.Upda
 		Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading user access key", err.Error())
+		resp.Diagnostics.AddError("Error reading user access key", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -250,6 +283,7 @@ func (r *IAMUserAccessKeyResource) Update(ctx context.Context, req resource.Upda
 		}
 	}
 	// Save updated plan into Terraform state
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 }
@@ -265,6 +299,12 @@ func (r *IAMUserAccessKeyResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, _, err := r.client.GenClient.IamApi.IamServiceDeleteAccessKey(ctx).
 		AccessKeyId(state.Id.ValueString()).
 		UserName(state.UserName.ValueString()).
@@ -274,7 +314,7 @@ func (r *IAMUserAccessKeyResource) Delete(ctx context.Context, req resource.Dele
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting IAM user access key",
-			err.Error(),
+			helper.APIErrorDetail(err),
 		)
 	}
 }
@@ -299,7 +339,7 @@ func (r *IAMUserAccessKeyResource) ImportState(ctx context.Context, req resource
 		Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading user access key", err.Error())
+		resp.Diagnostics.AddError("Error reading user access key", helper.APIErrorDetail(err))
 		return
 	}
 	var data models.IAMUserAccessKeyResourceModel
@@ -318,6 +358,7 @@ func (r *IAMUserAccessKeyResource) ImportState(ctx context.Context, req resource
 			}
 		}
 	}
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
 	// Save updated plan into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
@@ -24,6 +24,8 @@ import (
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
@@ -119,6 +121,9 @@ func (r *IAMUserResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -140,6 +145,12 @@ func (r *IAMUserResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	creq := r.client.GenClient.IamApi.IamServiceCreateUser(ctx).
 		UserName(plan.Name.ValueString()).
 		XEmcNamespace(plan.Namespace.ValueString())
@@ -154,7 +165,7 @@ func (r *IAMUserResource) Create(ctx context.Context, req resource.CreateRequest
 
 	_, _, err := creq.Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating user", err.Error())
+		resp.Diagnostics.AddError("Error creating user", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -173,11 +184,12 @@ func (r *IAMUserResource) Create(ctx context.Context, req resource.CreateRequest
 	}, plan.Namespace)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading user", err.Error())
+		resp.Diagnostics.AddError("Error reading user", helper.APIErrorDetail(err))
 		return
 	}
 
 	// Save data into Terraform state
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -192,13 +204,22 @@ func (r *IAMUserResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	iam_user, _, err := r.client.GenClient.IamApi.IamServiceGetUser(ctx).
 		UserName(state.Name.ValueString()).
 		XEmcNamespace(state.Namespace.ValueString()).
 		Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading user", err.Error())
+		if helper.RemoveNotFound(ctx, err, &resp.State, "user "+state.Name.ValueString()) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading user", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -212,6 +233,7 @@ func (r *IAMUserResource) Read(ctx context.Context, req resource.ReadRequest, re
 		Tags:                iam_user.GetUserResult.User.Tags,
 	}, state.Namespace)
 	// Save updated plan into Terraform state
+	data.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -281,6 +303,12 @@ func (r *IAMUserResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Prevent non-updatable fields from being changed
 	if !plan.Name.Equal(state.Name) {
 		resp.Diagnostics.AddError("Error updating user", "Name is not updatable")
@@ -333,7 +361,7 @@ func (r *IAMUserResource) Update(ctx context.Context, req resource.UpdateRequest
 				XEmcNamespace(plan.Namespace.ValueString()).
 				Execute()
 			if err != nil {
-				resp.Diagnostics.AddError("Error deleting permission boundary", err.Error())
+				resp.Diagnostics.AddError("Error deleting permission boundary", helper.APIErrorDetail(err))
 				return
 			}
 		} else {
@@ -343,7 +371,7 @@ func (r *IAMUserResource) Update(ctx context.Context, req resource.UpdateRequest
 				PermissionsBoundary(plan.PermissionsBoundaryArn.ValueString()).
 				Execute()
 			if err != nil {
-				resp.Diagnostics.AddError("Error updating permission boundary", err.Error())
+				resp.Diagnostics.AddError("Error updating permission boundary", helper.APIErrorDetail(err))
 				return
 			}
 		}
@@ -354,7 +382,7 @@ func (r *IAMUserResource) Update(ctx context.Context, req resource.UpdateRequest
 		XEmcNamespace(state.Namespace.ValueString()).
 		Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading user", err.Error())
+		resp.Diagnostics.AddError("Error reading user", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -368,6 +396,7 @@ func (r *IAMUserResource) Update(ctx context.Context, req resource.UpdateRequest
 		Tags:                iam_user.GetUserResult.User.Tags,
 	}, state.Namespace)
 
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -382,6 +411,12 @@ func (r *IAMUserResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, _, err := r.client.GenClient.IamApi.IamServiceDeleteUser(ctx).
 		UserName(state.Name.ValueString()).
 		XEmcNamespace(state.Namespace.ValueString()).
@@ -390,7 +425,7 @@ func (r *IAMUserResource) Delete(ctx context.Context, req resource.DeleteRequest
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting IAM user",
-			err.Error(),
+			helper.APIErrorDetail(err),
 		)
 	}
 }
@@ -412,7 +447,7 @@ func (r *IAMUserResource) ImportState(ctx context.Context, req resource.ImportSt
 		Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading user", err.Error())
+		resp.Diagnostics.AddError("Error reading user", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -425,6 +460,7 @@ func (r *IAMUserResource) ImportState(ctx context.Context, req resource.ImportSt
 		PermissionsBoundary: iam_user.GetUserResult.User.PermissionsBoundary,
 		Tags:                iam_user.GetUserResult.User.Tags,
 	}, types.StringValue(namespace))
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
 	// Save updated plan into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
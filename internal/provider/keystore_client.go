@@ -26,7 +26,6 @@ import (
 	"net/http"
 	"terraform-provider-objectscale/internal/client"
 	"terraform-provider-objectscale/internal/models"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -34,11 +33,11 @@ import (
 const (
 	vdcKeystorePath        = "/vdc/keystore"
 	objectCertKeystorePath = "/object-cert/keystore"
-	maxRetries             = 3
 )
 
 // doKeystoreRequest executes an HTTP request against the ObjectScale keystore API with auth headers.
-func doKeystoreRequest(ctx context.Context, c *client.Client, method, path string, body []byte) ([]byte, int, error) {
+// The errors of the API, which the keystore API may also return with HTTP 200, are returned as a *client.APIError.
+func doKeystoreRequest(ctx context.Context, c *client.Client, method, path string, body []byte) ([]byte, error) {
 	cfg := c.GenClient.GetConfig()
 	url := cfg.Servers[0].URL + path
 	var reqBody io.Reader
@@ -47,7 +46,7 @@ func doKeystoreRequest(ctx context.Context, c *client.Client, method, path strin
 	}
 	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
-		return nil, 0, fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 	for k, v := range cfg.DefaultHeader {
 		req.Header.Set(k, v)
@@ -57,78 +56,43 @@ func doKeystoreRequest(ctx context.Context, c *client.Client, method, path strin
 
 	resp, err := cfg.HTTPClient.Do(req)
 	if err != nil {
-		return nil, 0, fmt.Errorf("error executing request: %w", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp.StatusCode, fmt.Errorf("error reading response: %w", err)
+		return nil, fmt.Errorf("error reading response: %w", err)
 	}
 
-	return respBody, resp.StatusCode, nil
-}
-
-// parseKeystoreError checks if a response body contains an ObjectScale error code.
-func parseKeystoreError(body []byte) *models.KeystoreErrorResponse {
-	var errResp models.KeystoreErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil
-	}
-	if errResp.Code != 0 {
-		return &errResp
-	}
-	return nil
-}
-
-// mapKeystoreError maps an ObjectScale API error code to a human-readable message.
-func mapKeystoreError(errResp *models.KeystoreErrorResponse) (string, string) {
-	switch errResp.Code {
-	case 999:
-		return "Insufficient Permissions", fmt.Sprintf("SECURITY_ADMIN role required. API code: %d. Details: %s", errResp.Code, errResp.Details)
-	case 1005:
-		return "Missing Required Field", fmt.Sprintf("Both private_key and certificate_chain are required. API code: %d. Details: %s", errResp.Code, errResp.Details)
-	case 1008:
-		return "Invalid Certificate or Key Format", fmt.Sprintf("Ensure valid PEM with PKCS#1 or PKCS#8 RSA key. Note: OBS 4.1 only supports PKCS#1 format. If using PKCS#8, convert to PKCS#1 using: openssl rsa -in key.pem -out key-pkcs1.pem. API code: %d. Details: %s", errResp.Code, errResp.Details)
-	case 1013:
-		return "Certificate Already Deployed", fmt.Sprintf("The certificate chain is already active. No changes made. API code: %d. Details: %s", errResp.Code, errResp.Details)
-	default:
-		return "ObjectScale API Error", fmt.Sprintf("Unexpected error code: %d. Description: %s. Details: %s", errResp.Code, errResp.Description, errResp.Details)
+	if apiErr := client.NewAPIError(resp.StatusCode, respBody, nil); resp.StatusCode >= 400 || apiErr.Code != "" {
+		return nil, apiErr
 	}
+	return respBody, nil
 }
 
 // GetVDCKeystore reads the current VDC certificate chain via GET /vdc/keystore.
 var GetVDCKeystore = func(ctx context.Context, c *client.Client) (string, error) {
 	tflog.Debug(ctx, "reading VDC keystore certificate")
-	body, statusCode, err := doKeystoreRequest(ctx, c, http.MethodGet, vdcKeystorePath, nil)
+	body, err := doKeystoreRequest(ctx, c, http.MethodGet, vdcKeystorePath, nil)
 	if err != nil {
 		return "", fmt.Errorf("error reading VDC keystore: %w", err)
 	}
-	return parseGetResponse(body, statusCode, "VDC keystore")
+	return parseGetResponse(body, "VDC keystore")
 }
 
 // GetObjectCertKeystore reads the current Object certificate chain via GET /object-cert/keystore.
 var GetObjectCertKeystore = func(ctx context.Context, c *client.Client) (string, error) {
 	tflog.Debug(ctx, "reading Object certificate keystore")
-	body, statusCode, err := doKeystoreRequest(ctx, c, http.MethodGet, objectCertKeystorePath, nil)
+	body, err := doKeystoreRequest(ctx, c, http.MethodGet, objectCertKeystorePath, nil)
 	if err != nil {
 		return "", fmt.Errorf("error reading Object certificate keystore: %w", err)
 	}
-	return parseGetResponse(body, statusCode, "Object certificate keystore")
+	return parseGetResponse(body, "Object certificate keystore")
 }
 
-// parseGetResponse parses a GET keystore response, handling error codes.
-func parseGetResponse(body []byte, statusCode int, context string) (string, error) {
-	if statusCode == http.StatusUnauthorized {
-		return "", fmt.Errorf("%s: authentication failed (HTTP 401). Check credentials", context)
-	}
-
-	// Check for error body (even on HTTP 200)
-	if errResp := parseKeystoreError(body); errResp != nil {
-		summary, detail := mapKeystoreError(errResp)
-		return "", fmt.Errorf("%s: %s - %s", context, summary, detail)
-	}
-
+// parseGetResponse parses the certificate chain of a GET keystore response.
+func parseGetResponse(body []byte, context string) (string, error) {
 	var getResp models.KeystoreGetResponse
 	if err := json.Unmarshal(body, &getResp); err != nil {
 		return "", fmt.Errorf("%s: error parsing response: %w", context, err)
@@ -140,7 +104,7 @@ func parseGetResponse(body []byte, statusCode int, context string) (string, erro
 	return getResp.Chain, nil
 }
 
-// PutVDCKeystore replaces the VDC certificate via PUT /vdc/keystore with retry support.
+// PutVDCKeystore replaces the VDC certificate via PUT /vdc/keystore.
 var PutVDCKeystore = func(ctx context.Context, c *client.Client, privateKey, certChain string) error {
 	tflog.Debug(ctx, "updating VDC keystore certificate", map[string]interface{}{"has_private_key": true})
 	payload := models.KeystorePutRequest{
@@ -149,10 +113,10 @@ var PutVDCKeystore = func(ctx context.Context, c *client.Client, privateKey, cer
 			CertificateChain: certChain,
 		},
 	}
-	return executePutWithRetry(ctx, c, vdcKeystorePath, payload, "VDC keystore")
+	return executePut(ctx, c, vdcKeystorePath, payload, "VDC keystore")
 }
 
-// PutObjectCertKeystore replaces the Object certificate via PUT /object-cert/keystore with retry support.
+// PutObjectCertKeystore replaces the Object certificate via PUT /object-cert/keystore.
 var PutObjectCertKeystore = func(ctx context.Context, c *client.Client, privateKey, certChain string) error {
 	tflog.Debug(ctx, "updating Object certificate keystore", map[string]interface{}{"has_private_key": true})
 	payload := models.KeystorePutRequest{
@@ -161,7 +125,7 @@ var PutObjectCertKeystore = func(ctx context.Context, c *client.Client, privateK
 			CertificateChain: certChain,
 		},
 	}
-	return executePutWithRetry(ctx, c, objectCertKeystorePath, payload, "Object certificate keystore")
+	return executePut(ctx, c, objectCertKeystorePath, payload, "Object certificate keystore")
 }
 
 // PutObjectCertSelfSigned generates a self-signed Object certificate via PUT /object-cert/keystore.
@@ -178,24 +142,11 @@ var PutObjectCertSelfSigned = func(ctx context.Context, c *client.Client, ipAddr
 		return "", fmt.Errorf("error marshaling self-signed request: %w", err)
 	}
 
-	respBody, statusCode, err := doKeystoreRequest(ctx, c, http.MethodPut, objectCertKeystorePath, body)
+	respBody, err := doKeystoreRequest(ctx, c, http.MethodPut, objectCertKeystorePath, body)
 	if err != nil {
 		return "", fmt.Errorf("error sending self-signed request: %w", err)
 	}
 
-	if statusCode == http.StatusUnauthorized {
-		return "", fmt.Errorf("object certificate keystore: authentication failed (HTTP 401). Check credentials")
-	}
-
-	if errResp := parseKeystoreError(respBody); errResp != nil {
-		summary, detail := mapKeystoreError(errResp)
-		return "", fmt.Errorf("object certificate keystore: %s - %s", summary, detail)
-	}
-
-	if statusCode >= 500 {
-		return "", fmt.Errorf("object certificate keystore: server error (HTTP %d)", statusCode)
-	}
-
 	var getResp models.KeystoreGetResponse
 	if err := json.Unmarshal(respBody, &getResp); err != nil {
 		// Self-signed PUT may not return chain in some versions; read it back
@@ -216,49 +167,16 @@ var PutObjectCertSelfSigned = func(ctx context.Context, c *client.Client, ipAddr
 	return chain, nil
 }
 
-// executePutWithRetry executes a PUT request with exponential backoff retry for HTTP 5xx errors.
-func executePutWithRetry(ctx context.Context, c *client.Client, path string, payload models.KeystorePutRequest, context string) error {
+// executePut executes a PUT request of a certificate.
+// Transient failures are retried by the transport of the client.
+func executePut(ctx context.Context, c *client.Client, path string, payload models.KeystorePutRequest, context string) error {
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("error marshaling request: %w", err)
 	}
 
-	var lastErr error
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		respBody, statusCode, err := doKeystoreRequest(ctx, c, http.MethodPut, path, body)
-		if err != nil {
-			lastErr = err
-			if attempt < maxRetries-1 {
-				sleepDuration := time.Duration(1<<uint(attempt)) * time.Second
-				tflog.Warn(ctx, fmt.Sprintf("%s: request failed, retrying in %v (attempt %d/%d)", context, sleepDuration, attempt+1, maxRetries))
-				time.Sleep(sleepDuration)
-				continue
-			}
-			return fmt.Errorf("%s: %w", context, lastErr)
-		}
-
-		if statusCode == http.StatusUnauthorized {
-			return fmt.Errorf("%s: authentication failed (HTTP 401). Check credentials", context)
-		}
-
-		if statusCode >= 500 {
-			lastErr = fmt.Errorf("%s: server error (HTTP %d)", context, statusCode)
-			if attempt < maxRetries-1 {
-				sleepDuration := time.Duration(1<<uint(attempt)) * time.Second
-				tflog.Warn(ctx, fmt.Sprintf("%s: server error, retrying in %v (attempt %d/%d)", context, sleepDuration, attempt+1, maxRetries))
-				time.Sleep(sleepDuration)
-				continue
-			}
-			return lastErr
-		}
-
-		// Check for error body (even on HTTP 200)
-		if errResp := parseKeystoreError(respBody); errResp != nil {
-			summary, detail := mapKeystoreError(errResp)
-			return fmt.Errorf("%s: %s - %s", context, summary, detail)
-		}
-
-		return nil
+	if _, err := doKeystoreRequest(ctx, c, http.MethodPut, path, body); err != nil {
+		return fmt.Errorf("%s: %w", context, err)
 	}
-	return fmt.Errorf("%s: max retries exceeded: %w", context, lastErr)
+	return nil
 }
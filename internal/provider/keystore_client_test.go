@@ -24,8 +24,10 @@ import (
 	"net/http/httptest"
 	"terraform-provider-objectscale/internal/client"
 	"terraform-provider-objectscale/internal/clientgen"
+	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
 	"testing"
+	"time"
 )
 
 // newTestClient creates a client.Client pointing to a test HTTP server.
@@ -71,7 +73,7 @@ func TestGetVDCKeystore_Success(t *testing.T) {
 
 func TestGetVDCKeystore_Error999(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := models.KeystoreErrorResponse{Code: 999, Description: "Insufficient permissions", Details: "No SECURITY_ADMIN role"}
+		resp := map[string]interface{}{"code": 999, "description": "Insufficient permissions", "details": "No SECURITY_ADMIN role"}
 		w.Header().Set("Content-Type", "application/json")
 		if err := json.NewEncoder(w).Encode(resp); err != nil {
 			t.Errorf("failed to encode response: %v", err)
@@ -84,8 +86,8 @@ func TestGetVDCKeystore_Error999(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error for code 999")
 	}
-	if !contains(err.Error(), "Insufficient Permissions") {
-		t.Errorf("expected permission error, got: %v", err)
+	if client.KindOf(err) != client.ErrorAuth {
+		t.Errorf("expected auth error, got: %v", err)
 	}
 }
 
@@ -111,7 +113,7 @@ func TestPutVDCKeystore_Success(t *testing.T) {
 func TestPutVDCKeystore_Error1013(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusBadRequest)
-		resp := models.KeystoreErrorResponse{Code: 1013, Description: "Duplicate cert", Details: "Already deployed"}
+		resp := map[string]interface{}{"code": 1013, "description": "Duplicate cert", "details": "Already deployed"}
 		if err := json.NewEncoder(w).Encode(resp); err != nil {
 			t.Errorf("failed to encode response: %v", err)
 		}
@@ -123,8 +125,8 @@ func TestPutVDCKeystore_Error1013(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error for code 1013")
 	}
-	if !contains(err.Error(), "Certificate Already Deployed") {
-		t.Errorf("expected duplicate cert error, got: %v", err)
+	if client.KindOf(err) != client.ErrorConflict {
+		t.Errorf("expected conflict error, got: %v", err)
 	}
 }
 
@@ -195,7 +197,7 @@ func TestPutObjectCertSelfSigned_Success(t *testing.T) {
 
 func TestPutVDCKeystore_Error1008(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := models.KeystoreErrorResponse{Code: 1008, Description: "Invalid format", Details: "Bad PEM"}
+		resp := map[string]interface{}{"code": 1008, "description": "Invalid format", "details": "Bad PEM"}
 		w.Header().Set("Content-Type", "application/json")
 		if err := json.NewEncoder(w).Encode(resp); err != nil {
 			t.Errorf("failed to encode response: %v", err)
@@ -208,8 +210,8 @@ func TestPutVDCKeystore_Error1008(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error for code 1008")
 	}
-	if !contains(err.Error(), "Invalid Certificate or Key Format") {
-		t.Errorf("expected format error, got: %v", err)
+	if client.KindOf(err) != client.ErrorValidation {
+		t.Errorf("expected validation error, got: %v", err)
 	}
 }
 
@@ -224,7 +226,7 @@ func TestGetVDCKeystore_Auth401(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error for 401")
 	}
-	if !contains(err.Error(), "authentication failed") {
+	if client.KindOf(err) != client.ErrorAuth {
 		t.Errorf("expected auth error, got: %v", err)
 	}
 }
@@ -240,7 +242,7 @@ func TestPutVDCKeystore_Auth401(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error for 401")
 	}
-	if !contains(err.Error(), "authentication failed") {
+	if client.KindOf(err) != client.ErrorAuth {
 		t.Errorf("expected auth error, got: %v", err)
 	}
 }
@@ -276,62 +278,40 @@ func TestPutObjectCertSelfSigned_Auth401(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error for 401")
 	}
-	if !contains(err.Error(), "authentication failed") {
+	if client.KindOf(err) != client.ErrorAuth {
 		t.Errorf("expected auth error, got: %v", err)
 	}
 }
 
-func TestParseKeystoreError_NoError(t *testing.T) {
-	body := []byte(`{"chain":"test"}`)
-	result := parseKeystoreError(body)
-	if result != nil {
-		t.Error("expected nil for non-error response")
-	}
-}
+func TestPutVDCKeystore_ErrorMessage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		if _, err := w.Write([]byte(`{"code":1008,"description":"Invalid format","details":"Bad PEM"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
 
-func TestParseKeystoreError_WithError(t *testing.T) {
-	body := []byte(`{"code":999,"description":"Permission denied","details":"No admin role"}`)
-	result := parseKeystoreError(body)
-	if result == nil {
-		t.Fatal("expected error response")
+	c := newTestClient(server)
+	err := PutVDCKeystore(context.Background(), c, "key", "chain")
+	apiErr := client.ParseError(err)
+	if apiErr == nil {
+		t.Fatalf("expected an API error, got: %v", err)
 	}
-	if result.Code != 999 {
-		t.Errorf("expected code 999, got %d", result.Code)
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.Code != "1008" || apiErr.Details != "Bad PEM" {
+		t.Errorf("unexpected API error: %+v", apiErr)
 	}
-}
-
-func TestParseKeystoreError_InvalidJSON(t *testing.T) {
-	body := []byte(`not json`)
-	result := parseKeystoreError(body)
-	if result != nil {
-		t.Error("expected nil for invalid JSON")
+	if !contains(helper.APIErrorDetail(err), apiErr.Remediation()) {
+		t.Errorf("expected the remediation in the detail, got: %v", helper.APIErrorDetail(err))
 	}
-}
-
-func TestMapKeystoreError_AllCodes(t *testing.T) {
-	tests := []struct {
-		code            int
-		expectedSummary string
-	}{
-		{999, "Insufficient Permissions"},
-		{1005, "Missing Required Field"},
-		{1008, "Invalid Certificate or Key Format"},
-		{1013, "Certificate Already Deployed"},
-		{9999, "ObjectScale API Error"},
-	}
-
-	for _, tt := range tests {
-		errResp := &models.KeystoreErrorResponse{Code: tt.code, Description: "desc", Details: "detail"}
-		summary, _ := mapKeystoreError(errResp)
-		if summary != tt.expectedSummary {
-			t.Errorf("code %d: expected summary %q, got %q", tt.code, tt.expectedSummary, summary)
-		}
+	if !contains(helper.APIErrorDetail(err), "openssl rsa") {
+		t.Errorf("expected the PKCS#1 conversion in the detail, got: %v", helper.APIErrorDetail(err))
 	}
 }
 
 func TestPutObjectCertSelfSigned_Error999(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := models.KeystoreErrorResponse{Code: 999, Description: "Insufficient permissions", Details: "No role"}
+		resp := map[string]interface{}{"code": 999, "description": "Insufficient permissions", "details": "No role"}
 		w.Header().Set("Content-Type", "application/json")
 		if err := json.NewEncoder(w).Encode(resp); err != nil {
 			t.Errorf("failed to encode response: %v", err)
@@ -344,8 +324,8 @@ func TestPutObjectCertSelfSigned_Error999(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error for code 999")
 	}
-	if !contains(err.Error(), "Insufficient Permissions") {
-		t.Errorf("expected permission error, got: %v", err)
+	if client.KindOf(err) != client.ErrorAuth {
+		t.Errorf("expected auth error, got: %v", err)
 	}
 }
 
@@ -363,7 +343,7 @@ func TestPutObjectCertSelfSigned_ServerError(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error for server error")
 	}
-	if !contains(err.Error(), "server error") {
+	if client.KindOf(err) != client.ErrorServer {
 		t.Errorf("expected server error, got: %v", err)
 	}
 }
@@ -387,8 +367,17 @@ func TestPutVDCKeystore_RetryOn500(t *testing.T) {
 	}))
 	defer server.Close()
 
-	c := newTestClient(server)
-	err := PutVDCKeystore(context.Background(), c, "key", "chain")
+	// the retries are done by the transport of the client
+	c, err := client.NewClientFromConfig(client.Config{
+		Endpoint:     server.URL,
+		AuthToken:    "test-token",
+		MaxRetries:   2,
+		RetryBackoff: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = PutVDCKeystore(context.Background(), c, "key", "chain")
 	if err != nil {
 		t.Fatalf("expected success after retries, got: %v", err)
 	}
@@ -397,7 +386,7 @@ func TestPutVDCKeystore_RetryOn500(t *testing.T) {
 	}
 }
 
-func TestPutVDCKeystore_RetryExhausted(t *testing.T) {
+func TestPutVDCKeystore_ServerError(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 		if _, err := w.Write([]byte("error")); err != nil {
@@ -409,9 +398,9 @@ func TestPutVDCKeystore_RetryExhausted(t *testing.T) {
 	c := newTestClient(server)
 	err := PutVDCKeystore(context.Background(), c, "key", "chain")
 	if err == nil {
-		t.Fatal("expected error after max retries")
+		t.Fatal("expected error for server error")
 	}
-	if !contains(err.Error(), "server error") {
+	if client.KindOf(err) != client.ErrorServer {
 		t.Errorf("expected server error, got: %v", err)
 	}
 }
@@ -466,14 +455,14 @@ func TestGetObjectCertKeystore_Auth401(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error for 401")
 	}
-	if !contains(err.Error(), "authentication failed") {
+	if client.KindOf(err) != client.ErrorAuth {
 		t.Errorf("expected auth error, got: %v", err)
 	}
 }
 
 func TestGetObjectCertKeystore_Error999(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := models.KeystoreErrorResponse{Code: 999, Description: "Permission denied", Details: "No role"}
+		resp := map[string]interface{}{"code": 999, "description": "Permission denied", "details": "No role"}
 		if err := json.NewEncoder(w).Encode(resp); err != nil {
 			t.Errorf("failed to encode response: %v", err)
 		}
@@ -505,7 +494,7 @@ func TestGetObjectCertKeystore_EmptyChain(t *testing.T) {
 
 func TestPutObjectCertKeystore_Error999(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := models.KeystoreErrorResponse{Code: 999, Description: "Permission denied", Details: "No role"}
+		resp := map[string]interface{}{"code": 999, "description": "Permission denied", "details": "No role"}
 		if err := json.NewEncoder(w).Encode(resp); err != nil {
 			t.Errorf("failed to encode response: %v", err)
 		}
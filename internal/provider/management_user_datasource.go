@@ -127,7 +127,7 @@ func (d *ManagementUserDataSource) Read(ctx context.Context, req datasource.Read
 	if userID != "" {
 		getResp, _, err := d.client.GenClient.MgmtUserInfoApi.MgmtUserInfoServiceGetLocalUserInfo(ctx, userID).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Get Management User failed", err.Error())
+			resp.Diagnostics.AddError("Get Management User failed", helper.APIErrorDetail(err))
 			return
 		}
 		managementUsers = append(managementUsers, models.ManagementUserInfo{
@@ -142,7 +142,7 @@ func (d *ManagementUserDataSource) Read(ctx context.Context, req datasource.Read
 	} else {
 		listResp, _, err := d.client.GenClient.MgmtUserInfoApi.MgmtUserInfoServiceGetLocalUserInfos(ctx).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("List Management Users failed", err.Error())
+			resp.Diagnostics.AddError("List Management Users failed", helper.APIErrorDetail(err))
 			return
 		}
 		allManagementUsers := listResp.MgmtUserInfo
@@ -25,7 +25,9 @@ import (
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -61,7 +63,7 @@ func (r *ManagementUserResource) Metadata(_ context.Context, req resource.Metada
 }
 
 // Schema defines the schema for the resource.
-func (r *ManagementUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ManagementUserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description:         "This resource manages Dell ObjectScale management users. Supported types include LOCALUSER, ADLDAPUSER, and ADLDAP_GROUP.",
 		MarkdownDescription: "This resource manages Dell ObjectScale management users. Supported types include LOCALUSER, ADLDAPUSER, and ADLDAP_GROUP.",
@@ -100,6 +102,9 @@ func (r *ManagementUserResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"password_wo": helper.WriteOnlyAttribute("password",
+				"password for the management user. Only applicable for LOCAL_USER.", true),
+			"password_wo_version": helper.WriteOnlyVersionAttribute("password"),
 			"system_administrator": schema.BoolAttribute{
 				Description:         "If set to true, assigns the management user to the System Admin role. System Administrators perform system level administration (VDC administration) and namespace administration.",
 				MarkdownDescription: "If set to true, assigns the management user to the System Admin role. System Administrators perform system level administration (VDC administration) and namespace administration.",
@@ -122,6 +127,9 @@ func (r *ManagementUserResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Default:             booldefault.StaticBool(false),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -139,7 +147,7 @@ func (r *ManagementUserResource) ValidateConfig(ctx context.Context, req resourc
 
 	// Validate name format and password depending on type: LOCAL_USER vs AD/LDAP
 	hasAt := strings.Contains(userID, "@")
-	isPasswordProvided := isNonEmptyString(cfg.Password)
+	isPasswordProvided := isNonEmptyString(cfg.Password) || isNonEmptyString(cfg.PasswordWO)
 	switch mgmtUserType {
 	case ManagementUserTypeLocal:
 		if hasAt {
@@ -152,7 +160,7 @@ func (r *ManagementUserResource) ValidateConfig(ctx context.Context, req resourc
 		if !isPasswordProvided {
 			resp.Diagnostics.AddError(
 				"Password is required for LOCAL_USER",
-				"For type LOCAL_USER, 'password' must be provided.",
+				"For type LOCAL_USER, 'password' or 'password_wo' must be provided.",
 			)
 			return
 		}
@@ -167,7 +175,7 @@ func (r *ManagementUserResource) ValidateConfig(ctx context.Context, req resourc
 		if isPasswordProvided {
 			resp.Diagnostics.AddError(
 				"Password is not applicable for AD_LDAP_USER/AD_LDAP_GROUP",
-				"For type AD_LDAP_USER or AD_LDAP_GROUP, 'password' must not be provided.",
+				"For type AD_LDAP_USER or AD_LDAP_GROUP, 'password' and 'password_wo' must not be provided.",
 			)
 			return
 		}
@@ -183,17 +191,28 @@ func (r *ManagementUserResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	userID := state.Name.ValueString()
 	prevPassword := state.Password
 
 	// get management user
 	getResp, _, err := r.client.GenClient.MgmtUserInfoApi.MgmtUserInfoServiceGetLocalUserInfo(ctx, userID).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Read Management User failed", err.Error())
+		if helper.RemoveNotFound(ctx, err, &resp.State, "management user "+userID) {
+			return
+		}
+		resp.Diagnostics.AddError("Read Management User failed", helper.APIErrorDetail(err))
 		return
 	}
 
 	newState := mapToModel(getResp, prevPassword)
+	newState.PasswordWOVersion = state.PasswordWOVersion
+	newState.Timeouts = state.Timeouts
 	diags = resp.State.Set(ctx, &newState)
 	resp.Diagnostics.Append(diags...)
 }
@@ -207,6 +226,12 @@ func (r *ManagementUserResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	userID := plan.Name.ValueString()
 	mgmtUserType := plan.Type.ValueString()
 
@@ -221,7 +246,12 @@ func (r *ManagementUserResource) Create(ctx context.Context, req resource.Create
 	switch mgmtUserType {
 	case ManagementUserTypeLocal:
 		createRequest.IsExternalGroup = helper.ValueToPointer[bool](types.BoolValue(false))
-		createRequest.Password = helper.ValueToPointer[string](plan.Password)
+		password, diags := helper.SecretValue(ctx, plan.Password, req.Config, "password")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		createRequest.Password = helper.ValueToPointer[string](password)
 	case ManagementUserTypeADLDAPUser:
 		createRequest.IsExternalGroup = helper.ValueToPointer[bool](types.BoolValue(false))
 	case ManagementUserTypeADLDAPGroup:
@@ -231,18 +261,20 @@ func (r *ManagementUserResource) Create(ctx context.Context, req resource.Create
 	// create management user
 	_, _, err := r.client.GenClient.MgmtUserInfoApi.MgmtUserInfoServiceCreateLocalUserInfo(ctx).MgmtUserInfoServiceCreateLocalUserInfoRequest(createRequest).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Create Management User failed", err.Error())
+		resp.Diagnostics.AddError("Create Management User failed", helper.APIErrorDetail(err))
 		return
 	}
 
 	// get management user
 	getResp, _, err := r.client.GenClient.MgmtUserInfoApi.MgmtUserInfoServiceGetLocalUserInfo(ctx, userID).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Read Management User failed", err.Error())
+		resp.Diagnostics.AddError("Read Management User failed", helper.APIErrorDetail(err))
 		return
 	}
 
 	newState := mapToModel(getResp, plan.Password)
+	newState.PasswordWOVersion = plan.PasswordWOVersion
+	newState.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, &newState)
 	resp.Diagnostics.Append(diags...)
 }
@@ -256,6 +288,12 @@ func (r *ManagementUserResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state models.ManagementUserResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -285,23 +323,32 @@ func (r *ManagementUserResource) Update(ctx context.Context, req resource.Update
 		if state.Password.IsNull() || state.Password.IsUnknown() || plan.Password.ValueString() != state.Password.ValueString() {
 			updateRequest.Password = helper.ValueToPointer[string](plan.Password)
 		}
+	} else if mgmtUserType == ManagementUserTypeLocal && helper.IsWriteOnlyRotated(plan.PasswordWOVersion, state.PasswordWOVersion) {
+		password, diags := helper.SecretValue(ctx, plan.Password, req.Config, "password")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateRequest.Password = helper.ValueToPointer[string](password)
 	}
 
 	// update management user
 	_, _, err := r.client.GenClient.MgmtUserInfoApi.MgmtUserInfoServiceModifyLocalUserInfo(ctx, userID).MgmtUserInfoServiceModifyLocalUserInfoRequest(updateRequest).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Update Management User failed", err.Error())
+		resp.Diagnostics.AddError("Update Management User failed", helper.APIErrorDetail(err))
 		return
 	}
 
 	// get management user
 	getResp, _, err := r.client.GenClient.MgmtUserInfoApi.MgmtUserInfoServiceGetLocalUserInfo(ctx, userID).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Read Management User failed", err.Error())
+		resp.Diagnostics.AddError("Read Management User failed", helper.APIErrorDetail(err))
 		return
 	}
 
 	newState := mapToModel(getResp, plan.Password)
+	newState.PasswordWOVersion = plan.PasswordWOVersion
+	newState.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, &newState)
 	resp.Diagnostics.Append(diags...)
 }
@@ -317,12 +364,18 @@ func (r *ManagementUserResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	userID := state.Name.ValueString()
 
 	// delete management user
 	_, _, err := r.client.GenClient.MgmtUserInfoApi.MgmtUserInfoServiceDeleteLocalUserInfo(ctx, userID).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Delete Management User failed", err.Error())
+		resp.Diagnostics.AddError("Delete Management User failed", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -338,11 +391,12 @@ func (r *ManagementUserResource) ImportState(ctx context.Context, req resource.I
 	// get management user
 	getResp, _, err := r.client.GenClient.MgmtUserInfoApi.MgmtUserInfoServiceGetLocalUserInfo(ctx, userID).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Import Management User failed", err.Error())
+		resp.Diagnostics.AddError("Import Management User failed", helper.APIErrorDetail(err))
 		return
 	}
 
 	newState := mapToModel(getResp, types.StringNull())
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &newState.Timeouts)...)
 	diags := resp.State.Set(ctx, &newState)
 	resp.Diagnostics.Append(diags...)
 }
@@ -18,10 +18,12 @@ limitations under the License.
 package provider
 
 import (
+	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccManagementUserResourceForLocalUserCRUD(t *testing.T) {
@@ -68,6 +70,52 @@ func TestAccManagementUserResourceForLocalUserCRUD(t *testing.T) {
 	})
 }
 
+func TestAccManagementUserResourceForWriteOnlyPassword(t *testing.T) {
+	defer testUserTokenCleanup(t)
+
+	resourceName := "objectscale_management_user.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// create Local User with a write-only password
+			{
+				Config: ProviderConfigForTesting + testAccManagementUserResourceWriteOnlyConfig("pass123", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "localuser2"),
+					resource.TestCheckNoResourceAttr(resourceName, "password"),
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "1"),
+				),
+			},
+			// rotate the password
+			{
+				Config: ProviderConfigForTesting + testAccManagementUserResourceWriteOnlyConfig("pass1234", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "2"),
+				),
+			},
+			// password and password_wo conflict
+			{
+				Config: ProviderConfigForTesting + `
+				resource "objectscale_management_user" "example" {
+					type = "LOCAL_USER"
+					name = "localuser2"
+					password = "pass123"
+					password_wo = "pass123"
+					password_wo_version = 3
+				}
+				`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
 func TestAccManagementUserResourceForADLDAPUserCRUD(t *testing.T) {
 	defer testUserTokenCleanup(t)
 
@@ -246,6 +294,17 @@ func testAccManagementUserResourceLocalUserConfig1() string {
     `
 }
 
+func testAccManagementUserResourceWriteOnlyConfig(password string, version int) string {
+	return fmt.Sprintf(`
+    resource "objectscale_management_user" "example" {
+        type = "LOCAL_USER"
+        name = "localuser2"
+        password_wo = "%s"
+        password_wo_version = %d
+    }
+    `, password, version)
+}
+
 func testAccManagementUserResourceLocalUserConfig2() string {
 	return `
     resource "objectscale_management_user" "example" {
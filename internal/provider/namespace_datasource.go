@@ -314,7 +314,7 @@ func (d *NamespaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting namespaces",
-			err.Error(),
+			helper.APIErrorDetail(err),
 		)
 		return
 	}
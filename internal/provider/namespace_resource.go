@@ -19,13 +19,16 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"terraform-provider-objectscale/internal/clientgen"
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -239,6 +242,26 @@ func (r *NamespaceResource) Schema(ctx context.Context, req resource.SchemaReque
 				Sensitive:           true,
 				Optional:            true,
 			},
+			"root_user_password_wo":         helper.WriteOnlyAttribute("root_user_password", "root user password.", true),
+			"root_user_password_wo_version": helper.WriteOnlyVersionAttribute("root_user_password"),
+			"current_root_user_password_wo": helper.WriteOnlyAttribute("current_root_user_password",
+				"current root user password. Only to be provided when updating the root user password.", false),
+			"force_destroy": schema.BoolAttribute{
+				Description:         "Whether the buckets, object users, IAM users, groups, roles, policies and SAML providers of the namespace are deleted when the namespace is destroyed. When false, destroying a namespace which still contains any of them fails and lists them. The buckets are deleted with all their objects.",
+				MarkdownDescription: "Whether the buckets, object users, IAM users, groups, roles, policies and SAML providers of the namespace are deleted when the namespace is destroyed. When `false`, destroying a namespace which still contains any of them fails and lists them. The buckets are deleted with all their objects.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				Read:              true,
+				Update:            true,
+				Delete:            true,
+				DeleteDescription: "Maximum time allowed for the delete, which may empty and delete its buckets, as a duration like 30s, 10m or 2h. Defaults to 1h.",
+			}),
 		},
 	}
 	resp.Schema.Attributes = fillSchemaWithUseState(resp.Schema.Attributes)
@@ -356,7 +379,18 @@ func (r *NamespaceResource) Create(ctx context.Context, req resource.CreateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	planJson := r.modelToJson(plan)
+	rootpwd, diags := helper.SecretValue(ctx, plan.RootUserPassword, req.Config, "root_user_password")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	nsreq := r.client.GenClient.NamespaceApi.NamespaceServiceCreateNamespace(ctx)
 	namespace, _, err := nsreq.NamespaceServiceCreateNamespaceRequest(
@@ -374,10 +408,10 @@ func (r *NamespaceResource) Create(ctx context.Context, req resource.CreateReque
 			IsObjectLockWithAdoAllowed:   planJson.IsObjectLockWithAdoAllowed,
 			ComplianceEnabled:            planJson.IsComplianceEnabled,
 			DefaultAuditDeleteExpiration: planJson.DefaultAuditDeleteExpiration,
-			RootUserPassword:             helper.ValueToPointer[string](plan.RootUserPassword),
+			RootUserPassword:             helper.ValueToPointer[string](rootpwd),
 		}).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating namespace", err.Error())
+		resp.Diagnostics.AddError("Error creating namespace", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -402,8 +436,11 @@ func (r *NamespaceResource) Create(ctx context.Context, req resource.CreateReque
 		RootUserName:                 namespace.RootUserName,
 	}
 	data := r.getModel(stateJson1, plan.RootUserPassword, plan.CurrentRootUserPassword)
+	data.ForceDestroy = plan.ForceDestroy
+	data.RootUserPasswordWOVersion = plan.RootUserPasswordWOVersion
 
 	// Save data into Terraform state
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 	// update common workflow
@@ -415,6 +452,9 @@ func (r *NamespaceResource) Create(ctx context.Context, req resource.CreateReque
 
 	// Save data into Terraform state
 	data = r.getModel(stateJson2, plan.RootUserPassword, plan.CurrentRootUserPassword)
+	data.ForceDestroy = plan.ForceDestroy
+	data.RootUserPasswordWOVersion = plan.RootUserPasswordWOVersion
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 }
@@ -426,7 +466,7 @@ func (r *NamespaceResource) updateCommon(ctx context.Context, plan, state *clien
 	if err != nil {
 		return nil, &models.DiagError{
 			Summary: "Error adding retention classes",
-			Detail:  err.Error(),
+			Detail:  helper.APIErrorDetail(err),
 		}
 	}
 
@@ -435,7 +475,7 @@ func (r *NamespaceResource) updateCommon(ctx context.Context, plan, state *clien
 	if err != nil {
 		return nil, &models.DiagError{
 			Summary: "Error adding quotas",
-			Detail:  err.Error(),
+			Detail:  helper.APIErrorDetail(err),
 		}
 	}
 
@@ -444,7 +484,7 @@ func (r *NamespaceResource) updateCommon(ctx context.Context, plan, state *clien
 	if err != nil {
 		return nil, &models.DiagError{
 			Summary: "Error reading namespace after adding retention classes",
-			Detail:  err.Error(),
+			Detail:  helper.APIErrorDetail(err),
 		}
 	}
 
@@ -522,15 +562,31 @@ func (r *NamespaceResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	namespace, _, err := r.client.GenClient.NamespaceApi.NamespaceServiceGetNamespace(ctx, state.Id.ValueString()).Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading namespace", err.Error())
+		if helper.RemoveNotFound(ctx, err, &resp.State, "namespace "+state.Id.ValueString()) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading namespace", helper.APIErrorDetail(err))
 		return
 	}
 
 	data := r.getModel(namespace, state.RootUserPassword, state.CurrentRootUserPassword)
+	data.ForceDestroy = state.ForceDestroy
+	data.RootUserPasswordWOVersion = state.RootUserPasswordWOVersion
+	if data.ForceDestroy.IsNull() {
+		// imported namespace
+		data.ForceDestroy = types.BoolValue(false)
+	}
 	// Save updated plan into Terraform state
+	data.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -610,6 +666,12 @@ func (r *NamespaceResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// To prevent the non-updatable fields from being changed
 	if !plan.Name.Equal(state.Name) ||
 		!plan.IsComplianceEnabled.Equal(state.IsComplianceEnabled) ||
@@ -636,9 +698,17 @@ func (r *NamespaceResource) Update(ctx context.Context, req resource.UpdateReque
 
 	var currpass, newpass *string
 	// if root password is changing
-	if helper.IsChangedNN(plan.RootUserPassword, state.RootUserPassword) {
-		currpass = helper.ValueToPointer[string](plan.CurrentRootUserPassword)
-		newpass = helper.ValueToPointer[string](plan.RootUserPassword)
+	if helper.IsChangedNN(plan.RootUserPassword, state.RootUserPassword) ||
+		helper.IsWriteOnlyRotated(plan.RootUserPasswordWOVersion, state.RootUserPasswordWOVersion) {
+		current, diags := helper.SecretValue(ctx, plan.CurrentRootUserPassword, req.Config, "current_root_user_password")
+		resp.Diagnostics.Append(diags...)
+		rootpwd, diags := helper.SecretValue(ctx, plan.RootUserPassword, req.Config, "root_user_password")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		currpass = helper.ValueToPointer[string](current)
+		newpass = helper.ValueToPointer[string](rootpwd)
 	}
 
 	ureq := r.client.GenClient.NamespaceApi.NamespaceServiceUpdateNamespace(ctx, state.Id.ValueString())
@@ -672,7 +742,7 @@ func (r *NamespaceResource) Update(ctx context.Context, req resource.UpdateReque
 	})
 	_, _, err := ureq.Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating namespace", err.Error())
+		resp.Diagnostics.AddError("Error updating namespace", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -685,6 +755,9 @@ func (r *NamespaceResource) Update(ctx context.Context, req resource.UpdateReque
 
 	// Save updated data into Terraform state
 	data := r.getModel(namespace, plan.RootUserPassword, plan.CurrentRootUserPassword)
+	data.ForceDestroy = plan.ForceDestroy
+	data.RootUserPasswordWOVersion = plan.RootUserPasswordWOVersion
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -699,12 +772,42 @@ func (r *NamespaceResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	_, _, err := r.client.GenClient.NamespaceApi.NamespaceServiceDeactivateNamespace(ctx, state.Id.ValueString()).Execute()
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, namespaceDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespace := state.Id.ValueString()
+	deps, err := r.listDependencies(ctx, namespace)
+	switch {
+	case err != nil && state.ForceDestroy.ValueBool():
+		resp.Diagnostics.AddError("Error deleting namespace", "Could not list the entities of the namespace: "+helper.APIErrorDetail(err))
+		return
+	case err != nil:
+		// let the array decide whether the namespace can be deleted
+		resp.Diagnostics.AddWarning("Could not check the entities of the namespace before deleting it", helper.APIErrorDetail(err))
+	case deps.isEmpty():
+		// nothing prevents the deletion
+	case !state.ForceDestroy.ValueBool():
+		resp.Diagnostics.AddError(
+			"Error deleting namespace",
+			fmt.Sprintf("Namespace %s still contains:\n%s\nDelete them, or set force_destroy to true to delete them with the namespace.", namespace, deps),
+		)
+		return
+	default:
+		if err := r.deleteDependencies(ctx, namespace, deps); err != nil {
+			resp.Diagnostics.AddError("Error deleting namespace", "Could not delete the entities of the namespace: "+helper.APIErrorDetail(err))
+			return
+		}
+	}
+
+	_, _, err = r.client.GenClient.NamespaceApi.NamespaceServiceDeactivateNamespace(ctx, namespace).Execute()
 
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting namespace",
-			err.Error(),
+			helper.APIErrorDetail(err),
 		)
 	}
 }
@@ -0,0 +1,305 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-objectscale/internal/client"
+	"terraform-provider-objectscale/internal/clientgen"
+	"terraform-provider-objectscale/internal/helper"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// namespaceDependenciesDisplayLimit is the number of entities of each kind shown in the diagnostics.
+const namespaceDependenciesDisplayLimit = 10
+
+// namespaceDeleteTimeout is the default timeout of the delete of a namespace, which may empty and delete its buckets.
+const namespaceDeleteTimeout = bucketDeleteTimeout
+
+// namespaceDependencies holds the entities of a namespace which prevent its deletion.
+type namespaceDependencies struct {
+	Buckets       []string
+	ObjectUsers   []string
+	IAMUsers      []string
+	IAMGroups     []string
+	IAMRoles      []string
+	IAMPolicies   []string // ARNs of the customer managed policies
+	SAMLProviders []string // ARNs of the SAML providers
+}
+
+// isEmpty returns true if nothing prevents the deletion of the namespace.
+func (d namespaceDependencies) isEmpty() bool {
+	return len(d.Buckets)+len(d.ObjectUsers)+len(d.IAMUsers)+len(d.IAMGroups)+
+		len(d.IAMRoles)+len(d.IAMPolicies)+len(d.SAMLProviders) == 0
+}
+
+// String returns a readable list of the dependencies, one kind per line.
+func (d namespaceDependencies) String() string {
+	var sb strings.Builder
+	for _, kind := range []struct {
+		name  string
+		items []string
+	}{
+		{"buckets", d.Buckets},
+		{"object users", d.ObjectUsers},
+		{"IAM users", d.IAMUsers},
+		{"IAM groups", d.IAMGroups},
+		{"IAM roles", d.IAMRoles},
+		{"IAM policies", d.IAMPolicies},
+		{"SAML providers", d.SAMLProviders},
+	} {
+		if len(kind.items) == 0 {
+			continue
+		}
+		shown := kind.items
+		if len(shown) > namespaceDependenciesDisplayLimit {
+			shown = shown[:namespaceDependenciesDisplayLimit]
+		}
+		fmt.Fprintf(&sb, "  - %d %s: %s", len(kind.items), kind.name, strings.Join(shown, ", "))
+		if more := len(kind.items) - len(shown); more > 0 {
+			fmt.Fprintf(&sb, " and %d more", more)
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// listDependencies lists the entities of the namespace which prevent its deletion.
+func (r *NamespaceResource) listDependencies(ctx context.Context, namespace string) (namespaceDependencies, error) {
+	var deps namespaceDependencies
+	api := r.client.GenClient
+
+	buckets, err := helper.GetAllInstances(api.BucketApi.BucketServiceGetBuckets(ctx).Namespace(namespace))
+	if err != nil {
+		return deps, fmt.Errorf("could not list buckets: %w", err)
+	}
+	deps.Buckets = helper.SliceTransform(buckets, func(v clientgen.BucketServiceGetBucketsResponseObjectBucketInner) string {
+		return helper.TfStringNN(v.Name).ValueString()
+	})
+
+	// the users of a namespace cannot be paginated, so all the users are listed and filtered
+	users, err := helper.GetAllInstances(api.UserManagementApi.UserManagementServiceGetAllUsers(ctx))
+	if err != nil {
+		return deps, fmt.Errorf("could not list object users: %w", err)
+	}
+	for _, v := range users {
+		if v.Namespace != nil && *v.Namespace == namespace {
+			deps.ObjectUsers = append(deps.ObjectUsers, v.Userid)
+		}
+	}
+
+	iamUsers, err := helper.GetAllInstances(api.IamApi.IamServiceListUsers(ctx).XEmcNamespace(namespace))
+	if err != nil {
+		return deps, fmt.Errorf("could not list IAM users: %w", err)
+	}
+	deps.IAMUsers = helper.SliceTransform(iamUsers, func(v clientgen.IamServiceListUsersResponseListUsersResultUsersInner) string {
+		return helper.TfStringNN(v.UserName).ValueString()
+	})
+
+	groups, err := helper.GetAllInstances(api.IamApi.IamServiceListGroups(ctx).XEmcNamespace(namespace))
+	if err != nil {
+		return deps, fmt.Errorf("could not list IAM groups: %w", err)
+	}
+	deps.IAMGroups = helper.SliceTransform(groups, func(v clientgen.IamServiceListGroupsResponseListGroupsResultGroupsInner) string {
+		return v.GroupName
+	})
+
+	roles, err := helper.GetAllInstances(api.IamApi.IamServiceListRoles(ctx).XEmcNamespace(namespace))
+	if err != nil {
+		return deps, fmt.Errorf("could not list IAM roles: %w", err)
+	}
+	deps.IAMRoles = helper.SliceTransform(roles, func(v clientgen.IamRole) string {
+		return helper.TfStringNN(v.RoleName).ValueString()
+	})
+
+	policies, err := helper.GetAllInstances(api.IamApi.IamServiceListPolicies(ctx).PolicyScope("Local").XEmcNamespace(namespace))
+	if err != nil {
+		return deps, fmt.Errorf("could not list IAM policies: %w", err)
+	}
+	deps.IAMPolicies = helper.SliceTransform(policies, func(v clientgen.IamPolicy) string {
+		return helper.TfStringNN(v.Arn).ValueString()
+	})
+
+	providers, err := helper.GetAllInstances(api.IamApi.IamServiceListSAMLProviders(ctx).XEmcNamespace(namespace))
+	if err != nil {
+		return deps, fmt.Errorf("could not list SAML providers: %w", err)
+	}
+	deps.SAMLProviders = helper.SliceTransform(providers, func(v clientgen.IamSamlProviderEntry) string {
+		return helper.TfStringNN(v.Arn).ValueString()
+	})
+
+	return deps, nil
+}
+
+// deleteDependencies deletes the entities of the namespace in dependency order:
+// buckets first as they are owned by the object users, then the IAM entities,
+// whose policies must be detached before the policies can be deleted, and the object users last.
+func (r *NamespaceResource) deleteDependencies(ctx context.Context, namespace string, deps namespaceDependencies) error {
+	api := r.client.GenClient
+	for _, bucket := range deps.Buckets {
+		tflog.Info(ctx, "deleting bucket of namespace", map[string]interface{}{"namespace": namespace, "bucket": bucket})
+		_, _, err := api.BucketApi.BucketServiceDeactivateBucket(ctx, bucket).Namespace(namespace).EmptyBucket("true").Execute()
+		if err != nil {
+			return fmt.Errorf("could not delete bucket %s: %w", bucket, err)
+		}
+		if err := waitForBucketEmptied(ctx, r.client, bucket, namespace); err != nil {
+			return err
+		}
+	}
+
+	for _, role := range deps.IAMRoles {
+		tflog.Info(ctx, "deleting IAM role of namespace", map[string]interface{}{"namespace": namespace, "role": role})
+		attached, err := helper.GetAllInstances(api.IamApi.IamServiceListAttachedRolePolicies(ctx).RoleName(role).XEmcNamespace(namespace))
+		if err != nil {
+			return fmt.Errorf("could not list policies attached to IAM role %s: %w", role, err)
+		}
+		for _, p := range attached {
+			if _, _, err := api.IamApi.IamServiceDetachRolePolicy(ctx).RoleName(role).PolicyArn(*p.PolicyArn).XEmcNamespace(namespace).Execute(); err != nil {
+				return fmt.Errorf("could not detach policy %s from IAM role %s: %w", *p.PolicyArn, role, err)
+			}
+		}
+		inline, err := helper.GetAllInstances(api.IamApi.IamServiceListRolePolicies(ctx).RoleName(role).XEmcNamespace(namespace))
+		if err != nil {
+			return fmt.Errorf("could not list inline policies of IAM role %s: %w", role, err)
+		}
+		for _, p := range inline {
+			if _, _, err := api.IamApi.IamServiceDeleteRolePolicy(ctx).RoleName(role).PolicyName(p).XEmcNamespace(namespace).Execute(); err != nil {
+				return fmt.Errorf("could not delete inline policy %s of IAM role %s: %w", p, role, err)
+			}
+		}
+		if _, _, err := api.IamApi.IamServiceDeleteRole(ctx).RoleName(role).XEmcNamespace(namespace).Execute(); err != nil {
+			return fmt.Errorf("could not delete IAM role %s: %w", role, err)
+		}
+	}
+
+	for _, user := range deps.IAMUsers {
+		tflog.Info(ctx, "deleting IAM user of namespace", map[string]interface{}{"namespace": namespace, "user": user})
+		groups, err := helper.GetAllInstances(api.IamApi.IamServiceListGroupsForUser(ctx).UserName(user).XEmcNamespace(namespace))
+		if err != nil {
+			return fmt.Errorf("could not list groups of IAM user %s: %w", user, err)
+		}
+		for _, g := range groups {
+			if _, _, err := api.IamApi.IamServiceRemoveUserFromGroup(ctx).UserName(user).GroupName(*g.GroupName).XEmcNamespace(namespace).Execute(); err != nil {
+				return fmt.Errorf("could not remove IAM user %s from group %s: %w", user, *g.GroupName, err)
+			}
+		}
+		keys, _, err := api.IamApi.IamServiceListAccessKeys(ctx).UserName(user).XEmcNamespace(namespace).Execute()
+		if err != nil {
+			return fmt.Errorf("could not list access keys of IAM user %s: %w", user, err)
+		}
+		if keys.ListAccessKeysResult != nil {
+			for _, k := range keys.ListAccessKeysResult.AccessKeyMetadata {
+				if _, _, err := api.IamApi.IamServiceDeleteAccessKey(ctx).UserName(user).AccessKeyId(*k.AccessKeyId).XEmcNamespace(namespace).Execute(); err != nil {
+					return fmt.Errorf("could not delete access key %s of IAM user %s: %w", *k.AccessKeyId, user, err)
+				}
+			}
+		}
+		attached, err := helper.GetAllInstances(api.IamApi.IamServiceListAttachedUserPolicies(ctx).UserName(user).XEmcNamespace(namespace))
+		if err != nil {
+			return fmt.Errorf("could not list policies attached to IAM user %s: %w", user, err)
+		}
+		for _, p := range attached {
+			if _, _, err := api.IamApi.IamServiceDetachUserPolicy(ctx).UserName(user).PolicyArn(*p.PolicyArn).XEmcNamespace(namespace).Execute(); err != nil {
+				return fmt.Errorf("could not detach policy %s from IAM user %s: %w", *p.PolicyArn, user, err)
+			}
+		}
+		inline, err := helper.GetAllInstances(api.IamApi.IamServiceListUserPolicies(ctx).UserName(user).XEmcNamespace(namespace))
+		if err != nil {
+			return fmt.Errorf("could not list inline policies of IAM user %s: %w", user, err)
+		}
+		for _, p := range inline {
+			if _, _, err := api.IamApi.IamServiceDeleteUserPolicy(ctx).UserName(user).PolicyName(p).XEmcNamespace(namespace).Execute(); err != nil {
+				return fmt.Errorf("could not delete inline policy %s of IAM user %s: %w", p, user, err)
+			}
+		}
+		if _, _, err := api.IamApi.IamServiceDeleteUser(ctx).UserName(user).XEmcNamespace(namespace).Execute(); err != nil {
+			return fmt.Errorf("could not delete IAM user %s: %w", user, err)
+		}
+	}
+
+	for _, group := range deps.IAMGroups {
+		tflog.Info(ctx, "deleting IAM group of namespace", map[string]interface{}{"namespace": namespace, "group": group})
+		attached, err := helper.GetAllInstances(api.IamApi.IamServiceListAttachedGroupPolicies(ctx).GroupName(group).XEmcNamespace(namespace))
+		if err != nil {
+			return fmt.Errorf("could not list policies attached to IAM group %s: %w", group, err)
+		}
+		for _, p := range attached {
+			if _, _, err := api.IamApi.IamServiceDetachGroupPolicy(ctx).GroupName(group).PolicyArn(*p.PolicyArn).XEmcNamespace(namespace).Execute(); err != nil {
+				return fmt.Errorf("could not detach policy %s from IAM group %s: %w", *p.PolicyArn, group, err)
+			}
+		}
+		inline, err := helper.GetAllInstances(api.IamApi.IamServiceListGroupPolicies(ctx).GroupName(group).XEmcNamespace(namespace))
+		if err != nil {
+			return fmt.Errorf("could not list inline policies of IAM group %s: %w", group, err)
+		}
+		for _, p := range inline {
+			if _, _, err := api.IamApi.IamServiceDeleteGroupPolicy(ctx).GroupName(group).PolicyName(p).XEmcNamespace(namespace).Execute(); err != nil {
+				return fmt.Errorf("could not delete inline policy %s of IAM group %s: %w", p, group, err)
+			}
+		}
+		if _, _, err := api.IamApi.IamServiceDeleteGroup(ctx).GroupName(group).XEmcNamespace(namespace).Execute(); err != nil {
+			return fmt.Errorf("could not delete IAM group %s: %w", group, err)
+		}
+	}
+
+	for _, arn := range deps.IAMPolicies {
+		tflog.Info(ctx, "deleting IAM policy of namespace", map[string]interface{}{"namespace": namespace, "policy": arn})
+		versions, err := helper.GetAllInstances(api.IamApi.IamServiceListPolicyVersions(ctx).PolicyArn(arn).XEmcNamespace(namespace))
+		if err != nil {
+			return fmt.Errorf("could not list versions of IAM policy %s: %w", arn, err)
+		}
+		// the default version is deleted with the policy
+		for _, v := range versions {
+			if v.IsDefaultVersion != nil && *v.IsDefaultVersion {
+				continue
+			}
+			if _, _, err := api.IamApi.IamServiceDeletePolicyVersion(ctx).PolicyArn(arn).VersionId(*v.VersionId).XEmcNamespace(namespace).Execute(); err != nil {
+				return fmt.Errorf("could not delete version %s of IAM policy %s: %w", *v.VersionId, arn, err)
+			}
+		}
+		if _, _, err := api.IamApi.IamServiceDeletePolicy(ctx).PolicyArn(arn).XEmcNamespace(namespace).Execute(); err != nil {
+			return fmt.Errorf("could not delete IAM policy %s: %w", arn, err)
+		}
+	}
+
+	for _, arn := range deps.SAMLProviders {
+		tflog.Info(ctx, "deleting SAML provider of namespace", map[string]interface{}{"namespace": namespace, "saml_provider": arn})
+		_, _, err := api.IamApi.IamServiceDeleteSAMLProvider(ctx).SAMLProviderArn(arn).XEmcNamespace(namespace).Execute()
+		if err != nil && !client.IsNotFound(err) {
+			return fmt.Errorf("could not delete SAML provider %s: %w", arn, err)
+		}
+	}
+
+	for _, user := range deps.ObjectUsers {
+		tflog.Info(ctx, "deleting object user of namespace", map[string]interface{}{"namespace": namespace, "user": user})
+		_, _, err := api.UserManagementApi.UserManagementServiceRemoveUser(ctx).
+			UserManagementServiceRemoveUserRequest(clientgen.UserManagementServiceRemoveUserRequest{
+				Namespace: clientgen.PtrString(namespace),
+				User:      user,
+			}).
+			Execute()
+		if err != nil {
+			return fmt.Errorf("could not delete object user %s: %w", user, err)
+		}
+	}
+
+	return nil
+}
@@ -69,7 +69,7 @@ func TestAccNSRs(t *testing.T) {
 				`,
 				ResourceName:  "objectscale_namespace.all",
 				ImportState:   true,
-				ExpectError:   regexp.MustCompile(".*Error reading namespace.*"),
+				ExpectError:   regexp.MustCompile(".*Cannot import non-existent remote object.*"),
 				ImportStateId: "invalid-id",
 			},
 			{
@@ -623,3 +623,113 @@ func TestNamespaceResource_stringToBool(t *testing.T) {
 		})
 	}
 }
+
+// Test to destroy a namespace which still contains entities.
+func TestAccNSRsForceDestroy(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+	defer testUserTokenCleanup(t)
+	var listM, deleteM *mockey.Mocker
+	unPatchFunc := func() {
+		for _, m := range []*mockey.Mocker{listM, deleteM} {
+			if m != nil {
+				m.UnPatch()
+			}
+		}
+	}
+	mockDependencies := func() {
+		listM = mockey.Mock((*NamespaceResource).listDependencies).Return(
+			namespaceDependencies{Buckets: []string{"bucket1"}}, nil,
+		).Build()
+	}
+
+	nsConfig := func(forceDestroy bool) string {
+		return ProviderConfigForTesting + namespace_preq_rgs + fmt.Sprintf(`
+		resource"objectscale_namespace" "all" {
+			name                        = "testacc_namespace_destroy"
+			default_data_services_vpool = local.rgs["rg1"]
+			force_destroy               = %t
+		}
+		`, forceDestroy)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// create
+				Config: nsConfig(false),
+				Check:  resource.TestCheckResourceAttr("objectscale_namespace.all", "force_destroy", "false"),
+			},
+			{
+				// destroy error, the namespace is not empty
+				PreConfig:   mockDependencies,
+				Config:      ProviderConfigForTesting + namespace_preq_rgs,
+				ExpectError: regexp.MustCompile(`(?s)still contains.*1 buckets: bucket1`),
+			},
+			{
+				// enable force destroy
+				PreConfig: unPatchFunc,
+				Config:    nsConfig(true),
+				Check:     resource.TestCheckResourceAttr("objectscale_namespace.all", "force_destroy", "true"),
+			},
+			{
+				// destroy error, the entities cannot be deleted
+				PreConfig: func() {
+					mockDependencies()
+					deleteM = mockey.Mock((*NamespaceResource).deleteDependencies).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + namespace_preq_rgs,
+				ExpectError: regexp.MustCompile(`Could not delete the entities of the namespace: mock error`),
+			},
+			{
+				// destroy
+				PreConfig: unPatchFunc,
+				Config:    ProviderConfigForTesting + namespace_preq_rgs,
+			},
+		},
+	})
+}
+
+func TestNamespaceDependencies(t *testing.T) {
+	deps := namespaceDependencies{}
+	assert.True(t, deps.isEmpty())
+	assert.Equal(t, "", deps.String())
+
+	for i := 0; i < 12; i++ {
+		deps.Buckets = append(deps.Buckets, fmt.Sprintf("b%d", i))
+	}
+	deps.SAMLProviders = []string{"urn:ecs:iam::ns1:saml-provider/idp1"}
+	assert.False(t, deps.isEmpty())
+	assert.Equal(t,
+		"  - 12 buckets: b0, b1, b2, b3, b4, b5, b6, b7, b8, b9 and 2 more\n"+
+			"  - 1 SAML providers: urn:ecs:iam::ns1:saml-provider/idp1\n",
+		deps.String())
+}
+
+func TestNamespaceDeleteDependencies(t *testing.T) {
+	r := &NamespaceResource{
+		resourceProviderConfig: resourceProviderConfig{
+			client: &client.Client{
+				GenClient: &clientgen.APIClient{
+					BucketApi: &clientgen.BucketApiService{},
+					IamApi:    &clientgen.IamApiService{},
+				},
+			},
+		},
+	}
+
+	m := mockey.Mock((*clientgen.BucketApiService).BucketServiceDeactivateBucketExecute).
+		Return(nil, nil, fmt.Errorf("mock error")).Build()
+	err := r.deleteDependencies(context.Background(), "ns1", namespaceDependencies{Buckets: []string{"bucket1"}})
+	m.UnPatch()
+	assert.EqualError(t, err, "could not delete bucket bucket1: mock error")
+
+	m = mockey.Mock((*clientgen.IamApiService).IamServiceListAttachedRolePoliciesExecute).
+		Return(nil, nil, fmt.Errorf("mock error")).Build()
+	err = r.deleteDependencies(context.Background(), "ns1", namespaceDependencies{IAMRoles: []string{"role1"}})
+	m.UnPatch()
+	assert.EqualError(t, err, "could not list policies attached to IAM role role1: mock error")
+}
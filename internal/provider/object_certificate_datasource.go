@@ -73,7 +73,7 @@ func (d *ObjectCertificateDataSource) Read(ctx context.Context, req datasource.R
 
 	chain, err := GetObjectCertKeystore(ctx, d.client)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading Object certificate", err.Error())
+		resp.Diagnostics.AddError("Error reading Object certificate", helper.APIErrorDetail(err))
 		return
 	}
 
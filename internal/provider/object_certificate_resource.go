@@ -22,6 +22,7 @@ import (
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -39,6 +40,7 @@ import (
 var _ resource.Resource = &ObjectCertificateResource{}
 var _ resource.ResourceWithImportState = &ObjectCertificateResource{}
 var _ resource.ResourceWithConfigValidators = &ObjectCertificateResource{}
+var _ resource.ResourceWithValidateConfig = &ObjectCertificateResource{}
 
 func NewObjectCertificateResource() resource.Resource {
 	return &ObjectCertificateResource{}
@@ -65,11 +67,14 @@ func (r *ObjectCertificateResource) Schema(ctx context.Context, req resource.Sch
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"private_key": schema.StringAttribute{
-				Description:         "Private key in PEM format. Supports PKCS#1 (RSA PRIVATE KEY) and PKCS#8 (PRIVATE KEY) formats. PKCS#8 is supported on OBS 4.3+, but OBS 4.1 requires PKCS#1. Required when system_selfsigned is not set. Mutually exclusive with system_selfsigned.",
-				MarkdownDescription: "Private key in PEM format. Supports PKCS#1 (`RSA PRIVATE KEY`) and PKCS#8 (`PRIVATE KEY`) formats. PKCS#8 is supported on OBS 4.3+, but OBS 4.1 requires PKCS#1. Required when `system_selfsigned` is not set. Mutually exclusive with `system_selfsigned`.",
+				Description:         "Private key in PEM format. Supports PKCS#1 (RSA PRIVATE KEY) and PKCS#8 (PRIVATE KEY) formats. PKCS#8 is supported on OBS 4.3+, but OBS 4.1 requires PKCS#1. Required when system_selfsigned is not set, unless private_key_wo is set. Mutually exclusive with system_selfsigned.",
+				MarkdownDescription: "Private key in PEM format. Supports PKCS#1 (`RSA PRIVATE KEY`) and PKCS#8 (`PRIVATE KEY`) formats. PKCS#8 is supported on OBS 4.3+, but OBS 4.1 requires PKCS#1. Required when `system_selfsigned` is not set, unless `private_key_wo` is set. Mutually exclusive with `system_selfsigned`.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"private_key_wo_version": helper.WriteOnlyVersionAttribute("private_key"),
+			"private_key_wo": helper.WriteOnlyAttribute("private_key",
+				"private key in PEM format, see `private_key`.", true),
 			"certificate_chain": schema.StringAttribute{
 				Description:         "Certificate chain in PEM format. Required when system_selfsigned is not set. Mutually exclusive with system_selfsigned.",
 				MarkdownDescription: "Certificate chain in PEM format. Required when `system_selfsigned` is not set. Mutually exclusive with `system_selfsigned`.",
@@ -93,6 +98,9 @@ func (r *ObjectCertificateResource) Schema(ctx context.Context, req resource.Sch
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true}),
+		},
 	}
 }
 
@@ -104,15 +112,33 @@ func (r *ObjectCertificateResource) ConfigValidators(_ context.Context) []resour
 		),
 		resourcevalidator.Conflicting(
 			path.MatchRoot("system_selfsigned"),
-			path.MatchRoot("certificate_chain"),
+			path.MatchRoot("private_key_wo"),
 		),
-		resourcevalidator.RequiredTogether(
-			path.MatchRoot("private_key"),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("system_selfsigned"),
 			path.MatchRoot("certificate_chain"),
 		),
 	}
 }
 
+// ValidateConfig checks that the certificate chain is configured together with a private key,
+// given either by private_key or by the write-only private_key_wo.
+func (r *ObjectCertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg models.ObjectCertificateResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if cfg.CertificateChain.IsUnknown() || cfg.PrivateKey.IsUnknown() || cfg.PrivateKeyWO.IsUnknown() {
+		return
+	}
+	hasKey := !cfg.PrivateKey.IsNull() || !cfg.PrivateKeyWO.IsNull()
+	if hasKey != !cfg.CertificateChain.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("certificate_chain"), "Invalid Attribute Combination",
+			"certificate_chain must be configured together with either private_key or private_key_wo.")
+	}
+}
+
 func (r *ObjectCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan models.ObjectCertificateResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -120,7 +146,19 @@ func (r *ObjectCertificateResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	r.applyObjectCertificate(ctx, &plan, &resp.Diagnostics, &resp.State)
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateKey, diags := helper.SecretValue(ctx, plan.PrivateKey, req.Config, "private_key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyObjectCertificate(ctx, &plan, privateKey.ValueString(), &resp.Diagnostics, &resp.State)
 }
 
 func (r *ObjectCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -130,15 +168,22 @@ func (r *ObjectCertificateResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	chain, err := GetObjectCertKeystore(ctx, r.client)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading Object certificate", err.Error())
+		resp.Diagnostics.AddError("Error reading Object certificate", helper.APIErrorDetail(err))
 		return
 	}
 
 	state.CurrentCertificateChain = types.StringValue(helper.NormalizeLineEndings(chain))
 
 	tflog.Trace(ctx, "read Object certificate resource")
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -149,7 +194,19 @@ func (r *ObjectCertificateResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
-	r.applyObjectCertificate(ctx, &plan, &resp.Diagnostics, &resp.State)
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateKey, diags := helper.SecretValue(ctx, plan.PrivateKey, req.Config, "private_key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyObjectCertificate(ctx, &plan, privateKey.ValueString(), &resp.Diagnostics, &resp.State)
 }
 
 func (r *ObjectCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -160,7 +217,7 @@ func (r *ObjectCertificateResource) Delete(ctx context.Context, req resource.Del
 func (r *ObjectCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	chain, err := GetObjectCertKeystore(ctx, r.client)
 	if err != nil {
-		resp.Diagnostics.AddError("Error importing Object certificate", err.Error())
+		resp.Diagnostics.AddError("Error importing Object certificate", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -171,13 +228,14 @@ func (r *ObjectCertificateResource) ImportState(ctx context.Context, req resourc
 }
 
 // applyObjectCertificate is shared between Create and Update.
-func (r *ObjectCertificateResource) applyObjectCertificate(ctx context.Context, plan *models.ObjectCertificateResourceModel, diagnostics *diag.Diagnostics, state *tfsdk.State) {
+// The private key is given apart from the plan, as it may be set by the write-only private_key_wo.
+func (r *ObjectCertificateResource) applyObjectCertificate(ctx context.Context, plan *models.ObjectCertificateResourceModel, privateKey string, diagnostics *diag.Diagnostics, state *tfsdk.State) {
 	isSelfSigned := !plan.SystemSelfsigned.IsNull() && plan.SystemSelfsigned.ValueBool()
 
 	if isSelfSigned {
 		r.applySelfSignedCert(ctx, plan, diagnostics, state)
 	} else {
-		r.applyCustomCert(ctx, plan, diagnostics, state)
+		r.applyCustomCert(ctx, plan, privateKey, diagnostics, state)
 	}
 }
 
@@ -193,7 +251,7 @@ func (r *ObjectCertificateResource) applySelfSignedCert(ctx context.Context, pla
 
 	chain, err := PutObjectCertSelfSigned(ctx, r.client, ipAddresses)
 	if err != nil {
-		diagnostics.AddError("Error generating self-signed Object certificate", err.Error())
+		diagnostics.AddError("Error generating self-signed Object certificate", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -204,25 +262,24 @@ func (r *ObjectCertificateResource) applySelfSignedCert(ctx context.Context, pla
 }
 
 // applyCustomCert uploads a custom certificate.
-func (r *ObjectCertificateResource) applyCustomCert(ctx context.Context, plan *models.ObjectCertificateResourceModel, diagnostics *diag.Diagnostics, state *tfsdk.State) {
+func (r *ObjectCertificateResource) applyCustomCert(ctx context.Context, plan *models.ObjectCertificateResourceModel, privateKeyRaw string, diagnostics *diag.Diagnostics, state *tfsdk.State) {
 	// Validate PEM private key
-	privateKeyRaw := plan.PrivateKey.ValueString()
 	if err := helper.ValidatePEMPrivateKey(privateKeyRaw); err != nil {
-		diagnostics.AddError("Invalid Private Key", err.Error())
+		diagnostics.AddError("Invalid Private Key", helper.APIErrorDetail(err))
 		return
 	}
 
 	// Validate PEM certificate chain
 	certChainRaw := plan.CertificateChain.ValueString()
 	if err := helper.ValidatePEMCertificate(certChainRaw); err != nil {
-		diagnostics.AddError("Invalid Certificate Chain", err.Error())
+		diagnostics.AddError("Invalid Certificate Chain", helper.APIErrorDetail(err))
 		return
 	}
 
 	// Validate private key format (must be PKCS#1)
 	normalizedKey, err := helper.ValidateAndNormalizePrivateKey(privateKeyRaw)
 	if err != nil {
-		diagnostics.AddError("Invalid Private Key Format", err.Error())
+		diagnostics.AddError("Invalid Private Key Format", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -231,7 +288,7 @@ func (r *ObjectCertificateResource) applyCustomCert(ctx context.Context, plan *m
 	// Idempotency check: GET current chain and compare
 	currentChain, err := GetObjectCertKeystore(ctx, r.client)
 	if err != nil {
-		diagnostics.AddError("Error reading current Object certificate for idempotency check", err.Error())
+		diagnostics.AddError("Error reading current Object certificate for idempotency check", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -239,7 +296,7 @@ func (r *ObjectCertificateResource) applyCustomCert(ctx context.Context, plan *m
 		tflog.Info(ctx, "Object certificate chain unchanged, skipping PUT")
 	} else {
 		if err := PutObjectCertKeystore(ctx, r.client, normalizedKey, normalizedCertChain); err != nil {
-			diagnostics.AddError("Error updating Object certificate", err.Error())
+			diagnostics.AddError("Error updating Object certificate", helper.APIErrorDetail(err))
 			return
 		}
 	}
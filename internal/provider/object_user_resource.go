@@ -23,6 +23,8 @@ import (
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
@@ -102,6 +104,9 @@ func (r *ObjectUserResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -126,6 +131,12 @@ func (r *ObjectUserResource) Create(ctx context.Context, req resource.CreateRequ
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -142,14 +153,14 @@ func (r *ObjectUserResource) Create(ctx context.Context, req resource.CreateRequ
 			Tags:      planJson.Tags,
 		}).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating user", err.Error())
+		resp.Diagnostics.AddError("Error creating user", helper.APIErrorDetail(err))
 		return
 	}
 
 	object_user, _, err := r.client.GenClient.UserManagementApi.UserManagementServiceGetUserInfo(ctx, plan.Name.ValueString()).
 		Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading user after creation", err.Error())
+		resp.Diagnostics.AddError("Error reading user after creation", helper.APIErrorDetail(err))
 		return
 	}
 	data := r.getModel(&clientgen.UserManagementServiceGetUserInfoResponse{
@@ -161,6 +172,7 @@ func (r *ObjectUserResource) Create(ctx context.Context, req resource.CreateRequ
 	}, helper.TfStringNN(&object_user.Name))
 
 	// Save data into Terraform state
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -175,10 +187,19 @@ func (r *ObjectUserResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	object_user, _, err := r.client.GenClient.UserManagementApi.UserManagementServiceGetUserInfo(ctx, state.Name.ValueString()).
 		Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading user", err.Error())
+		if helper.RemoveNotFound(ctx, err, &resp.State, "object user "+state.Name.ValueString()) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading user", helper.APIErrorDetail(err))
 		return
 	}
 	data := r.getModel(&clientgen.UserManagementServiceGetUserInfoResponse{
@@ -190,6 +211,7 @@ func (r *ObjectUserResource) Read(ctx context.Context, req resource.ReadRequest,
 	}, helper.TfStringNN(&object_user.Name))
 
 	// Save data into Terraform state
+	data.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -286,6 +308,12 @@ func (r *ObjectUserResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if !plan.Tags.IsNull() || !plan.Tags.IsUnknown() || len(plan.Tags.Elements()) != 0 {
 		tags_plan, tags_state := helper.ValueListTransform(plan.Tags, r.tagJson),
 			helper.ValueListTransform(state.Tags, r.tagJson)
@@ -339,14 +367,14 @@ func (r *ObjectUserResource) Update(ctx context.Context, req resource.UpdateRequ
 			Execute()
 
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating locked status", err.Error())
+			resp.Diagnostics.AddError("Error updating locked status", helper.APIErrorDetail(err))
 			return
 		}
 	}
 	object_user, _, err := r.client.GenClient.UserManagementApi.UserManagementServiceGetUserInfo(ctx, state.Name.ValueString()).
 		Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading user after modification", err.Error())
+		resp.Diagnostics.AddError("Error reading user after modification", helper.APIErrorDetail(err))
 		return
 	}
 	data := r.getModel(&clientgen.UserManagementServiceGetUserInfoResponse{
@@ -358,6 +386,7 @@ func (r *ObjectUserResource) Update(ctx context.Context, req resource.UpdateRequ
 	}, helper.TfStringNN(&object_user.Name))
 
 	// Save data into Terraform state
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -372,6 +401,12 @@ func (r *ObjectUserResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, _, err := r.client.GenClient.UserManagementApi.UserManagementServiceRemoveUser(ctx).
 		UserManagementServiceRemoveUserRequest(clientgen.UserManagementServiceRemoveUserRequest{
 			Namespace: state.Namespace.ValueStringPointer(),
@@ -382,7 +417,7 @@ func (r *ObjectUserResource) Delete(ctx context.Context, req resource.DeleteRequ
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting Object user",
-			err.Error(),
+			helper.APIErrorDetail(err),
 		)
 	}
 }
@@ -393,7 +428,7 @@ func (r *ObjectUserResource) ImportState(ctx context.Context, req resource.Impor
 	object_user, _, err := r.client.GenClient.UserManagementApi.UserManagementServiceGetUserInfo(ctx, req.ID).
 		Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading user after import", err.Error())
+		resp.Diagnostics.AddError("Error reading user after import", helper.APIErrorDetail(err))
 		return
 	}
 	data := r.getModel(&clientgen.UserManagementServiceGetUserInfoResponse{
@@ -404,6 +439,7 @@ func (r *ObjectUserResource) ImportState(ctx context.Context, req resource.Impor
 		Name:      object_user.Name,
 	}, helper.TfStringNN(&object_user.Name))
 
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
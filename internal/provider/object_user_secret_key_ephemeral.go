@@ -0,0 +1,182 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"terraform-provider-objectscale/internal/client"
+	"terraform-provider-objectscale/internal/clientgen"
+	"terraform-provider-objectscale/internal/helper"
+	"terraform-provider-objectscale/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &ObjectUserSecretKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ObjectUserSecretKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &ObjectUserSecretKeyEphemeralResource{}
+
+// secretKeyPrivateKey is the private data key under which the secret key to revoke on close is kept.
+const secretKeyPrivateKey = "secret_key"
+
+func NewObjectUserSecretKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &ObjectUserSecretKeyEphemeralResource{}
+}
+
+// ObjectUserSecretKeyEphemeralResource defines the ephemeral resource implementation.
+type ObjectUserSecretKeyEphemeralResource struct {
+	ephemeralProviderConfig
+}
+
+// objectUserSecretKeyPrivate identifies the secret key to revoke on close.
+type objectUserSecretKeyPrivate struct {
+	UserName  string `json:"username"`
+	Namespace string `json:"namespace"`
+	Id        string `json:"id"`
+}
+
+func (e *ObjectUserSecretKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_user_secret_key"
+}
+
+func (e *ObjectUserSecretKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This ephemeral resource creates a new S3 secret key for a Dell ObjectScale object user for the duration of a Terraform run, without storing it in the state or plan. " +
+			"A new key is created each time Terraform opens the ephemeral resource, and revoked when Terraform closes it, unless `revoke_on_close` is `false`.",
+		Description: "This ephemeral resource creates a new S3 secret key for a Dell ObjectScale object user for the duration of a Terraform run, without storing it in the state or plan. " +
+			"A new key is created each time Terraform opens the ephemeral resource, and revoked when Terraform closes it, unless revoke_on_close is false.",
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Description:         "Name of the user to which the key is attached. Required.",
+				MarkdownDescription: "Name of the user to which the key is attached. Required.",
+				Required:            true,
+			},
+			"namespace": schema.StringAttribute{
+				Description:         "Namespace to which the user belongs to.",
+				MarkdownDescription: "Namespace to which the user belongs to.",
+				Required:            true,
+			},
+			"expiry_in_mins": schema.StringAttribute{
+				Description:         "Expiry of the existing secret key in minutes.",
+				MarkdownDescription: "Expiry of the existing secret key in minutes.",
+				Optional:            true,
+			},
+			"revoke_on_close": schema.BoolAttribute{
+				Description: "Whether the secret key is deleted when Terraform closes the ephemeral resource, at the end of each plan and apply. " +
+					"Set it to false when the key is written to an external store, e.g. a vault, that outlives the run. Defaults to true.",
+				MarkdownDescription: "Whether the secret key is deleted when Terraform closes the ephemeral resource, at the end of each plan and apply. " +
+					"Set it to `false` when the key is written to an external store, e.g. a vault, that outlives the run. Defaults to `true`.",
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the secret key that is generated by ObjectScale.",
+				MarkdownDescription: "Identifier of the secret key that is generated by ObjectScale.",
+				Computed:            true,
+			},
+			"secret_key": schema.StringAttribute{
+				Description:         "Secret key associated with the user.",
+				MarkdownDescription: "Secret key associated with the user.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"key_timestamp": schema.StringAttribute{
+				Description:         "Timestamp of creation of the key.",
+				MarkdownDescription: "Timestamp of creation of the key.",
+				Computed:            true,
+			},
+			"key_expiry_timestamp": schema.StringAttribute{
+				Description:         "Expiry timestamp of the key.",
+				MarkdownDescription: "Expiry timestamp of the key.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *ObjectUserSecretKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	tflog.Info(ctx, "opening ephemeral secret key")
+	var data models.ObjectUserSecretKeyEphemeralModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := clientgen.UserSecretKeyServiceCreateNewKeyForUserRequest{
+		Namespace:                 data.Namespace.ValueStringPointer(),
+		ExistingKeyExpiryTimeMins: data.ExpiryInMins.ValueStringPointer(),
+	}
+	key, _, err := e.client.GenClient.UserSecretKeyApi.UserSecretKeyServiceCreateNewKeyForUser(ctx, data.UserName.ValueString()).
+		UserSecretKeyServiceCreateNewKeyForUserRequest(body).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating secret key for the user", helper.APIErrorDetail(err))
+		return
+	}
+
+	data.Id = helper.TfString(key.SecretKeyId)
+	data.SecretKey = helper.TfString(key.SecretKey)
+	data.KeyTimestamp = helper.TfString(key.KeyTimestamp)
+	data.KeyExpiryTimestamp = helper.TfString(key.KeyExpiryTimestamp)
+
+	if data.RevokeOnClose.IsNull() || data.RevokeOnClose.ValueBool() {
+		private, err := json.Marshal(objectUserSecretKeyPrivate{
+			UserName:  data.UserName.ValueString(),
+			Namespace: data.Namespace.ValueString(),
+			Id:        data.Id.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error saving the secret key to revoke", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, secretKeyPrivateKey, private)...)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *ObjectUserSecretKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, secretKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var key objectUserSecretKeyPrivate
+	if err := json.Unmarshal(private, &key); err != nil {
+		resp.Diagnostics.AddError("Error reading the secret key to revoke", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "revoking ephemeral secret key "+key.Id)
+	_, _, err := e.client.GenClient.UserSecretKeyApi.UserSecretKeyServiceDeleteKeyForUser(ctx, key.UserName).
+		UserSecretKeyServiceDeleteKeyForUserRequest(clientgen.UserSecretKeyServiceDeleteKeyForUserRequest{
+			Namespace:   &key.Namespace,
+			SecretKeyId: &key.Id,
+		}).
+		Execute()
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error revoking secret key "+key.Id, helper.APIErrorDetail(err))
+	}
+}
@@ -25,6 +25,8 @@ import (
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
@@ -92,6 +94,9 @@ func (r *ObjectUserSecretKeyResource) Schema(ctx context.Context, req resource.S
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Delete: true}),
+		},
 	}
 }
 
@@ -106,6 +111,12 @@ func (r *ObjectUserSecretKeyResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	create_secret_key_resp := r.client.GenClient.UserSecretKeyApi.UserSecretKeyServiceCreateNewKeyForUser(ctx, plan.UserName.ValueString())
 	ns := plan.Namespace.ValueString()
 	secret_key := plan.SecretKey.ValueString()
@@ -124,7 +135,7 @@ func (r *ObjectUserSecretKeyResource) Create(ctx context.Context, req resource.C
 				Secretkey:                 &secret_key,
 			}).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating secret key for the user", err.Error())
+			resp.Diagnostics.AddError("Error creating secret key for the user", helper.APIErrorDetail(err))
 			return
 		}
 	} else {
@@ -134,7 +145,7 @@ func (r *ObjectUserSecretKeyResource) Create(ctx context.Context, req resource.C
 				Secretkey: &secret_key,
 			}).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating secret key for the user", err.Error())
+			resp.Diagnostics.AddError("Error creating secret key for the user", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -143,7 +154,7 @@ func (r *ObjectUserSecretKeyResource) Create(ctx context.Context, req resource.C
 		Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading user secret key", err.Error())
+		resp.Diagnostics.AddError("Error reading user secret key", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -173,6 +184,7 @@ func (r *ObjectUserSecretKeyResource) Create(ctx context.Context, req resource.C
 	}
 
 	// Save data into Terraform state
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -187,12 +199,21 @@ func (r *ObjectUserSecretKeyResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	kResp, _, err := r.client.GenClient.UserSecretKeyApi.
 		UserSecretKeyServiceGetKeysForUser(ctx, state.UserName.ValueString()).
 		Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading user secret key", err.Error())
+		if helper.RemoveNotFound(ctx, err, &resp.State, "secret key "+state.Id.ValueString()) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading user secret key", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -220,7 +241,13 @@ func (r *ObjectUserSecretKeyResource) Read(ctx context.Context, req resource.Rea
 			}
 		}
 	}
+	if data.Id.IsNull() {
+		// the key was deleted outside of Terraform
+		helper.RemoveMissing(ctx, &resp.State, "secret key "+state.Id.ValueString())
+		return
+	}
 	// Save updated plan into Terraform state
+	data.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -236,6 +263,12 @@ func (r *ObjectUserSecretKeyResource) Delete(ctx context.Context, req resource.D
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -253,7 +286,7 @@ func (r *ObjectUserSecretKeyResource) Delete(ctx context.Context, req resource.D
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Object user secret key",
-			err.Error(),
+			helper.APIErrorDetail(err),
 		)
 	}
 }
@@ -276,7 +309,7 @@ func (r *ObjectUserSecretKeyResource) ImportState(ctx context.Context, req resou
 		Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading user secret key", err.Error())
+		resp.Diagnostics.AddError("Error reading user secret key", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -305,6 +338,7 @@ func (r *ObjectUserSecretKeyResource) ImportState(ctx context.Context, req resou
 			}
 		}
 	}
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
 	// Save updated plan into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
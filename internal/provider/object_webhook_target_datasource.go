@@ -0,0 +1,169 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-objectscale/internal/clientgen"
+	"terraform-provider-objectscale/internal/helper"
+	"terraform-provider-objectscale/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ObjectWebhookTargetDataSource{}
+
+func NewObjectWebhookTargetDataSource() datasource.DataSource {
+	return &ObjectWebhookTargetDataSource{}
+}
+
+type ObjectWebhookTargetDataSource struct {
+	datasourceProviderConfig
+}
+
+func (d *ObjectWebhookTargetDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_webhook_target"
+}
+
+func (d *ObjectWebhookTargetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource can be used to fetch the object webhook targets of the namespace of the current user from Dell ObjectScale.",
+		Description:         "This datasource can be used to fetch the object webhook targets of the namespace of the current user from Dell ObjectScale.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the datasource.",
+				MarkdownDescription: "Identifier of the datasource.",
+				Computed:            true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Description:         "Case-sensitive prefix of the names of the webhook targets to fetch. If none given, all the webhook targets are fetched.",
+				MarkdownDescription: "Case-sensitive prefix of the names of the webhook targets to fetch. If none given, all the webhook targets are fetched.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"webhook_targets": schema.ListNestedAttribute{
+				Description:         "List of object webhook targets fetched using this datasource.",
+				MarkdownDescription: "List of object webhook targets fetched using this datasource.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "URN of the webhook target, used as topic ARN in the bucket notifications.",
+							MarkdownDescription: "URN of the webhook target, used as topic ARN in the bucket notifications.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							Description:         "Name of the webhook target.",
+							MarkdownDescription: "Name of the webhook target.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							Description:         "URL[:port] receiving the notifications.",
+							MarkdownDescription: "URL[:port] receiving the notifications.",
+							Computed:            true,
+						},
+						"ca_certificate": schema.StringAttribute{
+							Description:         "PEM encoded X.509 certificate used to validate the server certificate of the webhook target.",
+							MarkdownDescription: "PEM encoded X.509 certificate used to validate the server certificate of the webhook target.",
+							Computed:            true,
+						},
+						"comment": schema.StringAttribute{
+							Description:         "Comment of the webhook target.",
+							MarkdownDescription: "Comment of the webhook target.",
+							Computed:            true,
+						},
+						"pre_backoff_retries": schema.Int64Attribute{
+							Description:         "Number of retries of a notification before backing off, while the webhook target is offline.",
+							MarkdownDescription: "Number of retries of a notification before backing off, while the webhook target is offline.",
+							Computed:            true,
+						},
+						"backoff_retries": schema.Int64Attribute{
+							Description:         "Number of retries of a notification after backing off, while the webhook target is offline.",
+							MarkdownDescription: "Number of retries of a notification after backing off, while the webhook target is offline.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description:         "Whether the webhook target is enabled.",
+							MarkdownDescription: "Whether the webhook target is enabled.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							Description:         "Status of the webhook target, ex. ENABLED or DISABLED_RETRY_INTERVAL_EXCEEDED.",
+							MarkdownDescription: "Status of the webhook target, ex. `ENABLED` or `DISABLED_RETRY_INTERVAL_EXCEEDED`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ObjectWebhookTargetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.ObjectWebhookTargetDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// list webhook targets request
+	dsreq := d.client.GenClient.WebhookConfigurationApi.WebhookConfigurationServiceGetObjectWebhookTargets(ctx)
+	if prefix := helper.ValueToPointer[string](data.NamePrefix); prefix != nil {
+		// the array expects a wildcard after the prefix
+		dsreq = dsreq.Prefix(*prefix + "*")
+	}
+	targets, err := helper.GetAllInstances(dsreq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching object webhook targets", helper.APIErrorDetail(err))
+		return
+	}
+
+	// hardcoding a response value to save into the Terraform state.
+	data.ID = types.StringValue("object_webhook_target_datasource")
+	data.WebhookTargets = d.updateState(targets)
+
+	tflog.Trace(ctx, "read object webhook target data source done")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ObjectWebhookTargetDataSource) updateState(targets []clientgen.ObjectWebhookTarget) []models.ObjectWebhookTargetDataSourceItem {
+	return helper.SliceTransform(targets, func(v clientgen.ObjectWebhookTarget) models.ObjectWebhookTargetDataSourceItem {
+		return models.ObjectWebhookTargetDataSourceItem{
+			ID:                helper.TfStringNN(v.Id),
+			Name:              helper.TfStringNN(v.Name),
+			URL:               helper.TfStringNN(v.Url),
+			CaCertificate:     helper.TfStringNN(v.CaCertificate),
+			Comment:           helper.TfStringNN(v.Comment),
+			PreBackoffRetries: webhookTargetInt64(v.PreBackoffRetries, types.Int64Null()),
+			BackoffRetries:    webhookTargetInt64(v.BackoffRetries, types.Int64Null()),
+			Enabled:           webhookTargetBool(v.IsEnabled, types.BoolNull()),
+			Status:            helper.TfStringNN(v.Status),
+		}
+	})
+}
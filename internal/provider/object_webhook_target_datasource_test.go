@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-objectscale/internal/clientgen"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccObjectWebhookTargetDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+	defer testUserTokenCleanup(t)
+
+	var mockAPI *mockey.Mocker
+	unPatchFunc := func() {
+		if mockAPI != nil {
+			mockAPI.UnPatch()
+		}
+	}
+
+	webhookConfig := ProviderConfigForTesting + `
+	resource "objectscale_object_webhook_target" "test" {
+		name = "tfacc-webhook-ds"
+		url = "https://webhook.example.com:8443/events"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// get all
+				Config: webhookConfig + `
+				data "objectscale_object_webhook_target" "all" {
+					depends_on = [objectscale_object_webhook_target.test]
+				}
+				`,
+				Check: resource.TestCheckResourceAttrSet("data.objectscale_object_webhook_target.all", "webhook_targets.#"),
+			},
+			{
+				// get by name prefix
+				Config: webhookConfig + `
+				data "objectscale_object_webhook_target" "by_prefix" {
+					name_prefix = "tfacc-webhook-d"
+					depends_on = [objectscale_object_webhook_target.test]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.objectscale_object_webhook_target.by_prefix", "webhook_targets.#", "1"),
+					resource.TestCheckResourceAttr("data.objectscale_object_webhook_target.by_prefix", "webhook_targets.0.name", "tfacc-webhook-ds"),
+				),
+			},
+			{
+				// get by invalid name prefix
+				Config: ProviderConfigForTesting + `
+				data "objectscale_object_webhook_target" "by_invalid_prefix" {
+					name_prefix = "invalid-webhook"
+				}
+				`,
+				Check: resource.TestCheckResourceAttr("data.objectscale_object_webhook_target.by_invalid_prefix", "webhook_targets.#", "0"),
+			},
+			{
+				// list mock error
+				PreConfig: func() {
+					mockAPI = mockey.Mock((*clientgen.WebhookConfigurationApiService).WebhookConfigurationServiceGetObjectWebhookTargetsExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				Config: ProviderConfigForTesting + `
+				data "objectscale_object_webhook_target" "all" {
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Error fetching object webhook targets`),
+			},
+			{
+				PreConfig: unPatchFunc,
+				Config:    webhookConfig,
+			},
+		},
+	})
+}
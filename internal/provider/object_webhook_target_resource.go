@@ -0,0 +1,355 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"strconv"
+	"terraform-provider-objectscale/internal/clientgen"
+	"terraform-provider-objectscale/internal/helper"
+	"terraform-provider-objectscale/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ObjectWebhookTargetResource{}
+var _ resource.ResourceWithImportState = &ObjectWebhookTargetResource{}
+
+func NewObjectWebhookTargetResource() resource.Resource {
+	return &ObjectWebhookTargetResource{}
+}
+
+// ObjectWebhookTargetResource defines the resource implementation.
+type ObjectWebhookTargetResource struct {
+	resourceProviderConfig
+}
+
+func (r *ObjectWebhookTargetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_webhook_target"
+}
+
+func (r *ObjectWebhookTargetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This resource manages object webhook targets on Dell ObjectScale. A webhook target receives the S3 event notifications of the buckets, and is created in the namespace of the user configured in the provider.",
+		MarkdownDescription: "This resource manages object webhook targets on Dell ObjectScale. A webhook target receives the S3 event notifications of the buckets, and is created in the namespace of the user configured in the provider.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "URN of the webhook target, used as topic ARN in the bucket notifications.",
+				MarkdownDescription: "URN of the webhook target, used as topic ARN in the bucket notifications.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the webhook target. Cannot be updated.",
+				MarkdownDescription: "Name of the webhook target. Cannot be updated.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"url": schema.StringAttribute{
+				Description:         "URL[:port] receiving the notifications. The default port is 443 when ca_certificate is set, 80 otherwise. The URL must be reachable from ObjectScale.",
+				MarkdownDescription: "URL[:port] receiving the notifications. The default port is 443 when `ca_certificate` is set, 80 otherwise. The URL must be reachable from ObjectScale.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"auth_token": schema.StringAttribute{
+				Description:         "JWT or opaque token sent in the Authorization header of each notification. It is not returned by the array, so changes made outside of Terraform are not detected.",
+				MarkdownDescription: "JWT or opaque token sent in the Authorization header of each notification. It is not returned by the array, so changes made outside of Terraform are not detected.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"ca_certificate": schema.StringAttribute{
+				Description:         "PEM encoded X.509 certificate used to validate the server certificate of the webhook target.",
+				MarkdownDescription: "PEM encoded X.509 certificate used to validate the server certificate of the webhook target.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"comment": schema.StringAttribute{
+				Description:         "Comment of the webhook target.",
+				MarkdownDescription: "Comment of the webhook target.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"pre_backoff_retries": schema.Int64Attribute{
+				Description:         "Number of retries of a notification before backing off, while the webhook target is offline.",
+				MarkdownDescription: "Number of retries of a notification before backing off, while the webhook target is offline.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"backoff_retries": schema.Int64Attribute{
+				Description:         "Number of retries of a notification after backing off, while the webhook target is offline.",
+				MarkdownDescription: "Number of retries of a notification after backing off, while the webhook target is offline.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"enabled": schema.BoolAttribute{
+				Description:         "Whether the webhook target is enabled. A disabled target does not receive nor back up the notifications.",
+				MarkdownDescription: "Whether the webhook target is enabled. A disabled target does not receive nor back up the notifications.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"status": schema.StringAttribute{
+				Description:         "Status of the webhook target, ex. ENABLED or DISABLED_RETRY_INTERVAL_EXCEEDED.",
+				MarkdownDescription: "Status of the webhook target, ex. `ENABLED` or `DISABLED_RETRY_INTERVAL_EXCEEDED`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+// helper function to convert a number returned as string by the webhook target APIs.
+// The configured value is kept when the array does not return it.
+func webhookTargetInt64(in *string, configured types.Int64) types.Int64 {
+	if in != nil && *in != "" {
+		if v, err := strconv.ParseInt(*in, 10, 64); err == nil {
+			return types.Int64Value(v)
+		}
+	}
+	if helper.IsKnown(configured) {
+		return configured
+	}
+	return types.Int64Null()
+}
+
+// helper function to convert a boolean returned as string by the webhook target APIs.
+// The configured value is kept when the array does not return it.
+func webhookTargetBool(in *string, configured types.Bool) types.Bool {
+	if in != nil && *in != "" {
+		if v, err := strconv.ParseBool(*in); err == nil {
+			return types.BoolValue(v)
+		}
+	}
+	if helper.IsKnown(configured) {
+		return configured
+	}
+	return types.BoolNull()
+}
+
+// helper function to convert a number to the string expected by the webhook target APIs.
+func webhookTargetInt64Param(in types.Int64) *string {
+	if !helper.IsKnown(in) {
+		return nil
+	}
+	return clientgen.PtrString(strconv.FormatInt(in.ValueInt64(), 10))
+}
+
+// helper function to convert a boolean to the string expected by the webhook target APIs.
+func webhookTargetBoolParam(in types.Bool) *string {
+	if !helper.IsKnown(in) {
+		return nil
+	}
+	return clientgen.PtrString(strconv.FormatBool(in.ValueBool()))
+}
+
+// helper function to marshal GET response to tfsdk model.
+// The auth token is never returned by the array, so the configured one is kept.
+func (r *ObjectWebhookTargetResource) respToModel(target *clientgen.ObjectWebhookTarget, plan models.ObjectWebhookTargetResourceModel) models.ObjectWebhookTargetResourceModel {
+	return models.ObjectWebhookTargetResourceModel{
+		ID:                helper.TfStringNN(target.Id),
+		Name:              helper.TfStringNN(target.Name),
+		URL:               helper.TfStringNN(target.Url),
+		AuthToken:         plan.AuthToken,
+		CaCertificate:     helper.TfStringNN(target.CaCertificate),
+		Comment:           helper.TfStringNN(target.Comment),
+		PreBackoffRetries: webhookTargetInt64(target.PreBackoffRetries, plan.PreBackoffRetries),
+		BackoffRetries:    webhookTargetInt64(target.BackoffRetries, plan.BackoffRetries),
+		Enabled:           webhookTargetBool(target.IsEnabled, plan.Enabled),
+		Status:            helper.TfStringNN(target.Status),
+	}
+}
+
+// Create.
+func (r *ObjectWebhookTargetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "creating object webhook target")
+	var plan models.ObjectWebhookTargetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, _, err := r.client.GenClient.WebhookConfigurationApi.WebhookConfigurationServiceCreateWebhookConfiguration(ctx).
+		ObjectWebhookTarget(clientgen.ObjectWebhookTarget{
+			Name:              plan.Name.ValueStringPointer(),
+			Url:               plan.URL.ValueStringPointer(),
+			AuthToken:         helper.ValueToPointer[string](plan.AuthToken),
+			CaCertificate:     helper.ValueToPointer[string](plan.CaCertificate),
+			Comment:           helper.ValueToPointer[string](plan.Comment),
+			PreBackoffRetries: webhookTargetInt64Param(plan.PreBackoffRetries),
+			BackoffRetries:    webhookTargetInt64Param(plan.BackoffRetries),
+			IsEnabled:         webhookTargetBoolParam(plan.Enabled),
+		}).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating object webhook target", helper.APIErrorDetail(err))
+		return
+	}
+	if created.Id == nil || *created.Id == "" {
+		resp.Diagnostics.AddError("Error creating object webhook target", "the array did not return the ID of the webhook target")
+		return
+	}
+
+	target, _, err := r.client.GenClient.WebhookConfigurationApi.WebhookConfigurationServiceGetWebhookConfigurationByID(ctx, *created.Id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading object webhook target state after create", helper.APIErrorDetail(err))
+		return
+	}
+
+	// Save data into Terraform state
+	state := r.respToModel(target, plan)
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read.
+func (r *ObjectWebhookTargetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.ObjectWebhookTargetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target, _, err := r.client.GenClient.WebhookConfigurationApi.WebhookConfigurationServiceGetWebhookConfigurationByID(ctx, state.ID.ValueString()).Execute()
+	if err != nil {
+		if helper.RemoveNotFound(ctx, err, &resp.State, "webhook target "+state.ID.ValueString()) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading object webhook target state", helper.APIErrorDetail(err))
+		return
+	}
+	if target.Id == nil || *target.Id == "" {
+		// the array answers an unknown ID with an empty object
+		helper.RemoveMissing(ctx, &resp.State, "webhook target "+state.ID.ValueString())
+		return
+	}
+
+	state2 := r.respToModel(target, state)
+	state2.Timeouts = state.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state2)...)
+}
+
+// Update.
+func (r *ObjectWebhookTargetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "updating object webhook target")
+	var plan, state models.ObjectWebhookTargetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, _, err := r.client.GenClient.WebhookConfigurationApi.WebhookConfigurationServiceUpdateWebhookConfiguration(ctx, id).
+		WebhookConfigurationServiceUpdateWebhookConfigurationRequest(clientgen.WebhookConfigurationServiceUpdateWebhookConfigurationRequest{
+			// the name cannot be changed, but is accepted when unchanged
+			Name:              plan.Name.ValueStringPointer(),
+			Url:               plan.URL.ValueStringPointer(),
+			AuthToken:         helper.ValueToPointer[string](plan.AuthToken),
+			CaCertificate:     helper.ValueToPointer[string](plan.CaCertificate),
+			Comment:           helper.ValueToPointer[string](plan.Comment),
+			PreBackoffRetries: webhookTargetInt64Param(plan.PreBackoffRetries),
+			BackoffRetries:    webhookTargetInt64Param(plan.BackoffRetries),
+			IsEnabled:         webhookTargetBoolParam(plan.Enabled),
+		}).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating object webhook target", helper.APIErrorDetail(err))
+		return
+	}
+
+	// Read updated data
+	target, _, err := r.client.GenClient.WebhookConfigurationApi.WebhookConfigurationServiceGetWebhookConfigurationByID(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading object webhook target state after update", helper.APIErrorDetail(err))
+		return
+	}
+	state2 := r.respToModel(target, plan)
+	state2.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state2)...)
+}
+
+// Delete.
+func (r *ObjectWebhookTargetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "deleting object webhook target")
+	var state models.ObjectWebhookTargetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := r.client.GenClient.WebhookConfigurationApi.WebhookConfigurationServiceDeleteWebhookConfigurationByID(ctx, state.ID.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting object webhook target", helper.APIErrorDetail(err))
+	}
+}
+
+// ImportState.
+func (r *ObjectWebhookTargetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "importing object webhook target")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,177 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-objectscale/internal/clientgen"
+	"terraform-provider-objectscale/internal/models"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete object webhook target.
+func TestAccObjectWebhookTargetRs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+	defer testUserTokenCleanup(t)
+
+	var mockAPI *mockey.Mocker
+	unPatchFunc := func() {
+		if mockAPI != nil {
+			mockAPI.UnPatch()
+		}
+	}
+
+	webhookConfig := func(comment string) string {
+		return ProviderConfigForTesting + fmt.Sprintf(`
+		resource "objectscale_object_webhook_target" "test" {
+			name = "tfacc-webhook"
+			url = "https://webhook.example.com:8443/events"
+			auth_token = "tfacc-token"
+			comment = "%s"
+			pre_backoff_retries = 3
+			backoff_retries = 5
+			enabled = true
+		}
+		`, comment)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create mock error
+				PreConfig: func() {
+					mockAPI = mockey.Mock((*clientgen.WebhookConfigurationApiService).WebhookConfigurationServiceCreateWebhookConfigurationExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				Config:      webhookConfig("created by terraform"),
+				ExpectError: regexp.MustCompile("Error creating object webhook target"),
+			},
+			{
+				// Create
+				PreConfig: unPatchFunc,
+				Config:    webhookConfig("created by terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("objectscale_object_webhook_target.test", "id"),
+					resource.TestCheckResourceAttrSet("objectscale_object_webhook_target.test", "status"),
+					resource.TestCheckResourceAttr("objectscale_object_webhook_target.test", "name", "tfacc-webhook"),
+					resource.TestCheckResourceAttr("objectscale_object_webhook_target.test", "comment", "created by terraform"),
+					resource.TestCheckResourceAttr("objectscale_object_webhook_target.test", "pre_backoff_retries", "3"),
+					resource.TestCheckResourceAttr("objectscale_object_webhook_target.test", "backoff_retries", "5"),
+					resource.TestCheckResourceAttr("objectscale_object_webhook_target.test", "enabled", "true"),
+				),
+			},
+			{
+				// Import
+				ResourceName:            "objectscale_object_webhook_target.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auth_token"},
+			},
+			{
+				// mock refresh error
+				PreConfig: func() {
+					mockAPI = mockey.Mock((*clientgen.WebhookConfigurationApiService).WebhookConfigurationServiceGetWebhookConfigurationByIDExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				RefreshState: true,
+				ExpectError:  regexp.MustCompile("Error reading object webhook target state"),
+			},
+			{
+				// Update mock error
+				PreConfig: func() {
+					unPatchFunc()
+					mockAPI = mockey.Mock((*clientgen.WebhookConfigurationApiService).WebhookConfigurationServiceUpdateWebhookConfigurationExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				Config:      webhookConfig("updated by terraform"),
+				ExpectError: regexp.MustCompile("Error updating object webhook target"),
+			},
+			{
+				// Update
+				PreConfig: unPatchFunc,
+				Config:    webhookConfig("updated by terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objectscale_object_webhook_target.test", "comment", "updated by terraform"),
+				),
+			},
+			{
+				// Delete mock error
+				PreConfig: func() {
+					mockAPI = mockey.Mock((*clientgen.WebhookConfigurationApiService).WebhookConfigurationServiceDeleteWebhookConfigurationByIDExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				Config:      ProviderConfigForTesting,
+				ExpectError: regexp.MustCompile("Error deleting object webhook target"),
+			},
+			{
+				PreConfig: unPatchFunc,
+				Config:    ProviderConfigForTesting,
+			},
+		},
+	})
+}
+
+func TestObjectWebhookTargetRespToModel(t *testing.T) {
+	r := &ObjectWebhookTargetResource{}
+	plan := models.ObjectWebhookTargetResourceModel{
+		AuthToken:         types.StringValue("secret"),
+		PreBackoffRetries: types.Int64Value(3),
+		BackoffRetries:    types.Int64Unknown(),
+		Enabled:           types.BoolValue(true),
+	}
+	target := &clientgen.ObjectWebhookTarget{
+		Id:                clientgen.PtrString("urn:ecs:webhook:ns1:hook1"),
+		Name:              clientgen.PtrString("hook1"),
+		Url:               clientgen.PtrString("https://webhook.example.com"),
+		PreBackoffRetries: clientgen.PtrString("7"),
+		IsEnabled:         clientgen.PtrString("false"),
+		Status:            clientgen.PtrString("ENABLED"),
+	}
+
+	state := r.respToModel(target, plan)
+	if state.AuthToken.ValueString() != "secret" {
+		t.Errorf("expected the configured auth token to be kept, got %v", state.AuthToken)
+	}
+	if state.PreBackoffRetries.ValueInt64() != 7 || state.Enabled.ValueBool() {
+		t.Errorf("expected the returned values to be used, got %+v", state)
+	}
+	if !state.BackoffRetries.IsNull() {
+		t.Errorf("expected unknown backoff retries to be null, got %v", state.BackoffRetries)
+	}
+
+	if p := webhookTargetInt64Param(types.Int64Value(5)); p == nil || *p != "5" {
+		t.Errorf("unexpected int64 param %v", p)
+	}
+	if p := webhookTargetBoolParam(types.BoolNull()); p != nil {
+		t.Errorf("expected nil bool param, got %v", *p)
+	}
+}
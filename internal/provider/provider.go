@@ -20,13 +20,20 @@ package provider
 import (
 	"context"
 	"fmt"
+	"os"
+	"strconv"
 	"terraform-provider-objectscale/internal/client"
 	"terraform-provider-objectscale/internal/helper"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -35,6 +42,7 @@ import (
 
 // Ensure ObjectScaleProvider satisfies various provider interfaces.
 var _ provider.Provider = &ObjectScaleProvider{}
+var _ provider.ProviderWithEphemeralResources = &ObjectScaleProvider{}
 
 // ObjectScaleProvider defines the provider implementation.
 type ObjectScaleProvider struct {
@@ -46,13 +54,28 @@ type ObjectScaleProvider struct {
 
 // ObjectScaleProviderModel describes the provider data model.
 type ObjectScaleProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-	Insecure types.Bool   `tfsdk:"insecure"`
-	Timeout  types.Int64  `tfsdk:"timeout"`
+	Endpoint              types.String `tfsdk:"endpoint"`
+	Username              types.String `tfsdk:"username"`
+	Password              types.String `tfsdk:"password"`
+	AuthToken             types.String `tfsdk:"auth_token"`
+	Insecure              types.Bool   `tfsdk:"insecure"`
+	CACertificate         types.String `tfsdk:"ca_certificate"`
+	ClientCertificate     types.String `tfsdk:"client_certificate"`
+	ClientKey             types.String `tfsdk:"client_key"`
+	Timeout               types.Int64  `tfsdk:"timeout"`
+	MaxRetries            types.Int64  `tfsdk:"max_retries"`
+	RetryBackoff          types.Int64  `tfsdk:"retry_backoff"`
+	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Int64  `tfsdk:"requests_per_second"`
 }
 
+const (
+	// default number of retries of the API requests failing with a transient error.
+	defaultMaxRetries = 3
+	// default delay in seconds before the first retry.
+	defaultRetryBackoff = 1
+)
+
 // Metadata describes the provider arguments.
 func (p *ObjectScaleProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "objectscale"
@@ -66,37 +89,121 @@ func (p *ObjectScaleProvider) Schema(ctx context.Context, req provider.SchemaReq
 		Description:         "The Terraform provider for Dell Objectscale can be used to interact with a Dell Objectscale array in order to manage the array resources.",
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				MarkdownDescription: "The API endpoint, ex. https://10.10.10.10:4443",
-				Description:         "The API endpoint, ex. https://10.10.10.10:4443",
-				Required:            true,
+				MarkdownDescription: "The API endpoint, ex. https://10.10.10.10:4443. Can also be set with the `OBJECTSCALE_ENDPOINT` environment variable.",
+				Description:         "The API endpoint, ex. https://10.10.10.10:4443. Can also be set with the OBJECTSCALE_ENDPOINT environment variable.",
+				Optional:            true,
 			},
 			"username": schema.StringAttribute{
-				MarkdownDescription: "The username",
-				Description:         "The username",
-				Required:            true,
+				MarkdownDescription: "The username. Can also be set with the `OBJECTSCALE_USERNAME` environment variable.",
+				Description:         "The username. Can also be set with the OBJECTSCALE_USERNAME environment variable.",
+				Optional:            true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password",
-				Description:         "The password",
-				Required:            true,
+				MarkdownDescription: "The password. Can also be set with the `OBJECTSCALE_PASSWORD` environment variable.",
+				Description:         "The password. Can also be set with the OBJECTSCALE_PASSWORD environment variable.",
+				Optional:            true,
 				Sensitive:           true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"auth_token": schema.StringAttribute{
+				MarkdownDescription: "A pre-issued auth token (`X-SDS-AUTH-TOKEN`), used instead of logging in with username and password." +
+					" The provider does not log out this token. If username and password are also set, they are used to log in again when the token expires." +
+					" Can also be set with the `OBJECTSCALE_AUTH_TOKEN` environment variable.",
+				Description: "A pre-issued auth token (X-SDS-AUTH-TOKEN), used instead of logging in with username and password." +
+					" The provider does not log out this token. If username and password are also set, they are used to log in again when the token expires." +
+					" Can also be set with the OBJECTSCALE_AUTH_TOKEN environment variable.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			"insecure": schema.BoolAttribute{
-				MarkdownDescription: "whether to skip SSL validation",
-				Description:         "whether to skip SSL validation",
+				MarkdownDescription: "whether to skip SSL validation. Can also be set with the `OBJECTSCALE_INSECURE` environment variable.",
+				Description:         "whether to skip SSL validation. Can also be set with the OBJECTSCALE_INSECURE environment variable.",
 				Optional:            true,
 			},
+			"ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate bundle, or the path of a file containing it, used to verify the certificate of the API endpoint in addition to the system certificates." +
+					" Can also be set with the `OBJECTSCALE_CA_CERTIFICATE` environment variable.",
+				Description: "PEM encoded CA certificate bundle, or the path of a file containing it, used to verify the certificate of the API endpoint in addition to the system certificates." +
+					" Can also be set with the OBJECTSCALE_CA_CERTIFICATE environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate, or the path of a file containing it, presented to the API endpoint for mutual TLS. Requires `client_key`." +
+					" Can also be set with the `OBJECTSCALE_CLIENT_CERTIFICATE` environment variable.",
+				Description: "PEM encoded client certificate, or the path of a file containing it, presented to the API endpoint for mutual TLS. Requires client_key." +
+					" Can also be set with the OBJECTSCALE_CLIENT_CERTIFICATE environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the client certificate, or the path of a file containing it. Requires `client_certificate`." +
+					" Can also be set with the `OBJECTSCALE_CLIENT_KEY` environment variable.",
+				Description: "PEM encoded private key of the client certificate, or the path of a file containing it. Requires client_certificate." +
+					" Can also be set with the OBJECTSCALE_CLIENT_KEY environment variable.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			"timeout": schema.Int64Attribute{
-				MarkdownDescription: "The timeout in seconds",
-				Description:         "The timeout in seconds",
+				MarkdownDescription: "The timeout in seconds. Can also be set with the `OBJECTSCALE_TIMEOUT` environment variable.",
+				Description:         "The timeout in seconds. Can also be set with the OBJECTSCALE_TIMEOUT environment variable.",
 				Optional:            true,
 			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries of an API request failing with a transient error, such as throttling, a busy array or a connection reset." +
+					" Defaults to 3, set to 0 to disable the retries. Can also be set with the `OBJECTSCALE_MAX_RETRIES` environment variable.",
+				Description: "Maximum number of retries of an API request failing with a transient error, such as throttling, a busy array or a connection reset." +
+					" Defaults to 3, set to 0 to disable the retries. Can also be set with the OBJECTSCALE_MAX_RETRIES environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_backoff": schema.Int64Attribute{
+				MarkdownDescription: "Delay in seconds before the first retry of a failed API request, doubled after each retry." +
+					" Defaults to 1. Can also be set with the `OBJECTSCALE_RETRY_BACKOFF` environment variable.",
+				Description: "Delay in seconds before the first retry of a failed API request, doubled after each retry." +
+					" Defaults to 1. Can also be set with the OBJECTSCALE_RETRY_BACKOFF environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of API requests in flight to the array, shared by all the resources and data sources of the provider." +
+					" Defaults to 0, meaning unlimited. Can also be set with the `OBJECTSCALE_MAX_CONCURRENT_REQUESTS` environment variable.",
+				Description: "Maximum number of API requests in flight to the array, shared by all the resources and data sources of the provider." +
+					" Defaults to 0, meaning unlimited. Can also be set with the OBJECTSCALE_MAX_CONCURRENT_REQUESTS environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"requests_per_second": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of API requests sent to the array per second, shared by all the resources and data sources of the provider." +
+					" Defaults to 0, meaning unlimited. Can also be set with the `OBJECTSCALE_REQUESTS_PER_SECOND` environment variable.",
+				Description: "Maximum number of API requests sent to the array per second, shared by all the resources and data sources of the provider." +
+					" Defaults to 0, meaning unlimited. Can also be set with the OBJECTSCALE_REQUESTS_PER_SECOND environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 		},
 	}
 }
@@ -112,25 +219,96 @@ func (p *ObjectScaleProvider) Configure(ctx context.Context, req provider.Config
 	}
 
 	// Configuration values are now available.
-	client, err := client.NewClient(
-		data.Endpoint.ValueString(),
-		data.Username.ValueString(),
-		data.Password.ValueString(),
-		data.Insecure.ValueBool(),
-		data.Timeout.ValueInt64(),
-	)
+	config, diags := data.clientConfig()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := client.NewClientFromConfig(config)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create objectscale client",
-			err.Error(),
+			helper.APIErrorDetail(err),
 		)
 		return
 	}
 
-	// client configuration for data sources and resources
+	// client configuration for data sources, resources and ephemeral resources
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+}
+
+// helper function to resolve the client configuration, falling back to environment variables for the unset attributes.
+func (data ObjectScaleProviderModel) clientConfig() (client.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	config := client.Config{
+		Endpoint:          stringOrEnv(data.Endpoint, "OBJECTSCALE_ENDPOINT"),
+		Username:          stringOrEnv(data.Username, "OBJECTSCALE_USERNAME"),
+		Password:          stringOrEnv(data.Password, "OBJECTSCALE_PASSWORD"),
+		AuthToken:         stringOrEnv(data.AuthToken, "OBJECTSCALE_AUTH_TOKEN"),
+		Insecure:          data.Insecure.ValueBool(),
+		CACertificate:     stringOrEnv(data.CACertificate, "OBJECTSCALE_CA_CERTIFICATE"),
+		ClientCertificate: stringOrEnv(data.ClientCertificate, "OBJECTSCALE_CLIENT_CERTIFICATE"),
+		ClientKey:         stringOrEnv(data.ClientKey, "OBJECTSCALE_CLIENT_KEY"),
+	}
+
+	if v := os.Getenv("OBJECTSCALE_INSECURE"); data.Insecure.IsNull() && v != "" {
+		insecure, err := strconv.ParseBool(v)
+		if err != nil {
+			diags.AddAttributeError(path.Root("insecure"), "Invalid OBJECTSCALE_INSECURE environment variable", err.Error())
+		}
+		config.Insecure = insecure
+	}
+	config.Timeout = int64OrEnv(data.Timeout, "timeout", "OBJECTSCALE_TIMEOUT", 0, &diags)
+	config.MaxRetries = int(int64OrEnv(data.MaxRetries, "max_retries", "OBJECTSCALE_MAX_RETRIES", defaultMaxRetries, &diags))
+	config.RetryBackoff = time.Duration(int64OrEnv(data.RetryBackoff, "retry_backoff", "OBJECTSCALE_RETRY_BACKOFF", defaultRetryBackoff, &diags)) * time.Second
+	config.MaxConcurrentRequests = int(int64OrEnv(data.MaxConcurrentRequests, "max_concurrent_requests", "OBJECTSCALE_MAX_CONCURRENT_REQUESTS", 0, &diags))
+	config.RequestsPerSecond = int(int64OrEnv(data.RequestsPerSecond, "requests_per_second", "OBJECTSCALE_REQUESTS_PER_SECOND", 0, &diags))
+
+	if config.Endpoint == "" {
+		diags.AddAttributeError(path.Root("endpoint"), "Missing ObjectScale API endpoint",
+			"Set the endpoint attribute in the provider configuration or the OBJECTSCALE_ENDPOINT environment variable.")
+	}
+	if config.AuthToken == "" && (config.Username == "" || config.Password == "") {
+		diags.AddError("Missing ObjectScale credentials",
+			"Set either username and password, or auth_token in the provider configuration."+
+				" They can also be set with the OBJECTSCALE_USERNAME, OBJECTSCALE_PASSWORD and OBJECTSCALE_AUTH_TOKEN environment variables.")
+	}
+	if (config.ClientCertificate == "") != (config.ClientKey == "") {
+		diags.AddError("Incomplete ObjectScale client certificate",
+			"Both client_certificate and client_key must be set for mutual TLS."+
+				" They can also be set with the OBJECTSCALE_CLIENT_CERTIFICATE and OBJECTSCALE_CLIENT_KEY environment variables.")
+	}
+	return config, diags
+}
+
+// helper function to get a string attribute, or the environment variable when the attribute is not set.
+func stringOrEnv(v types.String, env string) string {
+	if v.IsNull() || v.IsUnknown() {
+		return os.Getenv(env)
+	}
+	return v.ValueString()
+}
+
+// helper function to get an integer attribute, or the environment variable when the attribute is not set, or the default value.
+func int64OrEnv(v types.Int64, attribute, env string, def int64, diags *diag.Diagnostics) int64 {
+	if !v.IsNull() && !v.IsUnknown() {
+		return v.ValueInt64()
+	}
+	envValue := os.Getenv(env)
+	if envValue == "" {
+		return def
+	}
+	value, err := strconv.ParseInt(envValue, 10, 64)
+	if err != nil || value < 0 {
+		diags.AddAttributeError(path.Root(attribute), "Invalid "+env+" environment variable",
+			fmt.Sprintf("Expected a non-negative integer, got %q.", envValue))
+		return def
+	}
+	return value
 }
 
 // Resources describes the provider resources.
@@ -154,6 +332,11 @@ func (p *ObjectScaleProvider) Resources(ctx context.Context) []func() resource.R
 		NewObjectCertificateResource,
 		NewIAMSAMLProviderResource,
 		NewIAMServiceProviderResource,
+		NewStoragePoolResource,
+		NewVDCResource,
+		NewBucketCopyPolicyResource,
+		NewBucketNotificationResource,
+		NewObjectWebhookTargetResource,
 	}
 }
 
@@ -170,6 +353,8 @@ func (p *ObjectScaleProvider) DataSources(ctx context.Context) []func() datasour
 		NewIAMInlinePolicyDataSource,
 		NewVDCDataSource,
 		NewStoragePoolDataSource,
+		NewBucketCopyPolicyDataSource,
+		NewObjectWebhookTargetDataSource,
 		NewManagementUserDataSource,
 		NewObjectUserDataSource,
 		NewVDCCertificateDataSource,
@@ -177,6 +362,16 @@ func (p *ObjectScaleProvider) DataSources(ctx context.Context) []func() datasour
 		NewIAMSAMLProviderDataSource,
 		NewIAMServiceProviderDataSource,
 		NewIAMServiceProviderMetadataDataSource,
+		NewIAMPolicyDocumentDataSource,
+		NewIAMPolicySimulationDataSource,
+	}
+}
+
+// EphemeralResources describes the provider ephemeral resources.
+func (p *ObjectScaleProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewObjectUserSecretKeyEphemeralResource,
+		NewIAMAccessKeyEphemeralResource,
 	}
 }
 
@@ -213,6 +408,30 @@ func (d *datasourceProviderConfig) Configure(ctx context.Context, req datasource
 	d.client = client
 }
 
+// ephemeralProviderConfig defines the provider config struct.
+type ephemeralProviderConfig struct {
+	client *client.Client
+}
+
+func (e *ephemeralProviderConfig) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	e.client = client
+}
+
 // resourceProviderConfig defines the provider config struct.
 type resourceProviderConfig struct {
 	client        *client.Client
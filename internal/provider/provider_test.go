@@ -24,11 +24,17 @@ import (
 	"log"
 	"os"
 	"strings"
+	"sync"
 	"terraform-provider-objectscale/internal/client"
+	"terraform-provider-objectscale/internal/testserver"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
 )
 
 // var testProvider provider.Provider
@@ -47,6 +53,9 @@ var ProviderConfigForTesting = ``
 var username, password, endpoint, insecure string
 var logoutUser, logoutPassword string
 
+// startTestServer starts the simulator the first time an acceptance test runs without an array.
+var startTestServer sync.Once
+
 func init() {
 	_, err := loadEnvFile("objectscale.env")
 	if err != nil {
@@ -54,22 +63,28 @@ func init() {
 		return
 	}
 
-	endpoint = setDefault(os.Getenv("OBJECTSCALE_ENDPOINT"), "http://localhost:3007")
 	username = setDefault(os.Getenv("OBJECTSCALE_USERNAME"), "test")
 	password = setDefault(os.Getenv("OBJECTSCALE_PASSWORD"), "test")
 	logoutUser = setDefault(os.Getenv("OBJECTSCALE_LOGOUT_USERNAME"), "logouttest")
 	logoutPassword = setDefault(os.Getenv("OBJECTSCALE_LOGOUT_PASSWORD"), "logouttest")
+	endpoint = os.Getenv("OBJECTSCALE_ENDPOINT")
 	insecure = setDefault(os.Getenv("OBJECTSCALE_INSECURE"), "true")
 
+	// Without an array, the endpoint is left out and read by the provider from
+	// OBJECTSCALE_ENDPOINT, which testAccPreCheck sets to the simulator.
+	endpointConfig := ""
+	if endpoint != "" {
+		endpointConfig = fmt.Sprintf("endpoint = %q", endpoint)
+	}
 	ProviderConfigForTesting = fmt.Sprintf(`
 		provider "objectscale" {
 			username = "%s"
 			password = "%s"
-			endpoint = "%s"
+			%s
 			insecure = "%s"
 			timeout = 120
 		}
-	`, username, password, endpoint, insecure)
+	`, username, password, endpointConfig, insecure)
 }
 
 func testAccPreCheck(t *testing.T) {
@@ -81,6 +96,15 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("OBJECTSCALE_PASSWORD must be set for acceptance tests")
 	}
 
+	if os.Getenv("OBJECTSCALE_ENDPOINT") == "" {
+		// Without an array, run against the in-process simulator.
+		// It lives as long as the test binary, so it is never closed.
+		startTestServer.Do(func() {
+			endpoint = testserver.New(map[string]string{username: password, logoutUser: logoutPassword}).URL
+			os.Setenv("OBJECTSCALE_ENDPOINT", endpoint)
+		})
+	}
+
 	if v := endpoint; v == "" {
 		t.Fatal("OBJECTSCALE_ENDPOINT must be set for acceptance tests")
 	}
@@ -159,3 +183,176 @@ func loadEnvFile(path string) (map[string]string, error) {
 
 	return envMap, nil
 }
+
+// Test the provider attributes fallback to environment variables.
+func TestProviderClientConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    ObjectScaleProviderModel
+		env     map[string]string
+		want    client.Config
+		wantErr string
+	}{
+		{
+			name: "attributes",
+			data: ObjectScaleProviderModel{
+				Endpoint: types.StringValue("https://10.0.0.1:4443"),
+				Username: types.StringValue("user"),
+				Password: types.StringValue("pass"),
+				Insecure: types.BoolValue(true),
+				Timeout:  types.Int64Value(60),
+			},
+			env:  map[string]string{"OBJECTSCALE_ENDPOINT": "https://10.0.0.2:4443", "OBJECTSCALE_TIMEOUT": "30"},
+			want: client.Config{Endpoint: "https://10.0.0.1:4443", Username: "user", Password: "pass", Insecure: true, Timeout: 60, MaxRetries: 3, RetryBackoff: time.Second},
+		},
+		{
+			name: "environment",
+			env: map[string]string{
+				"OBJECTSCALE_ENDPOINT": "https://10.0.0.2:4443",
+				"OBJECTSCALE_USERNAME": "envuser",
+				"OBJECTSCALE_PASSWORD": "envpass",
+				"OBJECTSCALE_INSECURE": "true",
+				"OBJECTSCALE_TIMEOUT":  "30",
+			},
+			want: client.Config{Endpoint: "https://10.0.0.2:4443", Username: "envuser", Password: "envpass", Insecure: true, Timeout: 30, MaxRetries: 3, RetryBackoff: time.Second},
+		},
+		{
+			name: "auth token",
+			data: ObjectScaleProviderModel{
+				Endpoint:  types.StringValue("https://10.0.0.1:4443"),
+				AuthToken: types.StringValue("token"),
+			},
+			want: client.Config{Endpoint: "https://10.0.0.1:4443", AuthToken: "token", MaxRetries: 3, RetryBackoff: time.Second},
+		},
+		{
+			name: "retries",
+			data: ObjectScaleProviderModel{
+				Endpoint:   types.StringValue("https://10.0.0.1:4443"),
+				AuthToken:  types.StringValue("token"),
+				MaxRetries: types.Int64Value(0),
+			},
+			env:  map[string]string{"OBJECTSCALE_MAX_RETRIES": "5", "OBJECTSCALE_RETRY_BACKOFF": "2"},
+			want: client.Config{Endpoint: "https://10.0.0.1:4443", AuthToken: "token", MaxRetries: 0, RetryBackoff: 2 * time.Second},
+		},
+		{
+			name: "request limits",
+			data: ObjectScaleProviderModel{
+				Endpoint:              types.StringValue("https://10.0.0.1:4443"),
+				AuthToken:             types.StringValue("token"),
+				MaxConcurrentRequests: types.Int64Value(4),
+			},
+			env: map[string]string{"OBJECTSCALE_MAX_CONCURRENT_REQUESTS": "8", "OBJECTSCALE_REQUESTS_PER_SECOND": "10"},
+			want: client.Config{
+				Endpoint:              "https://10.0.0.1:4443",
+				AuthToken:             "token",
+				MaxRetries:            3,
+				RetryBackoff:          time.Second,
+				MaxConcurrentRequests: 4,
+				RequestsPerSecond:     10,
+			},
+		},
+		{
+			name:    "invalid requests per second",
+			env:     map[string]string{"OBJECTSCALE_ENDPOINT": "https://10.0.0.2:4443", "OBJECTSCALE_AUTH_TOKEN": "token", "OBJECTSCALE_REQUESTS_PER_SECOND": "0.5"},
+			wantErr: "Invalid OBJECTSCALE_REQUESTS_PER_SECOND environment variable",
+		},
+		{
+			name:    "invalid retries",
+			env:     map[string]string{"OBJECTSCALE_ENDPOINT": "https://10.0.0.2:4443", "OBJECTSCALE_AUTH_TOKEN": "token", "OBJECTSCALE_MAX_RETRIES": "-1"},
+			wantErr: "Invalid OBJECTSCALE_MAX_RETRIES environment variable",
+		},
+		{
+			name:    "missing endpoint",
+			data:    ObjectScaleProviderModel{AuthToken: types.StringValue("token")},
+			wantErr: "Missing ObjectScale API endpoint",
+		},
+		{
+			name:    "missing credentials",
+			data:    ObjectScaleProviderModel{Endpoint: types.StringValue("https://10.0.0.1:4443"), Username: types.StringValue("user")},
+			wantErr: "Missing ObjectScale credentials",
+		},
+		{
+			name: "client certificate",
+			data: ObjectScaleProviderModel{
+				Endpoint:          types.StringValue("https://10.0.0.1:4443"),
+				AuthToken:         types.StringValue("token"),
+				CACertificate:     types.StringValue("/etc/ssl/objectscale-ca.pem"),
+				ClientCertificate: types.StringValue("/etc/ssl/client.pem"),
+			},
+			env: map[string]string{"OBJECTSCALE_CLIENT_KEY": "/etc/ssl/client.key"},
+			want: client.Config{
+				Endpoint:          "https://10.0.0.1:4443",
+				AuthToken:         "token",
+				CACertificate:     "/etc/ssl/objectscale-ca.pem",
+				ClientCertificate: "/etc/ssl/client.pem",
+				ClientKey:         "/etc/ssl/client.key",
+				MaxRetries:        3,
+				RetryBackoff:      time.Second,
+			},
+		},
+		{
+			name: "incomplete client certificate",
+			data: ObjectScaleProviderModel{
+				Endpoint:          types.StringValue("https://10.0.0.1:4443"),
+				AuthToken:         types.StringValue("token"),
+				ClientCertificate: types.StringValue("/etc/ssl/client.pem"),
+			},
+			wantErr: "Incomplete ObjectScale client certificate",
+		},
+		{
+			name:    "invalid timeout",
+			env:     map[string]string{"OBJECTSCALE_ENDPOINT": "https://10.0.0.2:4443", "OBJECTSCALE_AUTH_TOKEN": "token", "OBJECTSCALE_TIMEOUT": "1m"},
+			wantErr: "Invalid OBJECTSCALE_TIMEOUT environment variable",
+		},
+		{
+			name:    "invalid insecure",
+			env:     map[string]string{"OBJECTSCALE_ENDPOINT": "https://10.0.0.2:4443", "OBJECTSCALE_AUTH_TOKEN": "token", "OBJECTSCALE_INSECURE": "maybe"},
+			wantErr: "Invalid OBJECTSCALE_INSECURE environment variable",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, env := range []string{"OBJECTSCALE_ENDPOINT", "OBJECTSCALE_USERNAME", "OBJECTSCALE_PASSWORD", "OBJECTSCALE_AUTH_TOKEN", "OBJECTSCALE_INSECURE",
+				"OBJECTSCALE_CA_CERTIFICATE", "OBJECTSCALE_CLIENT_CERTIFICATE", "OBJECTSCALE_CLIENT_KEY", "OBJECTSCALE_TIMEOUT",
+				"OBJECTSCALE_MAX_RETRIES", "OBJECTSCALE_RETRY_BACKOFF", "OBJECTSCALE_MAX_CONCURRENT_REQUESTS", "OBJECTSCALE_REQUESTS_PER_SECOND"} {
+				t.Setenv(env, tt.env[env])
+			}
+			got, diags := tt.data.clientConfig()
+			if tt.wantErr != "" {
+				assert.True(t, diags.HasError())
+				assert.Contains(t, diags[0].Summary(), tt.wantErr)
+				return
+			}
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResourcesTimeouts(t *testing.T) {
+	ctx := context.Background()
+	p := &ObjectScaleProvider{}
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "objectscale"}, &metadata)
+		var resp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &resp)
+		assert.Contains(t, resp.Schema.Blocks, "timeouts", "resource %s has no timeouts block", metadata.TypeName)
+	}
+}
+
+func TestProviderSchema(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// the framework validates the schemas, e.g. that write-only attributes are not computed
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+}
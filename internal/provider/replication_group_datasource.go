@@ -183,7 +183,7 @@ func (d *ReplicationGroupDataSource) Read(ctx context.Context, req datasource.Re
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting the list of replication groups",
-			err.Error(),
+			helper.APIErrorDetail(err),
 		)
 		return
 	}
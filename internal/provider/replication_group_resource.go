@@ -25,6 +25,7 @@ import (
 	"terraform-provider-objectscale/internal/models"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -57,8 +58,8 @@ func (r *ReplicationGroupResource) Metadata(ctx context.Context, req resource.Me
 
 func (r *ReplicationGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description:         "This resource allows end user to Provision and manage Dell ObjectScale Replication Groups.",
-		MarkdownDescription: "This resource allows end user to Provision and manage Dell ObjectScale Replication Groups.",
+		Description:         "This resource allows end user to Provision and manage Dell ObjectScale Replication Groups. The ObjectScale management API cannot delete a Replication Group, so destroying this resource only removes it from the state and leaves the Replication Group on ObjectScale.",
+		MarkdownDescription: "This resource allows end user to Provision and manage Dell ObjectScale Replication Groups. The ObjectScale management API cannot delete a Replication Group, so destroying this resource only removes it from the state and leaves the Replication Group on ObjectScale.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description:         "Identifier of the Replication Group.",
@@ -149,6 +150,9 @@ func (r *ReplicationGroupResource) Schema(ctx context.Context, req resource.Sche
 				Default:             booldefault.StaticBool(false),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true}),
+		},
 	}
 }
 
@@ -389,6 +393,12 @@ func (r *ReplicationGroupResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// determine if we are dealing with asymmetric replication (ie. Passive Config)
 	zoneMappings := r.getZoneList(plan)
 	// run the API call
@@ -404,7 +414,7 @@ func (r *ReplicationGroupResource) Create(ctx context.Context, req resource.Crea
 		}).
 		Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Replication Group", err.Error())
+		resp.Diagnostics.AddError("Error creating Replication Group", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -419,6 +429,7 @@ func (r *ReplicationGroupResource) Create(ctx context.Context, req resource.Crea
 		IsFullRep:            createdRG.IsFullRep,
 		UseReplicationTarget: createdRG.UseReplicationTarget,
 	})
+	state.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -430,21 +441,32 @@ func (r *ReplicationGroupResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	rg, _, err := r.client.GenClient.DataVpoolApi.
 		DataServiceVpoolServiceGetDataServiceStore(ctx, state.ID.ValueString()).
 		Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading Replication Group state", err.Error())
+		if helper.RemoveNotFound(ctx, err, &resp.State, "replication group "+state.ID.ValueString()) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Replication Group state", helper.APIErrorDetail(err))
 		return
 	}
 
 	if rg.Id == nil {
 		// if wrong ID is passed to API, this happens
-		resp.Diagnostics.AddError("Error reading Replication Group state", "Replication Group not found with ID "+state.ID.ValueString())
+		helper.RemoveMissing(ctx, &resp.State, "replication group "+state.ID.ValueString())
+		return
 	}
 
 	state2 := r.respToModel(rg)
+	state2.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state2)...)
 }
 
@@ -487,6 +509,12 @@ func (r *ReplicationGroupResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// basic attributes update
 	basicreq := clientgen.DataServiceVpoolServicePutDataServiceVpoolRequest{
 		Description:        helper.ValueToPointer[string](plan.Description),
@@ -500,7 +528,7 @@ func (r *ReplicationGroupResource) Update(ctx context.Context, req resource.Upda
 		DataServiceVpoolServicePutDataServiceVpool(ctx, state.ID.ValueString()).
 		DataServiceVpoolServicePutDataServiceVpoolRequest(basicreq).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Replication Group attributes", err.Error())
+		resp.Diagnostics.AddError("Error updating Replication Group attributes", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -514,7 +542,7 @@ func (r *ReplicationGroupResource) Update(ctx context.Context, req resource.Upda
 				Mappings: add,
 			}).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error adding new zones to Replication Group", err.Error())
+			resp.Diagnostics.AddError("Error adding new zones to Replication Group", helper.APIErrorDetail(err))
 			return
 		}
 	}
@@ -527,28 +555,33 @@ func (r *ReplicationGroupResource) Update(ctx context.Context, req resource.Upda
 				Mappings: remove,
 			}).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error removing zones from Replication Group", err.Error())
+			resp.Diagnostics.AddError("Error removing zones from Replication Group", helper.APIErrorDetail(err))
 			return
 		}
 	}
 
 	// wait 30 seconds for replication group to be updated
-	time.Sleep(30 * time.Second)
+	if err := helper.Sleep(ctx, 30*time.Second); err != nil {
+		resp.Diagnostics.AddError("Error updating Replication Group", "timed out waiting for the replication group to be updated: "+err.Error())
+		return
+	}
 	// Read updated data
 	rg, _, err := r.client.GenClient.DataVpoolApi.
 		DataServiceVpoolServiceGetDataServiceStore(ctx, state.ID.ValueString()).
 		Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading Replication Group state after update", err.Error())
+		resp.Diagnostics.AddError("Error reading Replication Group state after update", helper.APIErrorDetail(err))
 		return
 	}
 	state2 := r.respToModel(rg)
+	state2.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state2)...)
 }
 
 // Delete.
 func (r *ReplicationGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// just remove from state
+	// the management API has no endpoint to deactivate a replication group,
+	// so just remove from state
 	resp.State.RemoveResource(ctx)
 }
 
@@ -558,7 +591,7 @@ func (r *ReplicationGroupResource) ImportState(ctx context.Context, req resource
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting the list of replication groups",
-			err.Error(),
+			helper.APIErrorDetail(err),
 		)
 		return
 	}
@@ -22,8 +22,8 @@ import (
 	"os"
 	"regexp"
 	"terraform-provider-objectscale/internal/clientgen"
+	"terraform-provider-objectscale/internal/helper"
 	"testing"
-	"time"
 
 	"github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -39,7 +39,7 @@ func TestAccRgRs(t *testing.T) {
 	}
 	defer testUserTokenCleanup(t)
 
-	mockTime := mockey.Mock(time.Sleep).Return().Build()
+	mockTime := mockey.Mock(helper.Sleep).Return(nil).Build()
 	defer mockTime.UnPatch()
 
 	var mockAPI *mockey.Mocker
@@ -0,0 +1,168 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"terraform-provider-objectscale/internal/client"
+	"terraform-provider-objectscale/internal/policytypes"
+	"terraform-provider-objectscale/internal/testserver"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// singletonResources are the resources whose object always exists on the array, so it cannot be deleted out-of-band.
+var singletonResources = map[string]bool{
+	"objectscale_object_certificate": true,
+	"objectscale_vdc_certificate":    true,
+}
+
+// newDeletedArray returns a client of an array answering every request as if the object was deleted:
+// the management API with a 1004 error and the IAM API with a NoSuchEntity error.
+func newDeletedArray(t *testing.T) *client.Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/login":
+			w.Header().Set("X-SDS-AUTH-TOKEN", "token")
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{}`))
+		case strings.HasPrefix(r.URL.Path, "/iam"):
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>NoSuchEntity</Code>` +
+				`<Message>The entity cannot be found.</Message></Error></ErrorResponse>`))
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":1004,"description":"Unable to find entity specified in URL","details":"deleted","retryable":false}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	c, err := client.NewClient(server.URL, "root", "password", true, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return c
+}
+
+// readResource configures a resource with the client and reads it from a prior state
+// whose top-level string attributes are set from values, or to "deleted" (an empty JSON document) when not given.
+func readResource(t *testing.T, r resource.Resource, c *client.Client, values map[string]string) *resource.ReadResponse {
+	t.Helper()
+	ctx := context.Background()
+	var configure resource.ConfigureResponse
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: c}, &configure)
+	if configure.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure error: %v", configure.Diagnostics)
+	}
+
+	var schema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schema)
+	state := tfsdk.State{Schema: schema.Schema, Raw: tftypes.NewValue(schema.Schema.Type().TerraformType(ctx), nil)}
+	for name, attr := range schema.Schema.Attributes {
+		if _, ok := attr.GetType().ValueType(ctx).(basetypes.StringValuable); !ok {
+			continue
+		}
+		value, ok := values[name]
+		if !ok {
+			value = "deleted"
+			if _, isJSON := attr.GetType().(policytypes.DocumentType); isJSON {
+				value = "{}"
+			}
+		}
+		if diags := state.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("unexpected error setting %s: %v", name, diags)
+		}
+	}
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	return resp
+}
+
+func TestResourcesReadDeleted(t *testing.T) {
+	ctx := context.Background()
+	c := newDeletedArray(t)
+	p := &ObjectScaleProvider{}
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "objectscale"}, &metadata)
+		if singletonResources[metadata.TypeName] {
+			continue
+		}
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			resp := readResource(t, r, c, nil)
+			assert.False(t, resp.Diagnostics.HasError(), "unexpected error: %v", resp.Diagnostics)
+			assert.True(t, resp.State.Raw.IsNull(), "expected the resource to be removed from state")
+		})
+	}
+}
+
+// TestResourcesReadEmpty covers the APIs answering with an empty object instead of an error for an unknown ID.
+func TestResourcesReadEmpty(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-SDS-AUTH-TOKEN", "token")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+	c, err := client.NewClient(server.URL, "root", "password", true, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for name, r := range map[string]resource.Resource{
+		"bucket_copy_policy":    NewBucketCopyPolicyResource(),
+		"bucket_notification":   NewBucketNotificationResource(),
+		"object_webhook_target": NewObjectWebhookTargetResource(),
+		"replication_group":     NewReplicationGroupResource(),
+		"storage_pool":          NewStoragePoolResource(),
+		"vdc":                   NewVDCResource(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp := readResource(t, r, c, nil)
+			assert.False(t, resp.Diagnostics.HasError(), "unexpected error: %v", resp.Diagnostics)
+			assert.True(t, resp.State.Raw.IsNull(), "expected the resource to be removed from state")
+		})
+	}
+}
+
+// TestResourcesReadDeletedKey covers the keys deleted while their user still exists.
+func TestResourcesReadDeletedKey(t *testing.T) {
+	s := testserver.New(map[string]string{"root": "password"})
+	t.Cleanup(s.Close)
+	c, err := client.NewClient(s.URL, "root", "password", true, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resp := readResource(t, NewIAMUserAccessKeyResource(), c, map[string]string{"user_name": "user_001", "namespace": "ns1"})
+	assert.False(t, resp.Diagnostics.HasError(), "unexpected error: %v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull(), "expected the access key to be removed from state")
+}
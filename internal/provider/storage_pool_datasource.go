@@ -198,7 +198,7 @@ func (d *StoragePoolDataSource) Read(ctx context.Context, req datasource.ReadReq
 		// get by id
 		dsResp, _, err := d.client.GenClient.ObjectVarrayApi.ObjectVarrayServiceGetVirtualArray(ctx, *id).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error fetching Storage Pool with ID: "+*id, err.Error())
+			resp.Diagnostics.AddError("Error fetching Storage Pool with ID: "+*id, helper.APIErrorDetail(err))
 			return
 		}
 		allSpResp = append(allSpResp, *dsResp)
@@ -211,7 +211,7 @@ func (d *StoragePoolDataSource) Read(ctx context.Context, req datasource.ReadReq
 		}
 		dsresp, _, err := dsreq.Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error fetching Storage Pools", err.Error())
+			resp.Diagnostics.AddError("Error fetching Storage Pools", helper.APIErrorDetail(err))
 			return
 		}
 		if name := helper.ValueToPointer[string](data.Name); name != nil {
@@ -0,0 +1,433 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-objectscale/internal/clientgen"
+	"terraform-provider-objectscale/internal/helper"
+	"terraform-provider-objectscale/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StoragePoolResource{}
+var _ resource.ResourceWithImportState = &StoragePoolResource{}
+var _ resource.ResourceWithModifyPlan = &StoragePoolResource{}
+
+func NewStoragePoolResource() resource.Resource {
+	return &StoragePoolResource{}
+}
+
+// StoragePoolResource defines the resource implementation.
+type StoragePoolResource struct {
+	resourceProviderConfig
+}
+
+func (r *StoragePoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_pool"
+}
+
+// alert threshold attribute schema.
+func (r *StoragePoolResource) alertSchema(level string) schema.Int32Attribute {
+	desc := "Threshold percent at which " + level + " alert is raised. Valid values are from -1 to 100. Value of -1 means do not alert."
+	return schema.Int32Attribute{
+		Description:         desc,
+		MarkdownDescription: desc,
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Int32{
+			int32planmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.Int32{
+			int32validator.Between(-1, 100),
+		},
+	}
+}
+
+func (r *StoragePoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This resource allows end user to Provision and manage Dell ObjectScale Storage Pools (Virtual Arrays) in the local VDC. The dumb-bell flags are not supported, as the virtual array API does not expose them.",
+		MarkdownDescription: "This resource allows end user to Provision and manage Dell ObjectScale Storage Pools (Virtual Arrays) in the local VDC. The dumb-bell flags are not supported, as the virtual array API does not expose them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the Storage Pool.",
+				MarkdownDescription: "Identifier of the Storage Pool.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the Storage Pool.",
+				MarkdownDescription: "Name of the Storage Pool.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description:         "Description of the Storage Pool.",
+				MarkdownDescription: "Description of the Storage Pool.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_cold_storage_enabled": schema.BoolAttribute{
+				Description:         "Whether cold storage erasure coding is enabled on the Storage Pool. Cannot be updated.",
+				MarkdownDescription: "Whether cold storage erasure coding is enabled on the Storage Pool. Cannot be updated.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_protected": schema.BoolAttribute{
+				Description: "Whether the Storage Pool is protected. New Storage Pools cannot be protected." +
+					" The API does not return this flag, so its value is kept from the configuration; after import it is unknown" +
+					" and must be set to the actual value of the Storage Pool before the Storage Pool can be updated.",
+				MarkdownDescription: "Whether the Storage Pool is protected. New Storage Pools cannot be protected." +
+					" The API does not return this flag, so its value is kept from the configuration; after import it is unknown" +
+					" and must be set to the actual value of the Storage Pool before the Storage Pool can be updated.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"number_of_data_blocks": schema.Int32Attribute{
+				Description:         "Number of data blocks in the erasure coding scheme of the Storage Pool. Cannot be updated.",
+				MarkdownDescription: "Number of data blocks in the erasure coding scheme of the Storage Pool. Cannot be updated.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"number_of_code_blocks": schema.Int32Attribute{
+				Description:         "Number of code blocks in the erasure coding scheme of the Storage Pool. Cannot be updated.",
+				MarkdownDescription: "Number of code blocks in the erasure coding scheme of the Storage Pool. Cannot be updated.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"warning_alert_at":  r.alertSchema("warning"),
+			"error_alert_at":    r.alertSchema("error"),
+			"critical_alert_at": r.alertSchema("critical"),
+			"label": schema.StringAttribute{
+				Description:         "Label of the Storage Pool. Cannot be updated.",
+				MarkdownDescription: "Label of the Storage Pool. Cannot be updated.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"drive_technology": schema.StringAttribute{
+				Description:         "Drive technology of the Storage Pool. Cannot be updated.",
+				MarkdownDescription: "Drive technology of the Storage Pool. Cannot be updated.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.Int32Attribute{
+				Description:         "Status of the Storage Pool, -1 for null, 0 ~ 6 for value.",
+				MarkdownDescription: "Status of the Storage Pool, -1 for null, 0 ~ 6 for value.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+// helper function to marshal GET response to tfsdk model.
+func (r *StoragePoolResource) respToModel(sp *clientgen.ObjectVarrayServiceGetVirtualArrayResponse) models.StoragePoolResourceModel {
+	return models.StoragePoolResourceModel{
+		ID:                   helper.TfStringNN(sp.Id),
+		Name:                 helper.TfStringNN(sp.Name),
+		Description:          helper.TfStringNN(sp.Description),
+		IsColdStorageEnabled: helper.TfBoolNN(sp.IsColdStorageEnabled),
+		NumberOfDataBlocks:   helper.TfInt32NN(sp.NumberOfDataBlocks),
+		NumberOfCodeBlocks:   helper.TfInt32NN(sp.NumberOfCodeBlocks),
+		WarningAlertAt:       helper.TfInt32NN(sp.WarningAlertAt),
+		ErrorAlertAt:         helper.TfInt32NN(sp.ErrorAlertAt),
+		CriticalAlertAt:      helper.TfInt32NN(sp.CriticalAlertAt),
+		Label:                helper.TfStringNN(sp.Label),
+		DriveTechnology:      helper.TfStringNN(sp.DriveTechnology),
+		Status:               helper.TfInt32NN(sp.Status),
+	}
+}
+
+// Plan Modify.
+func (r *StoragePoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to validate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan models.StoragePoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		// the API only accepts unprotected new storage pools
+		if plan.IsProtected.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("is_protected"), "Error creating Storage Pool",
+				"a protected Storage Pool cannot be created")
+		}
+		return
+	}
+
+	var state models.StoragePoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validation 2: the protection flag of an imported storage pool is not returned by the API,
+	// so it must be known before an update, which always sends it.
+	if plan.IsProtected.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("is_protected"), "Error updating Storage Pool",
+			"is_protected of an imported Storage Pool is unknown, set it to the actual value of the Storage Pool to update it")
+	}
+
+	// Validation 1: erasure coding scheme and drive details cannot be modified
+	immutable := map[string][2]attr.Value{
+		"is_cold_storage_enabled": {plan.IsColdStorageEnabled, state.IsColdStorageEnabled},
+		"number_of_data_blocks":   {plan.NumberOfDataBlocks, state.NumberOfDataBlocks},
+		"number_of_code_blocks":   {plan.NumberOfCodeBlocks, state.NumberOfCodeBlocks},
+		"label":                   {plan.Label, state.Label},
+		"drive_technology":        {plan.DriveTechnology, state.DriveTechnology},
+	}
+	for name, values := range immutable {
+		if helper.IsChangedNN(values[0], values[1]) {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Error updating Storage Pool", name+" cannot be modified")
+		}
+	}
+}
+
+// Create.
+func (r *StoragePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "creating Storage Pool")
+	var plan models.StoragePoolResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdSP, _, err := r.client.GenClient.ObjectVarrayApi.ObjectVarrayServiceCreateVirtualArray(ctx).
+		ObjectVarrayServiceCreateVirtualArrayRequest(clientgen.ObjectVarrayServiceCreateVirtualArrayRequest{
+			Name:                 plan.Name.ValueString(),
+			IsProtected:          plan.IsProtected.ValueBool(),
+			Description:          helper.ValueToPointer[string](plan.Description),
+			IsColdStorageEnabled: helper.ValueToPointer[bool](plan.IsColdStorageEnabled),
+			WarningAlertAt:       helper.ValueToPointer[int32](plan.WarningAlertAt),
+			ErrorAlertAt:         helper.ValueToPointer[int32](plan.ErrorAlertAt),
+			CriticalAlertAt:      helper.ValueToPointer[int32](plan.CriticalAlertAt),
+			NumberOfDataBlocks:   helper.ValueToPointer[int32](plan.NumberOfDataBlocks),
+			NumberOfCodeBlocks:   helper.ValueToPointer[int32](plan.NumberOfCodeBlocks),
+			Label:                helper.ValueToPointer[string](plan.Label),
+			DriveTechnology:      helper.ValueToPointer[string](plan.DriveTechnology),
+		}).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Storage Pool", helper.APIErrorDetail(err))
+		return
+	}
+
+	// Save data into Terraform state
+	state := r.respToModel(&clientgen.ObjectVarrayServiceGetVirtualArrayResponse{
+		Id:                   createdSP.Id,
+		Name:                 createdSP.Name,
+		Description:          createdSP.Description,
+		IsColdStorageEnabled: createdSP.IsColdStorageEnabled,
+		NumberOfDataBlocks:   createdSP.NumberOfDataBlocks,
+		NumberOfCodeBlocks:   createdSP.NumberOfCodeBlocks,
+		WarningAlertAt:       createdSP.WarningAlertAt,
+		ErrorAlertAt:         createdSP.ErrorAlertAt,
+		CriticalAlertAt:      createdSP.CriticalAlertAt,
+		Status:               createdSP.Status,
+		Label:                createdSP.Label,
+		DriveTechnology:      createdSP.DriveTechnology,
+	})
+	state.IsProtected = types.BoolValue(plan.IsProtected.ValueBool())
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read.
+func (r *StoragePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.StoragePoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sp, _, err := r.client.GenClient.ObjectVarrayApi.ObjectVarrayServiceGetVirtualArray(ctx, state.ID.ValueString()).Execute()
+	if err != nil {
+		if helper.RemoveNotFound(ctx, err, &resp.State, "storage pool "+state.ID.ValueString()) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Storage Pool state", helper.APIErrorDetail(err))
+		return
+	}
+
+	if sp.Id == nil {
+		// if wrong ID is passed to API, this happens
+		helper.RemoveMissing(ctx, &resp.State, "storage pool "+state.ID.ValueString())
+		return
+	}
+
+	state2 := r.respToModel(sp)
+	// the API does not return the protection flag
+	state2.IsProtected = state.IsProtected
+	state2.Timeouts = state.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state2)...)
+}
+
+// Update.
+func (r *StoragePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "updating Storage Pool")
+	var state, plan models.StoragePoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := r.client.GenClient.ObjectVarrayApi.ObjectVarrayServiceUpdateVirtualArray(ctx, state.ID.ValueString()).
+		ObjectVarrayServiceUpdateVirtualArrayRequest(clientgen.ObjectVarrayServiceUpdateVirtualArrayRequest{
+			Name:            plan.Name.ValueString(),
+			IsProtected:     plan.IsProtected.ValueBool(),
+			Description:     helper.ValueToPointer[string](plan.Description),
+			WarningAlertAt:  helper.ValueToPointer[int32](plan.WarningAlertAt),
+			ErrorAlertAt:    helper.ValueToPointer[int32](plan.ErrorAlertAt),
+			CriticalAlertAt: helper.ValueToPointer[int32](plan.CriticalAlertAt),
+		}).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Storage Pool", helper.APIErrorDetail(err))
+		return
+	}
+
+	// Read updated data
+	sp, _, err := r.client.GenClient.ObjectVarrayApi.ObjectVarrayServiceGetVirtualArray(ctx, state.ID.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Storage Pool state after update", helper.APIErrorDetail(err))
+		return
+	}
+	state2 := r.respToModel(sp)
+	state2.IsProtected = plan.IsProtected
+	state2.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state2)...)
+}
+
+// Delete.
+func (r *StoragePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "deleting Storage Pool")
+	var state models.StoragePoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := r.client.GenClient.ObjectVarrayApi.ObjectVarrayServiceDeleteVirtualArray(ctx, state.ID.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting Storage Pool", helper.APIErrorDetail(err))
+	}
+}
+
+// ImportState.
+func (r *StoragePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	allSPResp, _, err := r.client.GenClient.ObjectVarrayApi.ObjectVarrayServiceGetVirtualArrays(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting the list of storage pools",
+			helper.APIErrorDetail(err),
+		)
+		return
+	}
+	// Find the resource with the matching ID or name
+	for _, sp := range allSPResp.Varray {
+		if (sp.Id != nil && *sp.Id == req.ID) || (sp.Name != nil && *sp.Name == req.ID) {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), sp.Id)...)
+			return
+		}
+	}
+	// return error if not found
+	resp.Diagnostics.AddError(fmt.Sprintf("Could not find storage pool with ID or name %s", req.ID), "")
+}
@@ -0,0 +1,206 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-objectscale/internal/clientgen"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete Storage Pool.
+func TestAccStoragePoolRs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+	defer testUserTokenCleanup(t)
+
+	var mockAPI *mockey.Mocker
+	unPatchFunc := func() {
+		if mockAPI != nil {
+			mockAPI.UnPatch()
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// invalid alert threshold - Negative
+				Config: ProviderConfigForTesting + `
+				resource "objectscale_storage_pool" "test_sp" {
+					name = "tfacc_sp"
+					warning_alert_at = 101
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Attribute warning_alert_at value must be between -1 and 100"),
+			},
+			{
+				// create protected - Negative
+				Config: ProviderConfigForTesting + `
+				resource "objectscale_storage_pool" "test_sp" {
+					name = "tfacc_sp"
+					is_protected = true
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("a protected Storage Pool cannot be created"),
+			},
+			{
+				// Create mock error
+				PreConfig: func() {
+					mockAPI = mockey.Mock((*clientgen.ObjectVarrayApiService).ObjectVarrayServiceCreateVirtualArrayExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				Config: ProviderConfigForTesting + `
+				resource "objectscale_storage_pool" "test_sp" {
+					name = "tfacc_sp"
+				}
+				`,
+				ExpectError: regexp.MustCompile("Error creating Storage Pool"),
+			},
+			{
+				// Create
+				PreConfig: unPatchFunc,
+				Config: ProviderConfigForTesting + `
+				resource "objectscale_storage_pool" "test_sp" {
+					name = "tfacc_sp"
+					description = "created by terraform"
+					warning_alert_at = 70
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objectscale_storage_pool.test_sp", "name", "tfacc_sp"),
+					resource.TestCheckResourceAttr("objectscale_storage_pool.test_sp", "description", "created by terraform"),
+					resource.TestCheckResourceAttr("objectscale_storage_pool.test_sp", "warning_alert_at", "70"),
+					resource.TestCheckResourceAttr("objectscale_storage_pool.test_sp", "is_protected", "false"),
+					resource.TestCheckResourceAttrSet("objectscale_storage_pool.test_sp", "id"),
+				),
+			},
+			{
+				// Import by name
+				ResourceName:      "objectscale_storage_pool.test_sp",
+				ImportState:       true,
+				ImportStateId:     "tfacc_sp",
+				ImportStateVerify: true,
+				// the API does not return the protection flag
+				ImportStateVerifyIgnore: []string{"is_protected"},
+			},
+			{
+				// import invalid
+				ResourceName:  "objectscale_storage_pool.test_sp",
+				ImportState:   true,
+				ImportStateId: "invalid-id",
+				ExpectError:   regexp.MustCompile("Could not find storage pool with ID or name"),
+			},
+			{
+				// mock import error when getting list of storage pools
+				PreConfig: func() {
+					mockAPI = mockey.Mock((*clientgen.ObjectVarrayApiService).ObjectVarrayServiceGetVirtualArraysExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				ResourceName:  "objectscale_storage_pool.test_sp",
+				ImportState:   true,
+				ImportStateId: "tfacc_sp",
+				ExpectError:   regexp.MustCompile("Error getting the list of storage pools"),
+			},
+			{
+				// mock refresh error
+				PreConfig: func() {
+					unPatchFunc()
+					mockAPI = mockey.Mock((*clientgen.ObjectVarrayApiService).ObjectVarrayServiceGetVirtualArrayExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				RefreshState: true,
+				ExpectError:  regexp.MustCompile("Error reading Storage Pool state"),
+			},
+			{
+				// Update erasure coding scheme - Negative
+				PreConfig: unPatchFunc,
+				Config: ProviderConfigForTesting + `
+				resource "objectscale_storage_pool" "test_sp" {
+					name = "tfacc_sp"
+					description = "created by terraform"
+					warning_alert_at = 70
+					is_cold_storage_enabled = true
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is_cold_storage_enabled cannot be modified"),
+			},
+			{
+				// Update mock error
+				PreConfig: func() {
+					mockAPI = mockey.Mock((*clientgen.ObjectVarrayApiService).ObjectVarrayServiceUpdateVirtualArrayExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				Config: ProviderConfigForTesting + `
+				resource "objectscale_storage_pool" "test_sp" {
+					name = "tfacc_sp_updated"
+				}
+				`,
+				ExpectError: regexp.MustCompile("Error updating Storage Pool"),
+			},
+			{
+				// Update name, description and alerts
+				PreConfig: unPatchFunc,
+				Config: ProviderConfigForTesting + `
+				resource "objectscale_storage_pool" "test_sp" {
+					name = "tfacc_sp_updated"
+					description = "updated by terraform"
+					warning_alert_at = 60
+					error_alert_at = 80
+					critical_alert_at = 90
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objectscale_storage_pool.test_sp", "name", "tfacc_sp_updated"),
+					resource.TestCheckResourceAttr("objectscale_storage_pool.test_sp", "description", "updated by terraform"),
+					resource.TestCheckResourceAttr("objectscale_storage_pool.test_sp", "warning_alert_at", "60"),
+					resource.TestCheckResourceAttr("objectscale_storage_pool.test_sp", "error_alert_at", "80"),
+					resource.TestCheckResourceAttr("objectscale_storage_pool.test_sp", "critical_alert_at", "90"),
+				),
+			},
+			{
+				// Delete mock error
+				PreConfig: func() {
+					mockAPI = mockey.Mock((*clientgen.ObjectVarrayApiService).ObjectVarrayServiceDeleteVirtualArrayExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				Config:      ProviderConfigForTesting,
+				ExpectError: regexp.MustCompile("Error deleting Storage Pool"),
+			},
+			{
+				PreConfig: unPatchFunc,
+				Config:    ProviderConfigForTesting,
+			},
+		},
+	})
+}
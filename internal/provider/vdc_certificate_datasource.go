@@ -73,7 +73,7 @@ func (d *VDCCertificateDataSource) Read(ctx context.Context, req datasource.Read
 
 	chain, err := GetVDCKeystore(ctx, d.client)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading VDC certificate", err.Error())
+		resp.Diagnostics.AddError("Error reading VDC certificate", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -22,12 +22,15 @@ import (
 	"terraform-provider-objectscale/internal/helper"
 	"terraform-provider-objectscale/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -62,11 +65,17 @@ func (r *VDCCertificateResource) Schema(ctx context.Context, req resource.Schema
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"private_key": schema.StringAttribute{
-				Description:         "Private key in PEM format. Supports PKCS#1 (RSA PRIVATE KEY) and PKCS#8 (PRIVATE KEY) formats. PKCS#8 is supported on OBS 4.3+, but OBS 4.1 requires PKCS#1. Convert PKCS#8 to PKCS#1 for OBS 4.1 compatibility using: openssl rsa -in key.pem -out key-pkcs1.pem",
-				MarkdownDescription: "Private key in PEM format. Supports PKCS#1 (`RSA PRIVATE KEY`) and PKCS#8 (`PRIVATE KEY`) formats. PKCS#8 is supported on OBS 4.3+, but OBS 4.1 requires PKCS#1. Convert PKCS#8 to PKCS#1 for OBS 4.1 compatibility using: `openssl rsa -in key.pem -out key-pkcs1.pem`",
-				Required:            true,
+				Description:         "Private key in PEM format. Supports PKCS#1 (RSA PRIVATE KEY) and PKCS#8 (PRIVATE KEY) formats. PKCS#8 is supported on OBS 4.3+, but OBS 4.1 requires PKCS#1. Convert PKCS#8 to PKCS#1 for OBS 4.1 compatibility using: openssl rsa -in key.pem -out key-pkcs1.pem. Exactly one of private_key and private_key_wo must be set.",
+				MarkdownDescription: "Private key in PEM format. Supports PKCS#1 (`RSA PRIVATE KEY`) and PKCS#8 (`PRIVATE KEY`) formats. PKCS#8 is supported on OBS 4.3+, but OBS 4.1 requires PKCS#1. Convert PKCS#8 to PKCS#1 for OBS 4.1 compatibility using: `openssl rsa -in key.pem -out key-pkcs1.pem`. Exactly one of `private_key` and `private_key_wo` must be set.",
+				Optional:            true,
 				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("private_key_wo")),
+				},
 			},
+			"private_key_wo_version": helper.WriteOnlyVersionAttribute("private_key"),
+			"private_key_wo": helper.WriteOnlyAttribute("private_key",
+				"private key in PEM format, see `private_key`.", true),
 			"certificate_chain": schema.StringAttribute{
 				Description:         "Certificate chain in PEM format. Must contain at least one CERTIFICATE block.",
 				MarkdownDescription: "Certificate chain in PEM format. Must contain at least one CERTIFICATE block.",
@@ -78,6 +87,9 @@ func (r *VDCCertificateResource) Schema(ctx context.Context, req resource.Schema
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true}),
+		},
 	}
 }
 
@@ -88,7 +100,19 @@ func (r *VDCCertificateResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	r.applyVDCCertificate(ctx, &plan, &resp.Diagnostics, &resp.State)
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateKey, diags := helper.SecretValue(ctx, plan.PrivateKey, req.Config, "private_key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyVDCCertificate(ctx, &plan, privateKey.ValueString(), &resp.Diagnostics, &resp.State)
 }
 
 func (r *VDCCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -98,15 +122,22 @@ func (r *VDCCertificateResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	chain, err := GetVDCKeystore(ctx, r.client)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading VDC certificate", err.Error())
+		resp.Diagnostics.AddError("Error reading VDC certificate", helper.APIErrorDetail(err))
 		return
 	}
 
 	state.CurrentCertificateChain = types.StringValue(helper.NormalizeLineEndings(chain))
 
 	tflog.Trace(ctx, "read VDC certificate resource")
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -117,7 +148,19 @@ func (r *VDCCertificateResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	r.applyVDCCertificate(ctx, &plan, &resp.Diagnostics, &resp.State)
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateKey, diags := helper.SecretValue(ctx, plan.PrivateKey, req.Config, "private_key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyVDCCertificate(ctx, &plan, privateKey.ValueString(), &resp.Diagnostics, &resp.State)
 }
 
 func (r *VDCCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -128,7 +171,7 @@ func (r *VDCCertificateResource) Delete(ctx context.Context, req resource.Delete
 func (r *VDCCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	chain, err := GetVDCKeystore(ctx, r.client)
 	if err != nil {
-		resp.Diagnostics.AddError("Error importing VDC certificate", err.Error())
+		resp.Diagnostics.AddError("Error importing VDC certificate", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -141,25 +184,25 @@ func (r *VDCCertificateResource) ImportState(ctx context.Context, req resource.I
 }
 
 // applyVDCCertificate is shared between Create and Update.
-func (r *VDCCertificateResource) applyVDCCertificate(ctx context.Context, plan *models.VDCCertificateResourceModel, diagnostics *diag.Diagnostics, state *tfsdk.State) {
+// The private key is given apart from the plan, as it may be set by the write-only private_key_wo.
+func (r *VDCCertificateResource) applyVDCCertificate(ctx context.Context, plan *models.VDCCertificateResourceModel, privateKeyRaw string, diagnostics *diag.Diagnostics, state *tfsdk.State) {
 	// Validate PEM private key
-	privateKeyRaw := plan.PrivateKey.ValueString()
 	if err := helper.ValidatePEMPrivateKey(privateKeyRaw); err != nil {
-		diagnostics.AddError("Invalid Private Key", err.Error())
+		diagnostics.AddError("Invalid Private Key", helper.APIErrorDetail(err))
 		return
 	}
 
 	// Validate PEM certificate chain
 	certChainRaw := plan.CertificateChain.ValueString()
 	if err := helper.ValidatePEMCertificate(certChainRaw); err != nil {
-		diagnostics.AddError("Invalid Certificate Chain", err.Error())
+		diagnostics.AddError("Invalid Certificate Chain", helper.APIErrorDetail(err))
 		return
 	}
 
 	// Validate private key format (must be PKCS#1)
 	normalizedKey, err := helper.ValidateAndNormalizePrivateKey(privateKeyRaw)
 	if err != nil {
-		diagnostics.AddError("Invalid Private Key Format", err.Error())
+		diagnostics.AddError("Invalid Private Key Format", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -168,7 +211,7 @@ func (r *VDCCertificateResource) applyVDCCertificate(ctx context.Context, plan *
 	// Idempotency check: GET current chain and compare
 	currentChain, err := GetVDCKeystore(ctx, r.client)
 	if err != nil {
-		diagnostics.AddError("Error reading current VDC certificate for idempotency check", err.Error())
+		diagnostics.AddError("Error reading current VDC certificate for idempotency check", helper.APIErrorDetail(err))
 		return
 	}
 
@@ -177,7 +220,7 @@ func (r *VDCCertificateResource) applyVDCCertificate(ctx context.Context, plan *
 	} else {
 		// Chains differ — execute PUT
 		if err := PutVDCKeystore(ctx, r.client, normalizedKey, normalizedCertChain); err != nil {
-			diagnostics.AddError("Error updating VDC certificate", err.Error())
+			diagnostics.AddError("Error updating VDC certificate", helper.APIErrorDetail(err))
 			return
 		}
 		diagnostics.AddWarning("VDC Certificate Propagation Delay",
@@ -215,7 +215,7 @@ func (d *VDCDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		// get by id
 		dsResp, _, err := d.client.GenClient.ZoneInfoApi.ZoneInfoServiceGetVdcById(ctx, *id).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error fetching VDC with ID: "+*id, err.Error())
+			resp.Diagnostics.AddError("Error fetching VDC with ID: "+*id, helper.APIErrorDetail(err))
 			return
 		}
 		allPolicyResp = append(allPolicyResp, *dsResp)
@@ -223,7 +223,7 @@ func (d *VDCDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		// get by name
 		dsresp, _, err := d.client.GenClient.ZoneInfoApi.ZoneInfoServiceGetVdcByName(ctx, *name).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error fetching VDC with name: "+*name, err.Error())
+			resp.Diagnostics.AddError("Error fetching VDC with name: "+*name, helper.APIErrorDetail(err))
 			return
 		}
 		allPolicyResp = append(allPolicyResp, *dsresp)
@@ -231,7 +231,7 @@ func (d *VDCDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		// get local
 		dsresp, _, err := d.client.GenClient.ZoneInfoApi.ZoneInfoServiceGetLocalVdc(ctx).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error fetching local VDC", err.Error())
+			resp.Diagnostics.AddError("Error fetching local VDC", helper.APIErrorDetail(err))
 			return
 		}
 		allPolicyResp = append(allPolicyResp, *dsresp)
@@ -239,7 +239,7 @@ func (d *VDCDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		// get all VDCs
 		dsresp, _, err := d.client.GenClient.ZoneInfoApi.ZoneInfoServiceListAllVdc(ctx).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error fetching VDCs", err.Error())
+			resp.Diagnostics.AddError("Error fetching VDCs", helper.APIErrorDetail(err))
 			return
 		}
 		allPolicyResp = dsresp.Vdc
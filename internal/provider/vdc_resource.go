@@ -0,0 +1,311 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-objectscale/internal/clientgen"
+	"terraform-provider-objectscale/internal/helper"
+	"terraform-provider-objectscale/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VDCResource{}
+var _ resource.ResourceWithImportState = &VDCResource{}
+
+func NewVDCResource() resource.Resource {
+	return &VDCResource{}
+}
+
+// VDCResource defines the resource implementation.
+type VDCResource struct {
+	resourceProviderConfig
+}
+
+func (r *VDCResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vdc"
+}
+
+func (r *VDCResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This resource allows end user to insert and manage Dell ObjectScale Virtual Data Centers (VDCs) in the federation.",
+		MarkdownDescription: "This resource allows end user to insert and manage Dell ObjectScale Virtual Data Centers (VDCs) in the federation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the VDC.",
+				MarkdownDescription: "Identifier of the VDC.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the VDC. Cannot be updated.",
+				MarkdownDescription: "Name of the VDC. Cannot be updated.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"inter_vdc_end_points": schema.StringAttribute{
+				Description:         "Comma separated list of replication end points of the VDC.",
+				MarkdownDescription: "Comma separated list of replication end points of the VDC.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"inter_vdc_cmd_end_points": schema.StringAttribute{
+				Description:         "Comma separated list of control plane end points of the VDC.",
+				MarkdownDescription: "Comma separated list of control plane end points of the VDC.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"management_end_points": schema.StringAttribute{
+				Description:         "Comma separated list of management end points of the VDC.",
+				MarkdownDescription: "Comma separated list of management end points of the VDC.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"secret_key": schema.StringAttribute{
+				Description: "Secret key used to encrypt the traffic between VDCs." +
+					" This is the key returned by /object/vdcs/vdc/local/secretkey on the VDC being inserted," +
+					" which differs from the key of the local VDC for a remote VDC." +
+					" The array does not reliably return the secret key, so it can be empty after import," +
+					" in which case the first plan after the import shows an update of secret_key that sends the configured key to the VDC.",
+				MarkdownDescription: "Secret key used to encrypt the traffic between VDCs." +
+					" This is the key returned by `/object/vdcs/vdc/local/secretkey` on the VDC being inserted," +
+					" which differs from the key of the local VDC for a remote VDC." +
+					" The array does not reliably return the secret key, so it can be empty after import," +
+					" in which case the first plan after the import shows an update of `secret_key` that sends the configured key to the VDC.",
+				Required:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"local": schema.BoolAttribute{
+				Description:         "True if this VDC is local, false otherwise.",
+				MarkdownDescription: "True if this VDC is local, false otherwise.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"permanently_failed": schema.BoolAttribute{
+				Description:         "True if this VDC is permanently failed, false otherwise.",
+				MarkdownDescription: "True if this VDC is permanently failed, false otherwise.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+// helper function to marshal GET response to tfsdk model.
+// The secret key is not returned reliably by the array, so the configured one is kept when known.
+func (r *VDCResource) respToModel(vdc *clientgen.Vdc, secretKey types.String) models.VDCResourceModel {
+	if secretKey.IsNull() || secretKey.IsUnknown() {
+		secretKey = helper.TfStringNN(vdc.SecretKeys)
+	}
+	return models.VDCResourceModel{
+		ID:                   helper.TfStringNN(vdc.VdcId),
+		Name:                 helper.TfStringNN(vdc.VdcName),
+		InterVdcEndPoints:    helper.TfStringNN(vdc.InterVdcEndPoints),
+		InterVdcCmdEndPoints: helper.TfStringNN(vdc.InterVdcCmdEndPoints),
+		ManagementEndPoints:  helper.TfStringNN(vdc.ManagementEndPoints),
+		SecretKey:            secretKey,
+		Local:                helper.TfBoolNN(vdc.Local),
+		PermanentlyFailed:    helper.TfBoolNN(vdc.PermanentlyFailed),
+	}
+}
+
+// helper function to insert or update VDC info.
+func (r *VDCResource) insertVdcInfo(ctx context.Context, plan models.VDCResourceModel, secretKey types.String) error {
+	_, _, err := r.client.GenClient.ZoneInfoApi.ZoneInfoServiceInsertVdcInfo(ctx, plan.Name.ValueString()).
+		ZoneInfoServiceInsertVdcInfoRequest(clientgen.ZoneInfoServiceInsertVdcInfoRequest{
+			VdcName:              plan.Name.ValueStringPointer(),
+			InterVdcEndPoints:    plan.InterVdcEndPoints.ValueStringPointer(),
+			InterVdcCmdEndPoints: helper.ValueToPointer[string](plan.InterVdcCmdEndPoints),
+			ManagementEndPoints:  helper.ValueToPointer[string](plan.ManagementEndPoints),
+			SecretKeys:           secretKey.ValueStringPointer(),
+		}).
+		Execute()
+	return err
+}
+
+// Create.
+func (r *VDCResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "creating VDC")
+	var plan models.VDCResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretKey := plan.SecretKey
+	if err := r.insertVdcInfo(ctx, plan, secretKey); err != nil {
+		resp.Diagnostics.AddError("Error creating VDC", helper.APIErrorDetail(err))
+		return
+	}
+
+	// insert API does not return the VDC, so read it back by name
+	vdc, _, err := r.client.GenClient.ZoneInfoApi.ZoneInfoServiceGetVdcByName(ctx, plan.Name.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading VDC state after create", helper.APIErrorDetail(err))
+		return
+	}
+
+	// Save data into Terraform state
+	state := r.respToModel(vdc, secretKey)
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read.
+func (r *VDCResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.VDCResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vdc, _, err := r.client.GenClient.ZoneInfoApi.ZoneInfoServiceGetVdcById(ctx, state.ID.ValueString()).Execute()
+	if err != nil {
+		if helper.RemoveNotFound(ctx, err, &resp.State, "VDC "+state.ID.ValueString()) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading VDC state", helper.APIErrorDetail(err))
+		return
+	}
+
+	if vdc.VdcId == nil {
+		// if wrong ID is passed to API, this happens
+		helper.RemoveMissing(ctx, &resp.State, "VDC "+state.ID.ValueString())
+		return
+	}
+
+	state2 := r.respToModel(vdc, state.SecretKey)
+	state2.Timeouts = state.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state2)...)
+}
+
+// Update.
+func (r *VDCResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "updating VDC")
+	var state, plan models.VDCResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretKey := plan.SecretKey
+	if err := r.insertVdcInfo(ctx, plan, secretKey); err != nil {
+		resp.Diagnostics.AddError("Error updating VDC", helper.APIErrorDetail(err))
+		return
+	}
+
+	// Read updated data
+	vdc, _, err := r.client.GenClient.ZoneInfoApi.ZoneInfoServiceGetVdcById(ctx, state.ID.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading VDC state after update", helper.APIErrorDetail(err))
+		return
+	}
+	state2 := r.respToModel(vdc, secretKey)
+	state2.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state2)...)
+}
+
+// Delete.
+func (r *VDCResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "deleting VDC")
+	var state models.VDCResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, helper.DefaultTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := r.client.GenClient.ZoneInfoApi.ZoneInfoServiceDeactivateVdc(ctx, state.ID.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting VDC", helper.APIErrorDetail(err))
+	}
+}
+
+// ImportState.
+func (r *VDCResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	allVdcResp, _, err := r.client.GenClient.ZoneInfoApi.ZoneInfoServiceListAllVdc(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting the list of VDCs",
+			helper.APIErrorDetail(err),
+		)
+		return
+	}
+	// Find the resource with the matching ID or name
+	for _, vdc := range allVdcResp.Vdc {
+		if (vdc.VdcId != nil && *vdc.VdcId == req.ID) || (vdc.VdcName != nil && *vdc.VdcName == req.ID) {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), vdc.VdcId)...)
+			return
+		}
+	}
+	// return error if not found
+	resp.Diagnostics.AddError(fmt.Sprintf("Could not find VDC with ID or name %s", req.ID), "")
+}
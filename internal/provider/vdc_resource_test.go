@@ -0,0 +1,181 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-objectscale/internal/clientgen"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete VDC.
+func TestAccVDCRs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+	defer testUserTokenCleanup(t)
+
+	var mockAPI *mockey.Mocker
+	unPatchFunc := func() {
+		if mockAPI != nil {
+			mockAPI.UnPatch()
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// the secret key of the inserted VDC is required
+				Config: ProviderConfigForTesting + `
+				resource "objectscale_vdc" "test_vdc" {
+					name = "tfacc_vdc"
+					inter_vdc_end_points = "10.0.0.1"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`The argument "secret_key" is required`),
+			},
+			{
+				// Create mock error
+				PreConfig: func() {
+					unPatchFunc()
+					mockAPI = mockey.Mock((*clientgen.ZoneInfoApiService).ZoneInfoServiceInsertVdcInfoExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				Config: ProviderConfigForTesting + `
+				resource "objectscale_vdc" "test_vdc" {
+					name = "tfacc_vdc"
+					inter_vdc_end_points = "10.0.0.1"
+					secret_key = "tfacc_vdc_secret_key"
+				}
+				`,
+				ExpectError: regexp.MustCompile("Error creating VDC"),
+			},
+			{
+				// Create
+				PreConfig: unPatchFunc,
+				Config: ProviderConfigForTesting + `
+				resource "objectscale_vdc" "test_vdc" {
+					name = "tfacc_vdc"
+					inter_vdc_end_points = "10.0.0.1"
+					secret_key = "tfacc_vdc_secret_key"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objectscale_vdc.test_vdc", "name", "tfacc_vdc"),
+					resource.TestCheckResourceAttr("objectscale_vdc.test_vdc", "inter_vdc_end_points", "10.0.0.1"),
+					resource.TestCheckResourceAttrSet("objectscale_vdc.test_vdc", "id"),
+					resource.TestCheckResourceAttr("objectscale_vdc.test_vdc", "secret_key", "tfacc_vdc_secret_key"),
+				),
+			},
+			{
+				// Import by name
+				ResourceName:      "objectscale_vdc.test_vdc",
+				ImportState:       true,
+				ImportStateId:     "tfacc_vdc",
+				ImportStateVerify: true,
+				// the array does not reliably return the secret key
+				ImportStateVerifyIgnore: []string{"secret_key"},
+			},
+			{
+				// import invalid
+				ResourceName:  "objectscale_vdc.test_vdc",
+				ImportState:   true,
+				ImportStateId: "invalid-id",
+				ExpectError:   regexp.MustCompile("Could not find VDC with ID or name"),
+			},
+			{
+				// mock import error when getting list of VDCs
+				PreConfig: func() {
+					mockAPI = mockey.Mock((*clientgen.ZoneInfoApiService).ZoneInfoServiceListAllVdcExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				ResourceName:  "objectscale_vdc.test_vdc",
+				ImportState:   true,
+				ImportStateId: "tfacc_vdc",
+				ExpectError:   regexp.MustCompile("Error getting the list of VDCs"),
+			},
+			{
+				// mock refresh error
+				PreConfig: func() {
+					unPatchFunc()
+					mockAPI = mockey.Mock((*clientgen.ZoneInfoApiService).ZoneInfoServiceGetVdcByIdExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				RefreshState: true,
+				ExpectError:  regexp.MustCompile("Error reading VDC state"),
+			},
+			{
+				// Update mock error
+				PreConfig: func() {
+					unPatchFunc()
+					mockAPI = mockey.Mock((*clientgen.ZoneInfoApiService).ZoneInfoServiceInsertVdcInfoExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				Config: ProviderConfigForTesting + `
+				resource "objectscale_vdc" "test_vdc" {
+					name = "tfacc_vdc"
+					inter_vdc_end_points = "10.0.0.1,10.0.0.2"
+					secret_key = "tfacc_vdc_secret_key"
+				}
+				`,
+				ExpectError: regexp.MustCompile("Error updating VDC"),
+			},
+			{
+				// Update end points
+				PreConfig: unPatchFunc,
+				Config: ProviderConfigForTesting + `
+				resource "objectscale_vdc" "test_vdc" {
+					name = "tfacc_vdc"
+					inter_vdc_end_points = "10.0.0.1,10.0.0.2"
+					secret_key = "tfacc_vdc_secret_key"
+					management_end_points = "10.0.0.1,10.0.0.2"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objectscale_vdc.test_vdc", "inter_vdc_end_points", "10.0.0.1,10.0.0.2"),
+					resource.TestCheckResourceAttr("objectscale_vdc.test_vdc", "management_end_points", "10.0.0.1,10.0.0.2"),
+				),
+			},
+			{
+				// Delete mock error
+				PreConfig: func() {
+					mockAPI = mockey.Mock((*clientgen.ZoneInfoApiService).ZoneInfoServiceDeactivateVdcExecute).Return(
+						nil, nil, fmt.Errorf("mock error"),
+					).Build()
+				},
+				Config:      ProviderConfigForTesting,
+				ExpectError: regexp.MustCompile("Error deleting VDC"),
+			},
+			{
+				PreConfig: unPatchFunc,
+				Config:    ProviderConfigForTesting,
+			},
+		},
+	})
+}
@@ -0,0 +1,580 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testserver
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// bucket is a bucket stored by the simulator, with its settings managed by separate endpoints.
+type bucket struct {
+	name         string
+	namespace    string
+	info         document
+	acl          document
+	policy       document
+	notification document
+	copyPolicy   document
+	lockConfig   document
+}
+
+// bucketKey returns the key of a bucket in the buckets map.
+func bucketKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// bucketCreateFields maps the fields of the create request to the fields of the bucket info, when they differ.
+// The fields mapped to an empty name are not part of the bucket info.
+var bucketCreateFields = map[string]string{
+	"blockSize":                 "block_size",
+	"notificationSize":          "notification_size",
+	"filesystem_enabled":        "fs_access_enabled",
+	"head_type":                 "api_type",
+	"autocommit_period":         "auto_commit_period",
+	"audited_delete_expiration": "audit_delete_expiration",
+	"metadata_tokens":           "",
+	"storage_policy":            "",
+	"copy_policy":               "",
+}
+
+func (s *Server) registerBuckets(mux *http.ServeMux) {
+	mux.HandleFunc("GET /object/bucket", s.listBuckets)
+	mux.HandleFunc("POST /object/bucket", s.createBucket)
+	mux.HandleFunc("GET /object/bucket/copypolicy", s.listCopyPolicies)
+	mux.HandleFunc("GET /object/bucket/{bucket}/info", s.getBucketInfo)
+	mux.HandleFunc("POST /object/bucket/{bucket}/deactivate", s.deactivateBucket)
+	mux.HandleFunc("GET /object/bucket/{bucket}/empty-bucket-status", s.getEmptyBucketStatus)
+	mux.HandleFunc("GET /object/bucket/{bucket}/acl", s.getBucketACL)
+	mux.HandleFunc("PUT /object/bucket/{bucket}/acl", s.setBucketACL)
+	mux.HandleFunc("GET /object/bucket/{bucket}/policy", s.getBucketPolicy)
+	mux.HandleFunc("PUT /object/bucket/{bucket}/policy", s.setBucketPolicy)
+	mux.HandleFunc("DELETE /object/bucket/{bucket}/policy", s.deleteBucketPolicy)
+	mux.HandleFunc("GET /object/bucket/{bucket}/notification", s.getBucketNotification)
+	mux.HandleFunc("PUT /object/bucket/{bucket}/notification", s.setBucketNotification)
+	mux.HandleFunc("GET /object/bucket/{bucket}/copypolicy", s.getCopyPolicy)
+	mux.HandleFunc("POST /object/bucket/{bucket}/copypolicy", s.createCopyPolicy)
+	mux.HandleFunc("PUT /object/bucket/{bucket}/copypolicy", s.updateCopyPolicy)
+	mux.HandleFunc("DELETE /object/bucket/{bucket}/copypolicy", s.deleteCopyPolicy)
+	mux.HandleFunc("GET /object/bucket/{bucket}/object-lock-config", s.getBucketLockConfig)
+	mux.HandleFunc("PUT /object/bucket/{bucket}/object-lock-config", s.setBucketLockConfig)
+	mux.HandleFunc("POST /object/bucket/{bucket}/tags", s.updateBucketTags(addTags))
+	mux.HandleFunc("PUT /object/bucket/{bucket}/tags", s.updateBucketTags(addTags))
+	mux.HandleFunc("DELETE /object/bucket/{bucket}/tags", s.updateBucketTags(removeTags))
+	mux.HandleFunc("PUT /object/bucket/{bucket}/quota", s.updateBucketInfo(map[string]string{
+		"blockSize":        "block_size",
+		"notificationSize": "notification_size",
+	}))
+	mux.HandleFunc("DELETE /object/bucket/{bucket}/quota", s.removeBucketQuota)
+	mux.HandleFunc("PUT /object/bucket/{bucket}/retention", s.updateBucketInfo(map[string]string{"period": "retention"}))
+	mux.HandleFunc("PUT /object/bucket/{bucket}/autocommit", s.updateBucketInfo(map[string]string{"autocommit": "auto_commit_period"}))
+	mux.HandleFunc("PUT /object/bucket/{bucket}/defaultGroup", s.updateBucketInfo(nil))
+	mux.HandleFunc("POST /object/bucket/{bucket}/isstaleallowed", s.updateBucketInfo(nil))
+	mux.HandleFunc("POST /object/bucket/{bucket}/owner", s.updateBucketInfo(map[string]string{
+		"new_owner":             "owner",
+		"reset_previous_owners": "",
+	}))
+	mux.HandleFunc("PUT /object/bucket/{bucket}/versioning", s.updateBucketInfo(map[string]string{"Status": "versioning_status"}))
+	mux.HandleFunc("PUT /object/bucket/{bucket}/advancedMetadataSearchTarget", s.updateBucketInfo(map[string]string{
+		"target_name": "advancedMetadataSearchTargetName",
+		"stream_name": "advancedMetadataSearchTargetStream",
+	}))
+	mux.HandleFunc("PUT /object/bucket/{bucket}/advancedMetadataSearch", s.setBucketFlag("enableAdvancedMetadataSearch", true))
+	mux.HandleFunc("DELETE /object/bucket/{bucket}/advancedMetadataSearch", s.setBucketFlag("enableAdvancedMetadataSearch", false))
+	mux.HandleFunc("PUT /object/bucket/{bucket}/allow-object-lock-with-ado", s.setBucketFlag("is_object_lock_with_ado_allowed", true))
+	mux.HandleFunc("PUT /object/bucket/{bucket}/set-local-object-metadata-reads", s.setBucketLocalReads)
+	mux.HandleFunc("PUT /object/bucket/{bucket}/auditDeleteExpiration", s.setBucketAuditDeleteExpiration)
+}
+
+// bucket returns the bucket of the request path, writing a 404 when it does not exist.
+// The namespace is taken from the query, any namespace matches when it is not set.
+func (s *Server) bucket(w http.ResponseWriter, r *http.Request) (*bucket, bool) {
+	name, namespace := r.PathValue("bucket"), r.URL.Query().Get("namespace")
+	if b, ok := s.buckets[bucketKey(namespace, name)]; ok {
+		return b, true
+	}
+	if namespace == "" {
+		for _, key := range sortedKeys(s.buckets) {
+			if b := s.buckets[key]; b.name == name {
+				return b, true
+			}
+		}
+	}
+	writeNotFound(w, "bucket", name)
+	return nil, false
+}
+
+func (s *Server) listBuckets(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	var keys []string
+	for key, b := range s.buckets {
+		if (q.Get("namespace") == "" || b.namespace == q.Get("namespace")) && strings.HasPrefix(b.name, q.Get("name")) {
+			keys = append(keys, key)
+		}
+	}
+	page, next := paginate(keys, q.Get("marker"), q.Get("limit"))
+	items := make([]document, 0, len(page))
+	for _, key := range page {
+		items = append(items, s.buckets[key].info)
+	}
+	resp := document{"object_bucket": items}
+	if next != "" {
+		resp["NextMarker"] = next
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) createBucket(w http.ResponseWriter, r *http.Request) {
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	name, _ := req["name"].(string)
+	namespace, _ := req["namespace"].(string)
+	if name == "" {
+		writeMissingParam(w, "name")
+		return
+	}
+	if namespace == "" {
+		writeMissingParam(w, "namespace")
+		return
+	}
+	if _, ok := s.namespaces[namespace]; !ok {
+		writeNotFound(w, "namespace", namespace)
+		return
+	}
+	if _, ok := s.buckets[bucketKey(namespace, name)]; ok {
+		writeAlreadyExists(w, "bucket", name)
+		return
+	}
+
+	id := namespace + "." + name
+	info := document{
+		"name":                            name,
+		"id":                              id,
+		"link":                            link("/object/bucket/" + id),
+		"created":                         time.Now().UTC().Format("2006-01-02T15:04:05.000Z"),
+		"namespace":                       namespace,
+		"locked":                          false,
+		"api_type":                        "S3",
+		"fs_access_enabled":               false,
+		"softquota":                       "-1",
+		"block_size":                      -1,
+		"notification_size":               -1,
+		"blockSizeInCount":                -1,
+		"notificationSizeInCount":         -1,
+		"is_stale_allowed":                false,
+		"is_tso_read_only":                false,
+		"is_object_lock_enabled":          false,
+		"is_object_lock_with_ado_allowed": false,
+		"is_encryption_enabled":           "false",
+		"default_retention":               0,
+		"retention":                       0,
+		"auto_commit_period":              0,
+		"audit_delete_expiration":         -1,
+		"is_empty_bucket_in_progress":     false,
+		"enableAdvancedMetadataSearch":    false,
+		"local_object_metadata_reads":     false,
+		"TagSet":                          []document{},
+	}
+	merge(info, req, bucketCreateFields)
+	if _, ok := info["vpool"]; !ok {
+		if vpools := sortedKeys(s.vpools); len(vpools) > 0 {
+			info["vpool"] = vpools[0]
+		}
+	}
+	owner, _ := info["owner"].(string)
+
+	b := &bucket{
+		name:      name,
+		namespace: namespace,
+		info:      info,
+		acl: document{
+			"bucket":    name,
+			"namespace": namespace,
+			"acl": document{
+				"owner":    owner,
+				"user_acl": []document{{"user": owner, "permission": []string{"full_control"}}},
+			},
+		},
+	}
+	if cp, ok := req["copy_policy"].(map[string]any); ok {
+		b.copyPolicy = copyPolicyDocument(cp)
+	}
+	s.buckets[bucketKey(namespace, name)] = b
+	writeJSON(w, http.StatusOK, document{
+		"name":     name,
+		"id":       id,
+		"link":     info["link"],
+		"inactive": false,
+		"global":   false,
+		"remote":   false,
+		"internal": false,
+	})
+}
+
+func (s *Server) getBucketInfo(w http.ResponseWriter, r *http.Request) {
+	if b, ok := s.bucket(w, r); ok {
+		writeJSON(w, http.StatusOK, b.info)
+	}
+}
+
+// deactivateBucket deletes a bucket. The simulated buckets hold no objects,
+// so the bucket is deleted at once even when it is emptied first.
+func (s *Server) deactivateBucket(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	delete(s.buckets, bucketKey(b.namespace, b.name))
+	writeJSON(w, http.StatusOK, document{})
+}
+
+// getEmptyBucketStatus answers like the array when no empty bucket task is running.
+func (s *Server) getEmptyBucketStatus(w http.ResponseWriter, r *http.Request) {
+	if b, ok := s.bucket(w, r); ok {
+		writeNotFound(w, "empty bucket task of bucket", b.name)
+	}
+}
+
+func (s *Server) getBucketACL(w http.ResponseWriter, r *http.Request) {
+	if b, ok := s.bucket(w, r); ok {
+		writeJSON(w, http.StatusOK, b.acl)
+	}
+}
+
+func (s *Server) setBucketACL(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	req["bucket"], req["namespace"] = b.name, b.namespace
+	b.acl = req
+	writeJSON(w, http.StatusOK, document{})
+}
+
+func (s *Server) getBucketPolicy(w http.ResponseWriter, r *http.Request) {
+	if b, ok := s.bucket(w, r); ok {
+		if b.policy == nil {
+			writeJSON(w, http.StatusOK, document{})
+			return
+		}
+		writeJSON(w, http.StatusOK, b.policy)
+	}
+}
+
+func (s *Server) setBucketPolicy(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	b.policy = req
+	writeJSON(w, http.StatusOK, document{})
+}
+
+func (s *Server) deleteBucketPolicy(w http.ResponseWriter, r *http.Request) {
+	if b, ok := s.bucket(w, r); ok {
+		b.policy = nil
+		writeJSON(w, http.StatusOK, document{})
+	}
+}
+
+func (s *Server) getBucketNotification(w http.ResponseWriter, r *http.Request) {
+	if b, ok := s.bucket(w, r); ok {
+		if b.notification == nil {
+			writeJSON(w, http.StatusOK, document{"TopicConfiguration": []document{}})
+			return
+		}
+		writeJSON(w, http.StatusOK, b.notification)
+	}
+}
+
+func (s *Server) setBucketNotification(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	b.notification = req
+	writeJSON(w, http.StatusOK, document{})
+}
+
+// copyPolicyDocument returns the copy policy returned by the API for the given request, which never includes the secret key.
+func copyPolicyDocument(req document) document {
+	cp := document{"bucket_copy_policy_status": "Enabled"}
+	merge(cp, req, map[string]string{"target_secret_key": "", "paused": ""})
+	if paused, _ := req["paused"].(bool); paused {
+		cp["bucket_copy_policy_status"] = "Paused"
+	}
+	return cp
+}
+
+func (s *Server) listCopyPolicies(w http.ResponseWriter, r *http.Request) {
+	account := r.URL.Query().Get("account")
+	items := []document{}
+	for _, key := range sortedKeys(s.buckets) {
+		if cp := s.buckets[key].copyPolicy; cp != nil && (account == "" || cp["target_account"] == account) {
+			items = append(items, cp)
+		}
+	}
+	writeJSON(w, http.StatusOK, document{"bucket_copy_policy": items})
+}
+
+func (s *Server) getCopyPolicy(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	if b.copyPolicy == nil {
+		writeNotFound(w, "copy policy of bucket", b.name)
+		return
+	}
+	writeJSON(w, http.StatusOK, b.copyPolicy)
+}
+
+func (s *Server) createCopyPolicy(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	if b.copyPolicy != nil {
+		writeAlreadyExists(w, "copy policy of bucket", b.name)
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	b.copyPolicy = copyPolicyDocument(req)
+	writeJSON(w, http.StatusOK, document{})
+}
+
+func (s *Server) updateCopyPolicy(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	if b.copyPolicy == nil {
+		writeNotFound(w, "copy policy of bucket", b.name)
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	status := b.copyPolicy["bucket_copy_policy_status"]
+	merge(b.copyPolicy, copyPolicyDocument(req), nil)
+	if _, ok := req["paused"]; !ok {
+		b.copyPolicy["bucket_copy_policy_status"] = status
+	}
+	writeJSON(w, http.StatusOK, document{})
+}
+
+func (s *Server) deleteCopyPolicy(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	if b.copyPolicy == nil {
+		writeNotFound(w, "copy policy of bucket", b.name)
+		return
+	}
+	b.copyPolicy = nil
+	writeJSON(w, http.StatusOK, document{})
+}
+
+func (s *Server) getBucketLockConfig(w http.ResponseWriter, r *http.Request) {
+	if b, ok := s.bucket(w, r); ok {
+		if b.lockConfig == nil {
+			writeJSON(w, http.StatusOK, document{"ObjectLockEnabled": "Disabled"})
+			return
+		}
+		writeJSON(w, http.StatusOK, b.lockConfig)
+	}
+}
+
+func (s *Server) setBucketLockConfig(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	var req struct {
+		ObjectLockEnabled string `json:"ObjectLockEnabled"`
+		Rule              *struct {
+			DefaultRetention *struct {
+				Mode  *string `json:"Mode"`
+				Years *int32  `json:"Years"`
+				Days  *int32  `json:"Days"`
+			} `json:"DefaultRetention"`
+		} `json:"Rule"`
+	}
+	if !readBody(w, r, &req) {
+		return
+	}
+	b.info["is_object_lock_enabled"] = req.ObjectLockEnabled == "Enabled"
+	for _, f := range []string{"default_object_lock_retention_mode", "default_object_lock_retention_years", "default_object_lock_retention_days"} {
+		delete(b.info, f)
+	}
+	if req.Rule != nil && req.Rule.DefaultRetention != nil {
+		if ret := req.Rule.DefaultRetention; ret.Mode != nil {
+			b.info["default_object_lock_retention_mode"] = *ret.Mode
+		}
+		if ret := req.Rule.DefaultRetention; ret.Years != nil {
+			b.info["default_object_lock_retention_years"] = *ret.Years
+		}
+		if ret := req.Rule.DefaultRetention; ret.Days != nil {
+			b.info["default_object_lock_retention_days"] = *ret.Days
+		}
+	}
+	var cfg document
+	_ = convert(req, &cfg)
+	b.lockConfig = cfg
+	writeJSON(w, http.StatusOK, document{})
+}
+
+// tagsUpdate updates the tags of a bucket with the tags of the request.
+type tagsUpdate func(current, changes []document) []document
+
+// addTags adds the tags, or replaces the value of the existing keys.
+func addTags(current, changes []document) []document {
+	for _, c := range changes {
+		replaced := false
+		for _, t := range current {
+			if t["Key"] == c["Key"] {
+				t["Value"] = c["Value"]
+				replaced = true
+			}
+		}
+		if !replaced {
+			current = append(current, c)
+		}
+	}
+	return current
+}
+
+// removeTags removes the tags with the same keys.
+func removeTags(current, changes []document) []document {
+	kept := []document{}
+	for _, t := range current {
+		removed := false
+		for _, c := range changes {
+			removed = removed || t["Key"] == c["Key"]
+		}
+		if !removed {
+			kept = append(kept, t)
+		}
+	}
+	return kept
+}
+
+func (s *Server) updateBucketTags(update tagsUpdate) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		b, ok := s.bucket(w, r)
+		if !ok {
+			return
+		}
+		var req struct {
+			TagSet []document `json:"TagSet"`
+		}
+		if !readBody(w, r, &req) {
+			return
+		}
+		var current []document
+		_ = convert(b.info["TagSet"], &current)
+		b.info["TagSet"] = update(current, req.TagSet)
+		writeJSON(w, http.StatusOK, document{})
+	}
+}
+
+// updateBucketInfo returns a handler copying the fields of the request body into the bucket info.
+// The namespace of the request is never copied.
+func (s *Server) updateBucketInfo(rename map[string]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		b, ok := s.bucket(w, r)
+		if !ok {
+			return
+		}
+		var req document
+		if !readBody(w, r, &req) {
+			return
+		}
+		delete(req, "namespace")
+		merge(b.info, req, rename)
+		writeJSON(w, http.StatusOK, document{})
+	}
+}
+
+// setBucketFlag returns a handler setting a boolean field of the bucket info.
+func (s *Server) setBucketFlag(field string, value bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if b, ok := s.bucket(w, r); ok {
+			b.info[field] = value
+			writeJSON(w, http.StatusOK, document{})
+		}
+	}
+}
+
+func (s *Server) removeBucketQuota(w http.ResponseWriter, r *http.Request) {
+	if b, ok := s.bucket(w, r); ok {
+		for _, f := range []string{"block_size", "notification_size", "blockSizeInCount", "notificationSizeInCount"} {
+			b.info[f] = -1
+		}
+		writeJSON(w, http.StatusOK, document{})
+	}
+}
+
+func (s *Server) setBucketLocalReads(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	enabled, err := strconv.ParseBool(r.URL.Query().Get("enabled"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, codeInvalidParam, "Invalid parameter", "enabled must be true or false")
+		return
+	}
+	b.info["local_object_metadata_reads"] = enabled
+	writeJSON(w, http.StatusOK, document{})
+}
+
+func (s *Server) setBucketAuditDeleteExpiration(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.bucket(w, r)
+	if !ok {
+		return
+	}
+	expiration, err := strconv.ParseInt(r.URL.Query().Get("expiration"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, codeInvalidParam, "Invalid parameter", "expiration must be a number")
+		return
+	}
+	b.info["audit_delete_expiration"] = expiration
+	writeJSON(w, http.StatusOK, document{})
+}
@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testserver
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// fixtureInlinePolicy is the inline policy of the seeded IAM entities.
+const fixtureInlinePolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*"}]}`
+
+// fixtureAssumeRolePolicy is the trust policy of the seeded IAM role.
+const fixtureAssumeRolePolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["urn:ecs:iam::ns1:root"]},"Action":"sts:AssumeRoleWithWebIdentity"}]}`
+
+// seed creates the entities that the acceptance tests assume to exist on the test array:
+//   - the local VDC vdc1 and the remote VDCs vdc2 and vdc3, each with a storage pool sp1
+//   - the replication group rg1 over the storage pool sp1 of vdc1
+//   - the namespace ns1, whose default replication group is rg1
+//   - in ns1, the IAM users user_001, sample_user_1 and userTest1, the groups group_008 and groupTest1,
+//     and the role roleTest1; group_008 holds user_001 and sample_user_1, and sample_user_1,
+//     group_008 and roleTest1 have an inline policy
+//   - a self-signed certificate in each keystore
+//   - the local management user testlocaluser1
+func (s *Server) seed() {
+	local := s.addVdc("vdc1", "10.0.0.1", randomString(simulatedKeyLength), true)
+	s.addVarray(local["vdcId"].(string), "sp1")
+	for i, name := range []string{"vdc2", "vdc3"} {
+		vdc := s.addVdc(name, fmt.Sprintf("10.0.0.%d", i+2), randomString(simulatedKeyLength), false)
+		s.addVarray(vdc["vdcId"].(string), "sp1")
+	}
+
+	rg := s.addVpool("rg1")
+	for id, vdc := range s.varrayVdcs {
+		if vdc == local["vdcId"] {
+			rg["varrayMappings"] = []document{{"name": vdc, "value": id, "isReplicationTarget": false}}
+		}
+	}
+
+	ns := s.addNamespace("ns1")
+	ns["default_data_services_vpool"] = rg["id"]
+	s.seedIAM("ns1")
+
+	for _, path := range []string{vdcKeystore, objectCertKeystore} {
+		chain, err := selfSignedChain([]string{"127.0.0.1"})
+		if err != nil {
+			panic(err)
+		}
+		s.keystores[path] = chain
+	}
+
+	s.addManagementUser("testlocaluser1", document{"password": "password"})
+}
+
+// seedIAM creates the IAM entities of the namespace through the IAM actions, so they are stored
+// exactly as if created through the API.
+func (s *Server) seedIAM(namespace string) {
+	ns, e := s.iamNamespace(namespace)
+	if e == nil {
+		for _, call := range []struct {
+			action string
+			params url.Values
+		}{
+			{"CreateUser", url.Values{"UserName": {"user_001"}}},
+			{"CreateUser", url.Values{"UserName": {"sample_user_1"}}},
+			{"CreateUser", url.Values{"UserName": {"userTest1"}}},
+			{"CreateGroup", url.Values{"GroupName": {"group_008"}}},
+			{"CreateGroup", url.Values{"GroupName": {"groupTest1"}}},
+			{"CreateRole", url.Values{"RoleName": {"roleTest1"}, "AssumeRolePolicyDocument": {fixtureAssumeRolePolicy}}},
+			{"AddUserToGroup", url.Values{"GroupName": {"group_008"}, "UserName": {"user_001"}}},
+			{"AddUserToGroup", url.Values{"GroupName": {"group_008"}, "UserName": {"sample_user_1"}}},
+			{"PutUserPolicy", url.Values{"UserName": {"sample_user_1"}, "PolicyName": {"fixturePolicy"}, "PolicyDocument": {fixtureInlinePolicy}}},
+			{"PutGroupPolicy", url.Values{"GroupName": {"group_008"}, "PolicyName": {"fixturePolicy"}, "PolicyDocument": {fixtureInlinePolicy}}},
+			{"PutRolePolicy", url.Values{"RoleName": {"roleTest1"}, "PolicyName": {"fixturePolicy"}, "PolicyDocument": {fixtureInlinePolicy}}},
+		} {
+			if _, e = iamActions[call.action](s, ns, call.params); e != nil {
+				break
+			}
+		}
+	}
+	if e != nil {
+		panic(e.code + ": " + e.message)
+	}
+}
@@ -0,0 +1,1851 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testserver
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// maxPolicyVersions and maxAccessKeys are the limits of the IAM API.
+const (
+	maxPolicyVersions = 5
+	maxAccessKeys     = 2
+)
+
+// globalPolicies are the managed policies available in every namespace, with their policy document.
+var globalPolicies = map[string]string{
+	"ECSS3ReadOnlyAccess": `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:Get*","s3:List*"],"Resource":"*"}]}`,
+	"ECSS3FullAccess":     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+	"IAMReadOnlyAccess":   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["iam:Get*","iam:List*"],"Resource":"*"}]}`,
+	"IAMFullAccess":       `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"iam:*","Resource":"*"}]}`,
+}
+
+// iamError is an error of the IAM API, written as XML like the array does.
+type iamError struct {
+	status  int
+	code    string
+	message string
+}
+
+func noSuchEntity(format string, args ...any) *iamError {
+	return &iamError{http.StatusNotFound, "NoSuchEntity", fmt.Sprintf(format, args...)}
+}
+
+func entityAlreadyExists(format string, args ...any) *iamError {
+	return &iamError{http.StatusConflict, "EntityAlreadyExists", fmt.Sprintf(format, args...)}
+}
+
+func deleteConflict(format string, args ...any) *iamError {
+	return &iamError{http.StatusConflict, "DeleteConflict", fmt.Sprintf(format, args...)}
+}
+
+func limitExceeded(format string, args ...any) *iamError {
+	return &iamError{http.StatusConflict, "LimitExceeded", fmt.Sprintf(format, args...)}
+}
+
+func validationError(format string, args ...any) *iamError {
+	return &iamError{http.StatusBadRequest, "ValidationError", fmt.Sprintf(format, args...)}
+}
+
+// iamErrorResponse is the XML body of an IAM error.
+type iamErrorResponse struct {
+	XMLName xml.Name `xml:"ErrorResponse"`
+	Error   struct {
+		Type    string `xml:"Type"`
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	} `xml:"Error"`
+	RequestID string `xml:"RequestId"`
+}
+
+// writeIAMError writes an error of the IAM API.
+func writeIAMError(w http.ResponseWriter, e *iamError) {
+	var body iamErrorResponse
+	body.Error.Type = "Sender"
+	body.Error.Code = e.code
+	body.Error.Message = e.message
+	body.RequestID = randomHex(32)
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(e.status)
+	_, _ = w.Write([]byte(xml.Header))
+	_ = xml.NewEncoder(w).Encode(body)
+}
+
+// iamNamespace holds the IAM entities of a namespace.
+type iamNamespace struct {
+	name          string
+	users         map[string]*iamUser
+	groups        map[string]*iamGroup
+	roles         map[string]*iamRole
+	policies      map[string]*iamPolicy // customer managed policies, keyed by ARN
+	samlProviders map[string]*samlProvider
+}
+
+// iamPrincipal holds the policies of a user, group or role.
+type iamPrincipal struct {
+	inline   map[string]string
+	attached []string // ARNs of the attached managed policies
+}
+
+type iamUser struct {
+	iamPrincipal
+	info       document
+	boundary   string
+	tags       []document
+	groups     []string
+	accessKeys []document
+}
+
+type iamGroup struct {
+	iamPrincipal
+	info document
+}
+
+type iamRole struct {
+	iamPrincipal
+	info     document
+	boundary string
+	tags     []document
+}
+
+type iamPolicy struct {
+	info        document
+	versions    []document
+	nextVersion int
+}
+
+type samlProvider struct {
+	arn        string
+	metadata   string
+	createDate string
+	validUntil string
+}
+
+// iamAction handles an IAM action of a namespace, returning its result or an error.
+type iamAction func(s *Server, ns *iamNamespace, q url.Values) (any, *iamError)
+
+// iamActions are the supported IAM actions.
+var iamActions = map[string]iamAction{
+	"CreateUser":                       (*Server).iamCreateUser,
+	"GetUser":                          (*Server).iamGetUser,
+	"ListUsers":                        (*Server).iamListUsers,
+	"DeleteUser":                       (*Server).iamDeleteUser,
+	"TagUser":                          (*Server).iamTagUser,
+	"UntagUser":                        (*Server).iamUntagUser,
+	"ListUserTags":                     (*Server).iamListUserTags,
+	"PutUserPermissionsBoundary":       (*Server).iamPutUserPermissionsBoundary,
+	"DeleteUserPermissionsBoundary":    (*Server).iamDeleteUserPermissionsBoundary,
+	"CreateGroup":                      (*Server).iamCreateGroup,
+	"GetGroup":                         (*Server).iamGetGroup,
+	"ListGroups":                       (*Server).iamListGroups,
+	"DeleteGroup":                      (*Server).iamDeleteGroup,
+	"AddUserToGroup":                   (*Server).iamAddUserToGroup,
+	"RemoveUserFromGroup":              (*Server).iamRemoveUserFromGroup,
+	"ListGroupsForUser":                (*Server).iamListGroupsForUser,
+	"CreateRole":                       (*Server).iamCreateRole,
+	"GetRole":                          (*Server).iamGetRole,
+	"ListRoles":                        (*Server).iamListRoles,
+	"UpdateRole":                       (*Server).iamUpdateRole,
+	"UpdateAssumeRolePolicy":           (*Server).iamUpdateAssumeRolePolicy,
+	"DeleteRole":                       (*Server).iamDeleteRole,
+	"TagRole":                          (*Server).iamTagRole,
+	"UntagRole":                        (*Server).iamUntagRole,
+	"ListRoleTags":                     (*Server).iamListRoleTags,
+	"PutRolePermissionsBoundary":       (*Server).iamPutRolePermissionsBoundary,
+	"DeleteRolePermissionsBoundary":    (*Server).iamDeleteRolePermissionsBoundary,
+	"PutUserPolicy":                    principalAction("User", (*iamNamespace).userPrincipal, putInlinePolicy),
+	"GetUserPolicy":                    principalAction("User", (*iamNamespace).userPrincipal, getInlinePolicy),
+	"DeleteUserPolicy":                 principalAction("User", (*iamNamespace).userPrincipal, deleteInlinePolicy),
+	"ListUserPolicies":                 principalAction("User", (*iamNamespace).userPrincipal, listInlinePolicies),
+	"PutGroupPolicy":                   principalAction("Group", (*iamNamespace).groupPrincipal, putInlinePolicy),
+	"GetGroupPolicy":                   principalAction("Group", (*iamNamespace).groupPrincipal, getInlinePolicy),
+	"DeleteGroupPolicy":                principalAction("Group", (*iamNamespace).groupPrincipal, deleteInlinePolicy),
+	"ListGroupPolicies":                principalAction("Group", (*iamNamespace).groupPrincipal, listInlinePolicies),
+	"PutRolePolicy":                    principalAction("Role", (*iamNamespace).rolePrincipal, putInlinePolicy),
+	"GetRolePolicy":                    principalAction("Role", (*iamNamespace).rolePrincipal, getInlinePolicy),
+	"DeleteRolePolicy":                 principalAction("Role", (*iamNamespace).rolePrincipal, deleteInlinePolicy),
+	"ListRolePolicies":                 principalAction("Role", (*iamNamespace).rolePrincipal, listInlinePolicies),
+	"AttachUserPolicy":                 principalAction("User", (*iamNamespace).userPrincipal, attachPolicy),
+	"DetachUserPolicy":                 principalAction("User", (*iamNamespace).userPrincipal, detachPolicy),
+	"ListAttachedUserPolicies":         principalAction("User", (*iamNamespace).userPrincipal, listAttachedPolicies),
+	"AttachGroupPolicy":                principalAction("Group", (*iamNamespace).groupPrincipal, attachPolicy),
+	"DetachGroupPolicy":                principalAction("Group", (*iamNamespace).groupPrincipal, detachPolicy),
+	"ListAttachedGroupPolicies":        principalAction("Group", (*iamNamespace).groupPrincipal, listAttachedPolicies),
+	"AttachRolePolicy":                 principalAction("Role", (*iamNamespace).rolePrincipal, attachPolicy),
+	"DetachRolePolicy":                 principalAction("Role", (*iamNamespace).rolePrincipal, detachPolicy),
+	"ListAttachedRolePolicies":         principalAction("Role", (*iamNamespace).rolePrincipal, listAttachedPolicies),
+	"CreatePolicy":                     (*Server).iamCreatePolicy,
+	"GetPolicy":                        (*Server).iamGetPolicy,
+	"ListPolicies":                     (*Server).iamListPolicies,
+	"DeletePolicy":                     (*Server).iamDeletePolicy,
+	"ListEntitiesForPolicy":            (*Server).iamListEntitiesForPolicy,
+	"CreatePolicyVersion":              (*Server).iamCreatePolicyVersion,
+	"GetPolicyVersion":                 (*Server).iamGetPolicyVersion,
+	"ListPolicyVersions":               (*Server).iamListPolicyVersions,
+	"DeletePolicyVersion":              (*Server).iamDeletePolicyVersion,
+	"SetDefaultPolicyVersion":          (*Server).iamSetDefaultPolicyVersion,
+	"CreateAccessKey":                  (*Server).iamCreateAccessKey,
+	"ListAccessKeys":                   (*Server).iamListAccessKeys,
+	"UpdateAccessKey":                  (*Server).iamUpdateAccessKey,
+	"DeleteAccessKey":                  (*Server).iamDeleteAccessKey,
+	"CreateSAMLProvider":               (*Server).iamCreateSAMLProvider,
+	"GetSAMLProvider":                  (*Server).iamGetSAMLProvider,
+	"ListSAMLProviders":                (*Server).iamListSAMLProviders,
+	"UpdateSAMLProvider":               (*Server).iamUpdateSAMLProvider,
+	"DeleteSAMLProvider":               (*Server).iamDeleteSAMLProvider,
+	"SimulatePrincipalPolicy":          (*Server).iamSimulatePrincipalPolicy,
+	"SimulateCustomPolicy":             (*Server).iamSimulateCustomPolicy,
+	"GetContextKeysForPrincipalPolicy": (*Server).iamGetContextKeysForPrincipalPolicy,
+	"GetContextKeysForCustomPolicy":    (*Server).iamGetContextKeysForCustomPolicy,
+}
+
+// iamResultKeys are the result elements of the actions not named after the action, as returned by the array.
+var iamResultKeys = map[string]string{
+	"SimulateCustomPolicy": "SimulateCustomerPolicyResult",
+}
+
+func (s *Server) registerIAM(mux *http.ServeMux) {
+	mux.HandleFunc("POST /iam", s.iamQuery)
+}
+
+// iamQuery dispatches the IAM query API, whose action and parameters are given in the query
+// and whose namespace is given in the x-emc-namespace header.
+func (s *Server) iamQuery(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	action, ok := iamActions[q.Get("Action")]
+	if !ok {
+		writeIAMError(w, &iamError{http.StatusNotImplemented, "NotImplemented",
+			fmt.Sprintf("action %s is not implemented by the test server", q.Get("Action"))})
+		return
+	}
+	ns, e := s.iamNamespace(r.Header.Get("x-emc-namespace"))
+	if e != nil {
+		writeIAMError(w, e)
+		return
+	}
+	result, e := action(s, ns, q)
+	if e != nil {
+		writeIAMError(w, e)
+		return
+	}
+	resp := document{"ResponseMetadata": document{"RequestId": randomHex(32)}}
+	if result != nil {
+		key, ok := iamResultKeys[q.Get("Action")]
+		if !ok {
+			key = q.Get("Action") + "Result"
+		}
+		resp[key] = result
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// iamNamespace returns the IAM entities of a namespace.
+func (s *Server) iamNamespace(name string) (*iamNamespace, *iamError) {
+	if name == "" {
+		return nil, validationError("the x-emc-namespace header is required")
+	}
+	if _, ok := s.namespaces[name]; !ok {
+		return nil, validationError("namespace %s does not exist", name)
+	}
+	ns, ok := s.iam[name]
+	if !ok {
+		ns = &iamNamespace{
+			name:          name,
+			users:         map[string]*iamUser{},
+			groups:        map[string]*iamGroup{},
+			roles:         map[string]*iamRole{},
+			policies:      map[string]*iamPolicy{},
+			samlProviders: map[string]*samlProvider{},
+		}
+		s.iam[name] = ns
+	}
+	return ns, nil
+}
+
+// arn returns the ARN of an entity of the namespace.
+func (ns *iamNamespace) arn(kind, path, name string) string {
+	return fmt.Sprintf("urn:ecs:iam::%s:%s%s%s", ns.name, kind, path, name)
+}
+
+// required returns the value of a required parameter.
+func required(q url.Values, param string) (string, *iamError) {
+	v := q.Get(param)
+	if v == "" {
+		return "", validationError("%s is required", param)
+	}
+	return v, nil
+}
+
+// path returns the Path parameter, which defaults to "/".
+func path(q url.Values) (string, *iamError) {
+	p := q.Get("Path")
+	if p == "" {
+		return "/", nil
+	}
+	if !strings.HasPrefix(p, "/") || !strings.HasSuffix(p, "/") {
+		return "", validationError("the path %s must begin and end with /", p)
+	}
+	return p, nil
+}
+
+// members returns the values of a list parameter, like TagKeys.member.1, TagKeys.member.2...
+func members(q url.Values, prefix, suffix string) []string {
+	var values []string
+	for i := 1; q.Has(fmt.Sprintf("%s.member.%d%s", prefix, i, suffix)); i++ {
+		values = append(values, q.Get(fmt.Sprintf("%s.member.%d%s", prefix, i, suffix)))
+	}
+	return values
+}
+
+// tags returns the tags of the request, with the given key and value field names.
+func tags(q url.Values, key, value string) []document {
+	keys, values := members(q, "Tags", ".Key"), members(q, "Tags", ".Value")
+	out := make([]document, 0, len(keys))
+	for i, k := range keys {
+		out = append(out, document{key: k, value: values[i]})
+	}
+	return out
+}
+
+// mergeTags adds the tags, or replaces the value of the existing keys.
+func mergeTags(current, changes []document, key, value string) []document {
+	for _, c := range changes {
+		i := slices.IndexFunc(current, func(t document) bool { return t[key] == c[key] })
+		if i < 0 {
+			current = append(current, c)
+		} else {
+			current[i][value] = c[value]
+		}
+	}
+	return current
+}
+
+// untag removes the tags with the given keys.
+func untag(current []document, key string, keys []string) []document {
+	return slices.DeleteFunc(current, func(t document) bool {
+		k, _ := t[key].(string)
+		return slices.Contains(keys, k)
+	})
+}
+
+// page returns a page of the sorted keys, with the pagination fields of the IAM list results.
+func page(keys []string, q url.Values) ([]string, document) {
+	p, next := paginate(keys, q.Get("Marker"), q.Get("MaxItems"))
+	result := document{"IsTruncated": next != ""}
+	if next != "" {
+		result["Marker"] = next
+	}
+	return p, result
+}
+
+// policy returns the managed policy with the given ARN, either a global or a namespace policy.
+func (s *Server) policy(ns *iamNamespace, arn string) (*iamPolicy, *iamError) {
+	if p, ok := ns.policies[arn]; ok {
+		return p, nil
+	}
+	if name, ok := strings.CutPrefix(arn, "urn:ecs:iam:::policy/"); ok {
+		if doc, ok := globalPolicies[name]; ok {
+			return &iamPolicy{
+				info: document{
+					"Arn":              arn,
+					"PolicyName":       name,
+					"PolicyId":         "ANPA" + strings.ToUpper(name),
+					"Path":             "/",
+					"DefaultVersionId": "v1",
+					"IsAttachable":     true,
+					"CreateDate":       "2020-01-01T00:00:00Z",
+					"UpdateDate":       "2020-01-01T00:00:00Z",
+				},
+				versions: []document{{
+					"VersionId":        "v1",
+					"Document":         url.QueryEscape(doc),
+					"IsDefaultVersion": true,
+					"CreateDate":       "2020-01-01T00:00:00Z",
+				}},
+			}, nil
+		}
+	}
+	return nil, noSuchEntity("Policy %s does not exist or is not attachable.", arn)
+}
+
+// policyInfo returns the policy with its attachment counts in the namespace.
+func (ns *iamNamespace) policyInfo(p *iamPolicy) document {
+	info := document{}
+	merge(info, p.info, nil)
+	arn, _ := p.info["Arn"].(string)
+	attachments, boundaries := 0, 0
+	for _, pr := range ns.principals() {
+		if slices.Contains(pr.attached, arn) {
+			attachments++
+		}
+	}
+	for _, u := range ns.users {
+		if u.boundary == arn {
+			boundaries++
+		}
+	}
+	for _, r := range ns.roles {
+		if r.boundary == arn {
+			boundaries++
+		}
+	}
+	info["AttachmentCount"] = attachments
+	info["PermissionsBoundaryUsageCount"] = boundaries
+	return info
+}
+
+// principals returns the policies of all the users, groups and roles of the namespace.
+func (ns *iamNamespace) principals() []*iamPrincipal {
+	var out []*iamPrincipal
+	for _, u := range ns.users {
+		out = append(out, &u.iamPrincipal)
+	}
+	for _, g := range ns.groups {
+		out = append(out, &g.iamPrincipal)
+	}
+	for _, r := range ns.roles {
+		out = append(out, &r.iamPrincipal)
+	}
+	return out
+}
+
+// boundary validates a permissions boundary ARN.
+func (s *Server) boundary(ns *iamNamespace, arn string) *iamError {
+	if arn == "" {
+		return nil
+	}
+	_, e := s.policy(ns, arn)
+	return e
+}
+
+// boundaryDocument returns the permissions boundary of a user or role.
+func boundaryDocument(arn string) document {
+	return document{"PermissionsBoundaryArn": arn, "PermissionsBoundaryType": "PermissionsBoundaryPolicy"}
+}
+
+// validPolicyDocument checks that a policy document is a JSON object.
+func validPolicyDocument(doc string) *iamError {
+	var v map[string]any
+	if err := json.Unmarshal([]byte(doc), &v); err != nil {
+		return &iamError{http.StatusBadRequest, "MalformedPolicyDocument", "The policy document is not valid JSON: " + err.Error()}
+	}
+	return nil
+}
+
+// ---- users
+
+func (ns *iamNamespace) user(q url.Values) (*iamUser, *iamError) {
+	name, e := required(q, "UserName")
+	if e != nil {
+		return nil, e
+	}
+	u, ok := ns.users[name]
+	if !ok {
+		return nil, noSuchEntity("The user with name %s cannot be found.", name)
+	}
+	return u, nil
+}
+
+// userDocument returns the user as returned by CreateUser and GetUser.
+func (u *iamUser) document() document {
+	out := document{}
+	merge(out, u.info, nil)
+	if u.boundary != "" {
+		out["PermissionsBoundary"] = boundaryDocument(u.boundary)
+	}
+	if len(u.tags) > 0 {
+		out["Tags"] = u.tags
+	}
+	return out
+}
+
+func (s *Server) iamCreateUser(ns *iamNamespace, q url.Values) (any, *iamError) {
+	name, e := required(q, "UserName")
+	if e != nil {
+		return nil, e
+	}
+	p, e := path(q)
+	if e != nil {
+		return nil, e
+	}
+	if _, ok := ns.users[name]; ok {
+		return nil, entityAlreadyExists("User with name %s already exists.", name)
+	}
+	if e := s.boundary(ns, q.Get("PermissionsBoundary")); e != nil {
+		return nil, e
+	}
+	u := &iamUser{
+		iamPrincipal: iamPrincipal{inline: map[string]string{}},
+		info: document{
+			"UserName":   name,
+			"UserId":     "AIDA" + strings.ToUpper(randomHex(17)),
+			"Arn":        ns.arn("user", p, name),
+			"Path":       p,
+			"CreateDate": nowISO(),
+		},
+		boundary: q.Get("PermissionsBoundary"),
+		tags:     tags(q, "Key", "Value"),
+	}
+	ns.users[name] = u
+	return document{"User": u.document()}, nil
+}
+
+func (s *Server) iamGetUser(ns *iamNamespace, q url.Values) (any, *iamError) {
+	u, e := ns.user(q)
+	if e != nil {
+		return nil, e
+	}
+	return document{"User": u.document()}, nil
+}
+
+func (s *Server) iamListUsers(ns *iamNamespace, q url.Values) (any, *iamError) {
+	var keys []string
+	for name, u := range ns.users {
+		if strings.HasPrefix(u.info["Path"].(string), q.Get("PathPrefix")) {
+			keys = append(keys, name)
+		}
+	}
+	names, result := page(keys, q)
+	users := []document{}
+	for _, name := range names {
+		users = append(users, ns.users[name].info)
+	}
+	result["Users"] = users
+	return result, nil
+}
+
+// iamDeleteUser deletes a user. Like the array, the user must have no group, policy or access key left.
+func (s *Server) iamDeleteUser(ns *iamNamespace, q url.Values) (any, *iamError) {
+	u, e := ns.user(q)
+	if e != nil {
+		return nil, e
+	}
+	if len(u.groups) > 0 || len(u.attached) > 0 || len(u.inline) > 0 || len(u.accessKeys) > 0 {
+		return nil, deleteConflict("Cannot delete entity, must remove groups, policies and access keys first.")
+	}
+	delete(ns.users, q.Get("UserName"))
+	return nil, nil
+}
+
+func (s *Server) iamTagUser(ns *iamNamespace, q url.Values) (any, *iamError) {
+	u, e := ns.user(q)
+	if e != nil {
+		return nil, e
+	}
+	u.tags = mergeTags(u.tags, tags(q, "Key", "Value"), "Key", "Value")
+	return nil, nil
+}
+
+func (s *Server) iamUntagUser(ns *iamNamespace, q url.Values) (any, *iamError) {
+	u, e := ns.user(q)
+	if e != nil {
+		return nil, e
+	}
+	u.tags = untag(u.tags, "Key", members(q, "TagKeys", ""))
+	return nil, nil
+}
+
+func (s *Server) iamListUserTags(ns *iamNamespace, q url.Values) (any, *iamError) {
+	u, e := ns.user(q)
+	if e != nil {
+		return nil, e
+	}
+	t := u.tags
+	if t == nil {
+		t = []document{}
+	}
+	return document{"Tags": t, "IsTruncated": false}, nil
+}
+
+func (s *Server) iamPutUserPermissionsBoundary(ns *iamNamespace, q url.Values) (any, *iamError) {
+	u, e := ns.user(q)
+	if e != nil {
+		return nil, e
+	}
+	arn, e := required(q, "PermissionsBoundary")
+	if e != nil {
+		return nil, e
+	}
+	if e := s.boundary(ns, arn); e != nil {
+		return nil, e
+	}
+	u.boundary = arn
+	return nil, nil
+}
+
+func (s *Server) iamDeleteUserPermissionsBoundary(ns *iamNamespace, q url.Values) (any, *iamError) {
+	u, e := ns.user(q)
+	if e != nil {
+		return nil, e
+	}
+	u.boundary = ""
+	return nil, nil
+}
+
+// ---- groups
+
+func (ns *iamNamespace) group(q url.Values) (*iamGroup, *iamError) {
+	name, e := required(q, "GroupName")
+	if e != nil {
+		return nil, e
+	}
+	g, ok := ns.groups[name]
+	if !ok {
+		return nil, noSuchEntity("The group with name %s cannot be found.", name)
+	}
+	return g, nil
+}
+
+func (s *Server) iamCreateGroup(ns *iamNamespace, q url.Values) (any, *iamError) {
+	name, e := required(q, "GroupName")
+	if e != nil {
+		return nil, e
+	}
+	p, e := path(q)
+	if e != nil {
+		return nil, e
+	}
+	if _, ok := ns.groups[name]; ok {
+		return nil, entityAlreadyExists("Group with name %s already exists.", name)
+	}
+	g := &iamGroup{
+		iamPrincipal: iamPrincipal{inline: map[string]string{}},
+		info: document{
+			"GroupName":  name,
+			"GroupId":    "AGPA" + strings.ToUpper(randomHex(17)),
+			"Arn":        ns.arn("group", p, name),
+			"Path":       p,
+			"CreateDate": nowISO(),
+		},
+	}
+	ns.groups[name] = g
+	return document{"Group": g.info}, nil
+}
+
+// iamGetGroup returns a group with a page of its users.
+func (s *Server) iamGetGroup(ns *iamNamespace, q url.Values) (any, *iamError) {
+	g, e := ns.group(q)
+	if e != nil {
+		return nil, e
+	}
+	var keys []string
+	for name, u := range ns.users {
+		if slices.Contains(u.groups, q.Get("GroupName")) {
+			keys = append(keys, name)
+		}
+	}
+	names, result := page(keys, q)
+	users := []document{}
+	for _, name := range names {
+		users = append(users, ns.users[name].info)
+	}
+	result["Group"] = g.info
+	result["Users"] = users
+	return result, nil
+}
+
+func (s *Server) iamListGroups(ns *iamNamespace, q url.Values) (any, *iamError) {
+	var keys []string
+	for name, g := range ns.groups {
+		if strings.HasPrefix(g.info["Path"].(string), q.Get("PathPrefix")) {
+			keys = append(keys, name)
+		}
+	}
+	names, result := page(keys, q)
+	groups := []document{}
+	for _, name := range names {
+		groups = append(groups, ns.groups[name].info)
+	}
+	result["Groups"] = groups
+	return result, nil
+}
+
+// iamDeleteGroup deletes a group. Like the array, the group must have no user or policy left.
+func (s *Server) iamDeleteGroup(ns *iamNamespace, q url.Values) (any, *iamError) {
+	g, e := ns.group(q)
+	if e != nil {
+		return nil, e
+	}
+	for _, u := range ns.users {
+		if slices.Contains(u.groups, q.Get("GroupName")) {
+			return nil, deleteConflict("Cannot delete entity, must remove users from group first.")
+		}
+	}
+	if len(g.attached) > 0 || len(g.inline) > 0 {
+		return nil, deleteConflict("Cannot delete entity, must delete policies first.")
+	}
+	delete(ns.groups, q.Get("GroupName"))
+	return nil, nil
+}
+
+func (s *Server) iamAddUserToGroup(ns *iamNamespace, q url.Values) (any, *iamError) {
+	if _, e := ns.group(q); e != nil {
+		return nil, e
+	}
+	u, e := ns.user(q)
+	if e != nil {
+		return nil, e
+	}
+	if !slices.Contains(u.groups, q.Get("GroupName")) {
+		u.groups = append(u.groups, q.Get("GroupName"))
+	}
+	return nil, nil
+}
+
+func (s *Server) iamRemoveUserFromGroup(ns *iamNamespace, q url.Values) (any, *iamError) {
+	if _, e := ns.group(q); e != nil {
+		return nil, e
+	}
+	u, e := ns.user(q)
+	if e != nil {
+		return nil, e
+	}
+	if !slices.Contains(u.groups, q.Get("GroupName")) {
+		return nil, noSuchEntity("The user %s is not a member of the group %s.", q.Get("UserName"), q.Get("GroupName"))
+	}
+	u.groups = slices.DeleteFunc(u.groups, func(g string) bool { return g == q.Get("GroupName") })
+	return nil, nil
+}
+
+func (s *Server) iamListGroupsForUser(ns *iamNamespace, q url.Values) (any, *iamError) {
+	u, e := ns.user(q)
+	if e != nil {
+		return nil, e
+	}
+	names, result := page(slices.Clone(u.groups), q)
+	groups := []document{}
+	for _, name := range names {
+		groups = append(groups, ns.groups[name].info)
+	}
+	result["Groups"] = groups
+	return result, nil
+}
+
+// ---- roles
+
+func (ns *iamNamespace) role(q url.Values) (*iamRole, *iamError) {
+	name, e := required(q, "RoleName")
+	if e != nil {
+		return nil, e
+	}
+	r, ok := ns.roles[name]
+	if !ok {
+		return nil, noSuchEntity("The role with name %s cannot be found.", name)
+	}
+	return r, nil
+}
+
+// document returns the role as returned by GetRole and ListRoles.
+func (r *iamRole) document() document {
+	out := document{}
+	merge(out, r.info, nil)
+	if r.boundary != "" {
+		out["PermissionsBoundary"] = boundaryDocument(r.boundary)
+	}
+	if len(r.tags) > 0 {
+		out["Tags"] = r.tags
+	}
+	return out
+}
+
+// maxSessionDuration parses the MaxSessionDuration parameter, which must be between 1 and 12 hours.
+func maxSessionDuration(q url.Values) (int, *iamError) {
+	if !q.Has("MaxSessionDuration") {
+		return 3600, nil
+	}
+	d, err := strconv.Atoi(q.Get("MaxSessionDuration"))
+	if err != nil || d < 3600 || d > 43200 {
+		return 0, validationError("MaxSessionDuration must be between 3600 and 43200 seconds")
+	}
+	return d, nil
+}
+
+func (s *Server) iamCreateRole(ns *iamNamespace, q url.Values) (any, *iamError) {
+	name, e := required(q, "RoleName")
+	if e != nil {
+		return nil, e
+	}
+	doc, e := required(q, "AssumeRolePolicyDocument")
+	if e != nil {
+		return nil, e
+	}
+	if e := validPolicyDocument(doc); e != nil {
+		return nil, e
+	}
+	p, e := path(q)
+	if e != nil {
+		return nil, e
+	}
+	d, e := maxSessionDuration(q)
+	if e != nil {
+		return nil, e
+	}
+	if _, ok := ns.roles[name]; ok {
+		return nil, entityAlreadyExists("Role with name %s already exists.", name)
+	}
+	if e := s.boundary(ns, q.Get("PermissionsBoundary")); e != nil {
+		return nil, e
+	}
+	r := &iamRole{
+		iamPrincipal: iamPrincipal{inline: map[string]string{}},
+		info: document{
+			"RoleName":                 name,
+			"RoleId":                   "AROA" + strings.ToUpper(randomHex(17)),
+			"Arn":                      ns.arn("role", p, name),
+			"Path":                     p,
+			"CreateDate":               nowISO(),
+			"AssumeRolePolicyDocument": doc,
+			"Description":              q.Get("Description"),
+			"MaxSessionDuration":       d,
+		},
+		boundary: q.Get("PermissionsBoundary"),
+		tags:     tags(q, "key", "value"),
+	}
+	ns.roles[name] = r
+	return document{"Role": r.document()}, nil
+}
+
+func (s *Server) iamGetRole(ns *iamNamespace, q url.Values) (any, *iamError) {
+	r, e := ns.role(q)
+	if e != nil {
+		return nil, e
+	}
+	return document{"Role": r.document()}, nil
+}
+
+func (s *Server) iamListRoles(ns *iamNamespace, q url.Values) (any, *iamError) {
+	var keys []string
+	for name, r := range ns.roles {
+		if strings.HasPrefix(r.info["Path"].(string), q.Get("PathPrefix")) {
+			keys = append(keys, name)
+		}
+	}
+	names, result := page(keys, q)
+	roles := []document{}
+	for _, name := range names {
+		roles = append(roles, ns.roles[name].document())
+	}
+	result["Roles"] = roles
+	return result, nil
+}
+
+func (s *Server) iamUpdateRole(ns *iamNamespace, q url.Values) (any, *iamError) {
+	r, e := ns.role(q)
+	if e != nil {
+		return nil, e
+	}
+	if q.Has("MaxSessionDuration") {
+		d, e := maxSessionDuration(q)
+		if e != nil {
+			return nil, e
+		}
+		r.info["MaxSessionDuration"] = d
+	}
+	if q.Has("Description") {
+		r.info["Description"] = q.Get("Description")
+	}
+	return document{}, nil
+}
+
+func (s *Server) iamUpdateAssumeRolePolicy(ns *iamNamespace, q url.Values) (any, *iamError) {
+	r, e := ns.role(q)
+	if e != nil {
+		return nil, e
+	}
+	doc, e := required(q, "PolicyDocument")
+	if e != nil {
+		return nil, e
+	}
+	if e := validPolicyDocument(doc); e != nil {
+		return nil, e
+	}
+	r.info["AssumeRolePolicyDocument"] = doc
+	return nil, nil
+}
+
+// iamDeleteRole deletes a role. Like the array, the role must have no policy left.
+func (s *Server) iamDeleteRole(ns *iamNamespace, q url.Values) (any, *iamError) {
+	r, e := ns.role(q)
+	if e != nil {
+		return nil, e
+	}
+	if len(r.attached) > 0 || len(r.inline) > 0 {
+		return nil, deleteConflict("Cannot delete entity, must detach all policies first.")
+	}
+	delete(ns.roles, q.Get("RoleName"))
+	return nil, nil
+}
+
+func (s *Server) iamTagRole(ns *iamNamespace, q url.Values) (any, *iamError) {
+	r, e := ns.role(q)
+	if e != nil {
+		return nil, e
+	}
+	r.tags = mergeTags(r.tags, tags(q, "key", "value"), "key", "value")
+	return nil, nil
+}
+
+func (s *Server) iamUntagRole(ns *iamNamespace, q url.Values) (any, *iamError) {
+	r, e := ns.role(q)
+	if e != nil {
+		return nil, e
+	}
+	r.tags = untag(r.tags, "key", members(q, "TagKeys", ""))
+	return nil, nil
+}
+
+func (s *Server) iamListRoleTags(ns *iamNamespace, q url.Values) (any, *iamError) {
+	r, e := ns.role(q)
+	if e != nil {
+		return nil, e
+	}
+	t := r.tags
+	if t == nil {
+		t = []document{}
+	}
+	return document{"member": t}, nil
+}
+
+func (s *Server) iamPutRolePermissionsBoundary(ns *iamNamespace, q url.Values) (any, *iamError) {
+	r, e := ns.role(q)
+	if e != nil {
+		return nil, e
+	}
+	arn, e := required(q, "PermissionsBoundary")
+	if e != nil {
+		return nil, e
+	}
+	if e := s.boundary(ns, arn); e != nil {
+		return nil, e
+	}
+	r.boundary = arn
+	return nil, nil
+}
+
+func (s *Server) iamDeleteRolePermissionsBoundary(ns *iamNamespace, q url.Values) (any, *iamError) {
+	r, e := ns.role(q)
+	if e != nil {
+		return nil, e
+	}
+	r.boundary = ""
+	return nil, nil
+}
+
+// ---- inline and attached policies, shared by users, groups and roles
+
+func (ns *iamNamespace) userPrincipal(q url.Values) (*iamPrincipal, *iamError) {
+	u, e := ns.user(q)
+	if e != nil {
+		return nil, e
+	}
+	return &u.iamPrincipal, nil
+}
+
+func (ns *iamNamespace) groupPrincipal(q url.Values) (*iamPrincipal, *iamError) {
+	g, e := ns.group(q)
+	if e != nil {
+		return nil, e
+	}
+	return &g.iamPrincipal, nil
+}
+
+func (ns *iamNamespace) rolePrincipal(q url.Values) (*iamPrincipal, *iamError) {
+	r, e := ns.role(q)
+	if e != nil {
+		return nil, e
+	}
+	return &r.iamPrincipal, nil
+}
+
+// principalPolicyAction handles an action on the policies of a user, group or role.
+// The kind is the one used in the parameter names, like UserName.
+type principalPolicyAction func(s *Server, ns *iamNamespace, p *iamPrincipal, kind string, q url.Values) (any, *iamError)
+
+// principalAction adapts an action on the policies of a user, group or role to an IAM action.
+func principalAction(kind string, principal func(*iamNamespace, url.Values) (*iamPrincipal, *iamError), action principalPolicyAction) iamAction {
+	return func(s *Server, ns *iamNamespace, q url.Values) (any, *iamError) {
+		p, e := principal(ns, q)
+		if e != nil {
+			return nil, e
+		}
+		return action(s, ns, p, kind, q)
+	}
+}
+
+func putInlinePolicy(_ *Server, _ *iamNamespace, p *iamPrincipal, _ string, q url.Values) (any, *iamError) {
+	name, e := required(q, "PolicyName")
+	if e != nil {
+		return nil, e
+	}
+	doc, e := required(q, "PolicyDocument")
+	if e != nil {
+		return nil, e
+	}
+	if e := validPolicyDocument(doc); e != nil {
+		return nil, e
+	}
+	p.inline[name] = doc
+	return nil, nil
+}
+
+func getInlinePolicy(_ *Server, _ *iamNamespace, p *iamPrincipal, kind string, q url.Values) (any, *iamError) {
+	name, e := required(q, "PolicyName")
+	if e != nil {
+		return nil, e
+	}
+	doc, ok := p.inline[name]
+	if !ok {
+		return nil, noSuchEntity("The %s policy with name %s cannot be found.", strings.ToLower(kind), name)
+	}
+	return document{
+		kind + "Name":    q.Get(kind + "Name"),
+		"PolicyName":     name,
+		"PolicyDocument": doc,
+	}, nil
+}
+
+func deleteInlinePolicy(_ *Server, _ *iamNamespace, p *iamPrincipal, kind string, q url.Values) (any, *iamError) {
+	name, e := required(q, "PolicyName")
+	if e != nil {
+		return nil, e
+	}
+	if _, ok := p.inline[name]; !ok {
+		return nil, noSuchEntity("The %s policy with name %s cannot be found.", strings.ToLower(kind), name)
+	}
+	delete(p.inline, name)
+	return nil, nil
+}
+
+func listInlinePolicies(_ *Server, _ *iamNamespace, p *iamPrincipal, _ string, q url.Values) (any, *iamError) {
+	names, result := page(sortedKeys(p.inline), q)
+	result["PolicyNames"] = names
+	return result, nil
+}
+
+func attachPolicy(s *Server, ns *iamNamespace, p *iamPrincipal, _ string, q url.Values) (any, *iamError) {
+	arn, e := required(q, "PolicyArn")
+	if e != nil {
+		return nil, e
+	}
+	if _, e := s.policy(ns, arn); e != nil {
+		return nil, e
+	}
+	if !slices.Contains(p.attached, arn) {
+		p.attached = append(p.attached, arn)
+	}
+	return nil, nil
+}
+
+func detachPolicy(_ *Server, _ *iamNamespace, p *iamPrincipal, _ string, q url.Values) (any, *iamError) {
+	arn, e := required(q, "PolicyArn")
+	if e != nil {
+		return nil, e
+	}
+	if !slices.Contains(p.attached, arn) {
+		return nil, noSuchEntity("Policy %s was not found.", arn)
+	}
+	p.attached = slices.DeleteFunc(p.attached, func(a string) bool { return a == arn })
+	return nil, nil
+}
+
+func listAttachedPolicies(s *Server, ns *iamNamespace, p *iamPrincipal, _ string, q url.Values) (any, *iamError) {
+	arns, result := page(slices.Clone(p.attached), q)
+	attached := []document{}
+	for _, arn := range arns {
+		policy, e := s.policy(ns, arn)
+		if e != nil {
+			continue
+		}
+		attached = append(attached, document{"PolicyArn": arn, "PolicyName": policy.info["PolicyName"]})
+	}
+	result["AttachedPolicies"] = attached
+	return result, nil
+}
+
+// ---- managed policies
+
+func (s *Server) iamCreatePolicy(ns *iamNamespace, q url.Values) (any, *iamError) {
+	name, e := required(q, "PolicyName")
+	if e != nil {
+		return nil, e
+	}
+	doc, e := required(q, "PolicyDocument")
+	if e != nil {
+		return nil, e
+	}
+	if e := validPolicyDocument(doc); e != nil {
+		return nil, e
+	}
+	p, e := path(q)
+	if e != nil {
+		return nil, e
+	}
+	arn := ns.arn("policy", p, name)
+	if _, ok := ns.policies[arn]; ok {
+		return nil, entityAlreadyExists("A policy called %s already exists.", name)
+	}
+	now := nowISO()
+	policy := &iamPolicy{
+		info: document{
+			"PolicyName":       name,
+			"PolicyId":         "ANPA" + strings.ToUpper(randomHex(17)),
+			"Arn":              arn,
+			"Path":             p,
+			"Description":      q.Get("Description"),
+			"DefaultVersionId": "v1",
+			"IsAttachable":     true,
+			"CreateDate":       now,
+			"UpdateDate":       now,
+		},
+		versions: []document{{
+			"VersionId":        "v1",
+			"Document":         url.QueryEscape(doc),
+			"IsDefaultVersion": true,
+			"CreateDate":       now,
+		}},
+		nextVersion: 2,
+	}
+	ns.policies[arn] = policy
+	return document{"Policy": ns.policyInfo(policy)}, nil
+}
+
+func (s *Server) iamGetPolicy(ns *iamNamespace, q url.Values) (any, *iamError) {
+	arn, e := required(q, "PolicyArn")
+	if e != nil {
+		return nil, e
+	}
+	p, e := s.policy(ns, arn)
+	if e != nil {
+		return nil, e
+	}
+	return document{"Policy": ns.policyInfo(p)}, nil
+}
+
+// iamListPolicies lists the managed policies. The scope is All (the default), AWS for the global policies,
+// or Local for the policies of the namespace.
+func (s *Server) iamListPolicies(ns *iamNamespace, q url.Values) (any, *iamError) {
+	scope := q.Get("PolicyScope")
+	var arns []string
+	if scope == "" || scope == "All" || scope == "AWS" {
+		for name := range globalPolicies {
+			arns = append(arns, "urn:ecs:iam:::policy/"+name)
+		}
+	}
+	if scope == "" || scope == "All" || scope == "Local" {
+		arns = append(arns, sortedKeys(ns.policies)...)
+	}
+	var keys []string
+	for _, arn := range arns {
+		p, _ := s.policy(ns, arn)
+		info := ns.policyInfo(p)
+		if !strings.HasPrefix(info["Path"].(string), q.Get("PathPrefix")) {
+			continue
+		}
+		if q.Get("OnlyAttached") == "true" && info["AttachmentCount"] == 0 {
+			continue
+		}
+		keys = append(keys, arn)
+	}
+	arns, result := page(keys, q)
+	policies := []document{}
+	for _, arn := range arns {
+		p, _ := s.policy(ns, arn)
+		policies = append(policies, ns.policyInfo(p))
+	}
+	result["Policies"] = policies
+	return result, nil
+}
+
+// iamListEntitiesForPolicy lists the users, groups and roles a managed policy is attached to. The filter is
+// User, Group or Role; the other filters of the array are not supported.
+func (s *Server) iamListEntitiesForPolicy(ns *iamNamespace, q url.Values) (any, *iamError) {
+	arn, e := required(q, "PolicyArn")
+	if e != nil {
+		return nil, e
+	}
+	if _, e := s.policy(ns, arn); e != nil {
+		return nil, e
+	}
+	filter := q.Get("EntityFilter")
+	var keys []string
+	add := func(kind string, name string, p *iamPrincipal, info document) {
+		if (filter == "" || filter == kind) && slices.Contains(p.attached, arn) &&
+			strings.HasPrefix(info["Path"].(string), q.Get("PathPrefix")) {
+			keys = append(keys, kind+"/"+name)
+		}
+	}
+	for name, u := range ns.users {
+		add("User", name, &u.iamPrincipal, u.info)
+	}
+	for name, g := range ns.groups {
+		add("Group", name, &g.iamPrincipal, g.info)
+	}
+	for name, r := range ns.roles {
+		add("Role", name, &r.iamPrincipal, r.info)
+	}
+	keys, result := page(keys, q)
+	users, groups, roles := []document{}, []document{}, []document{}
+	for _, key := range keys {
+		kind, name, _ := strings.Cut(key, "/")
+		switch kind {
+		case "User":
+			users = append(users, document{"UserName": name, "UserId": ns.users[name].info["UserId"]})
+		case "Group":
+			groups = append(groups, document{"GroupName": name, "GroupId": ns.groups[name].info["GroupId"]})
+		case "Role":
+			roles = append(roles, document{"RoleName": name, "RoleId": ns.roles[name].info["RoleId"]})
+		}
+	}
+	result["PolicyUsers"] = users
+	result["PolicyGroups"] = groups
+	result["PolicyRoles"] = roles
+	return result, nil
+}
+
+// localPolicy returns a policy of the namespace. The global policies cannot be changed.
+func (ns *iamNamespace) localPolicy(q url.Values) (*iamPolicy, *iamError) {
+	arn, e := required(q, "PolicyArn")
+	if e != nil {
+		return nil, e
+	}
+	p, ok := ns.policies[arn]
+	if !ok {
+		return nil, noSuchEntity("Policy %s does not exist or is not attachable.", arn)
+	}
+	return p, nil
+}
+
+// iamDeletePolicy deletes a policy. Like the array, the policy must not be attached.
+func (s *Server) iamDeletePolicy(ns *iamNamespace, q url.Values) (any, *iamError) {
+	p, e := ns.localPolicy(q)
+	if e != nil {
+		return nil, e
+	}
+	info := ns.policyInfo(p)
+	if info["AttachmentCount"] != 0 || info["PermissionsBoundaryUsageCount"] != 0 {
+		return nil, deleteConflict("Cannot delete a policy attached to entities.")
+	}
+	delete(ns.policies, q.Get("PolicyArn"))
+	return nil, nil
+}
+
+func (s *Server) iamCreatePolicyVersion(ns *iamNamespace, q url.Values) (any, *iamError) {
+	p, e := ns.localPolicy(q)
+	if e != nil {
+		return nil, e
+	}
+	doc, e := required(q, "PolicyDocument")
+	if e != nil {
+		return nil, e
+	}
+	if e := validPolicyDocument(doc); e != nil {
+		return nil, e
+	}
+	if len(p.versions) >= maxPolicyVersions {
+		return nil, limitExceeded("A managed policy can have up to %d versions.", maxPolicyVersions)
+	}
+	version := document{
+		"VersionId":        fmt.Sprintf("v%d", p.nextVersion),
+		"Document":         url.QueryEscape(doc),
+		"IsDefaultVersion": false,
+		"CreateDate":       nowISO(),
+	}
+	p.nextVersion++
+	p.versions = append(p.versions, version)
+	if q.Get("SetAsDefault") == "true" {
+		p.setDefault(version["VersionId"].(string))
+	}
+	return document{"PolicyVersion": version}, nil
+}
+
+// setDefault makes a version the default version of the policy.
+func (p *iamPolicy) setDefault(id string) {
+	for _, v := range p.versions {
+		v["IsDefaultVersion"] = v["VersionId"] == id
+	}
+	p.info["DefaultVersionId"] = id
+	p.info["UpdateDate"] = nowISO()
+}
+
+// version returns a version of the policy.
+func (p *iamPolicy) version(q url.Values) (document, *iamError) {
+	id, e := required(q, "VersionId")
+	if e != nil {
+		return nil, e
+	}
+	for _, v := range p.versions {
+		if v["VersionId"] == id {
+			return v, nil
+		}
+	}
+	return nil, noSuchEntity("Policy %s version %s does not exist.", q.Get("PolicyArn"), id)
+}
+
+func (s *Server) iamGetPolicyVersion(ns *iamNamespace, q url.Values) (any, *iamError) {
+	arn, e := required(q, "PolicyArn")
+	if e != nil {
+		return nil, e
+	}
+	p, e := s.policy(ns, arn)
+	if e != nil {
+		return nil, e
+	}
+	v, e := p.version(q)
+	if e != nil {
+		return nil, e
+	}
+	return document{"PolicyVersion": v}, nil
+}
+
+func (s *Server) iamListPolicyVersions(ns *iamNamespace, q url.Values) (any, *iamError) {
+	arn, e := required(q, "PolicyArn")
+	if e != nil {
+		return nil, e
+	}
+	p, e := s.policy(ns, arn)
+	if e != nil {
+		return nil, e
+	}
+	return document{"Versions": p.versions}, nil
+}
+
+// iamDeletePolicyVersion deletes a version. Like the array, the default version cannot be deleted.
+func (s *Server) iamDeletePolicyVersion(ns *iamNamespace, q url.Values) (any, *iamError) {
+	p, e := ns.localPolicy(q)
+	if e != nil {
+		return nil, e
+	}
+	v, e := p.version(q)
+	if e != nil {
+		return nil, e
+	}
+	if v["IsDefaultVersion"] == true {
+		return nil, deleteConflict("Cannot delete the default version of a policy.")
+	}
+	p.versions = slices.DeleteFunc(p.versions, func(d document) bool { return d["VersionId"] == v["VersionId"] })
+	return nil, nil
+}
+
+func (s *Server) iamSetDefaultPolicyVersion(ns *iamNamespace, q url.Values) (any, *iamError) {
+	p, e := ns.localPolicy(q)
+	if e != nil {
+		return nil, e
+	}
+	v, e := p.version(q)
+	if e != nil {
+		return nil, e
+	}
+	p.setDefault(v["VersionId"].(string))
+	return nil, nil
+}
+
+// ---- access keys
+
+func (s *Server) iamCreateAccessKey(ns *iamNamespace, q url.Values) (any, *iamError) {
+	u, e := ns.user(q)
+	if e != nil {
+		return nil, e
+	}
+	if len(u.accessKeys) >= maxAccessKeys {
+		return nil, limitExceeded("Cannot exceed quota for AccessKeysPerUser: %d", maxAccessKeys)
+	}
+	key := document{
+		"AccessKeyId": "AKIA" + strings.ToUpper(randomHex(16)),
+		"Status":      "Active",
+		"UserName":    q.Get("UserName"),
+		"CreateDate":  nowISO(),
+	}
+	u.accessKeys = append(u.accessKeys, key)
+	out := document{"SecretAccessKey": randomString(simulatedKeyLength)}
+	merge(out, key, nil)
+	return document{"AccessKey": out}, nil
+}
+
+func (s *Server) iamListAccessKeys(ns *iamNamespace, q url.Values) (any, *iamError) {
+	u, e := ns.user(q)
+	if e != nil {
+		return nil, e
+	}
+	keys := u.accessKeys
+	if keys == nil {
+		keys = []document{}
+	}
+	return document{"AccessKeyMetadata": keys, "IsTruncated": false}, nil
+}
+
+// accessKey returns the index of an access key of the user.
+func (u *iamUser) accessKey(q url.Values) (int, *iamError) {
+	id, e := required(q, "AccessKeyId")
+	if e != nil {
+		return 0, e
+	}
+	i := slices.IndexFunc(u.accessKeys, func(k document) bool { return k["AccessKeyId"] == id })
+	if i < 0 {
+		return 0, noSuchEntity("The Access Key with id %s cannot be found.", id)
+	}
+	return i, nil
+}
+
+func (s *Server) iamUpdateAccessKey(ns *iamNamespace, q url.Values) (any, *iamError) {
+	u, e := ns.user(q)
+	if e != nil {
+		return nil, e
+	}
+	i, e := u.accessKey(q)
+	if e != nil {
+		return nil, e
+	}
+	status := q.Get("Status")
+	if status != "Active" && status != "Inactive" {
+		return nil, validationError("Status must be Active or Inactive")
+	}
+	u.accessKeys[i]["Status"] = status
+	return nil, nil
+}
+
+func (s *Server) iamDeleteAccessKey(ns *iamNamespace, q url.Values) (any, *iamError) {
+	u, e := ns.user(q)
+	if e != nil {
+		return nil, e
+	}
+	i, e := u.accessKey(q)
+	if e != nil {
+		return nil, e
+	}
+	u.accessKeys = slices.Delete(u.accessKeys, i, i+1)
+	return nil, nil
+}
+
+// ---- SAML providers
+
+func (ns *iamNamespace) samlProvider(q url.Values) (*samlProvider, *iamError) {
+	arn, e := required(q, "SAMLProviderArn")
+	if e != nil {
+		return nil, e
+	}
+	p, ok := ns.samlProviders[arn]
+	if !ok {
+		return nil, noSuchEntity("SAMLProvider %s does not exist.", arn)
+	}
+	return p, nil
+}
+
+func (s *Server) iamCreateSAMLProvider(ns *iamNamespace, q url.Values) (any, *iamError) {
+	name, e := required(q, "Name")
+	if e != nil {
+		return nil, e
+	}
+	metadata, e := required(q, "SAMLMetadataDocument")
+	if e != nil {
+		return nil, e
+	}
+	arn := ns.arn("saml-provider", "/", name)
+	if _, ok := ns.samlProviders[arn]; ok {
+		return nil, entityAlreadyExists("SAMLProvider %s already exists.", name)
+	}
+	ns.samlProviders[arn] = &samlProvider{
+		arn:        arn,
+		metadata:   metadata,
+		createDate: nowISO(),
+		validUntil: "2099-12-31T23:59:59Z",
+	}
+	return document{"SAMLProviderArn": arn}, nil
+}
+
+func (s *Server) iamGetSAMLProvider(ns *iamNamespace, q url.Values) (any, *iamError) {
+	p, e := ns.samlProvider(q)
+	if e != nil {
+		return nil, e
+	}
+	return document{"SAMLMetadataDocument": p.metadata, "CreateDate": p.createDate, "ValidUntil": p.validUntil}, nil
+}
+
+func (s *Server) iamListSAMLProviders(ns *iamNamespace, q url.Values) (any, *iamError) {
+	arns, result := page(sortedKeys(ns.samlProviders), q)
+	providers := []document{}
+	for _, arn := range arns {
+		p := ns.samlProviders[arn]
+		providers = append(providers, document{"Arn": p.arn, "CreateDate": p.createDate, "ValidUntil": p.validUntil})
+	}
+	result["SAMLProviderList"] = providers
+	return result, nil
+}
+
+func (s *Server) iamUpdateSAMLProvider(ns *iamNamespace, q url.Values) (any, *iamError) {
+	p, e := ns.samlProvider(q)
+	if e != nil {
+		return nil, e
+	}
+	metadata, e := required(q, "SAMLMetadataDocument")
+	if e != nil {
+		return nil, e
+	}
+	p.metadata = metadata
+	return document{"SAMLProviderArn": p.arn}, nil
+}
+
+func (s *Server) iamDeleteSAMLProvider(ns *iamNamespace, q url.Values) (any, *iamError) {
+	if _, e := ns.samlProvider(q); e != nil {
+		return nil, e
+	}
+	delete(ns.samlProviders, q.Get("SAMLProviderArn"))
+	return nil, nil
+}
+
+// ---- policy simulation
+
+// simulatedPolicy is a policy evaluated by the policy simulation, with the source reported in the matched statements.
+type simulatedPolicy struct {
+	id       string
+	kind     string // user, group, role, user-managed, aws-managed or none for the policies of the request
+	document string
+}
+
+// simulatedStatement is a statement of a simulated policy. The actions and resources are a string or a list of strings.
+type simulatedStatement struct {
+	Effect      string
+	Action      any
+	NotAction   any
+	Resource    any
+	NotResource any
+	Condition   map[string]map[string]any
+}
+
+// statements decodes the statements of a policy document, which may be URL-encoded like the policy versions.
+func (p simulatedPolicy) statements() []simulatedStatement {
+	doc := p.document
+	if !strings.HasPrefix(strings.TrimSpace(doc), "{") {
+		if decoded, err := url.QueryUnescape(doc); err == nil {
+			doc = decoded
+		}
+	}
+	var raw struct {
+		Statement json.RawMessage
+	}
+	if json.Unmarshal([]byte(doc), &raw) != nil {
+		return nil
+	}
+	var out []simulatedStatement
+	if json.Unmarshal(raw.Statement, &out) != nil {
+		var s simulatedStatement
+		if json.Unmarshal(raw.Statement, &s) != nil {
+			return nil
+		}
+		out = []simulatedStatement{s}
+	}
+	return out
+}
+
+// stringValues returns the values of a statement element, either a string or a list of strings.
+func stringValues(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var out []string
+		for _, x := range v {
+			if s, ok := x.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// wildcardMatch reports whether the value matches any of the patterns, where * matches any characters and ? any character.
+func wildcardMatch(patterns []string, value string, ignoreCase bool) bool {
+	for _, p := range patterns {
+		expr := strings.NewReplacer(`\*`, ".*", `\?`, ".").Replace(regexp.QuoteMeta(p))
+		if ignoreCase {
+			expr = "(?i)" + expr
+		}
+		if regexp.MustCompile("^" + expr + "$").MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// applies reports whether the statement applies to the action on the resource.
+func (st simulatedStatement) applies(action, resource string) bool {
+	if st.NotAction != nil {
+		if wildcardMatch(stringValues(st.NotAction), action, true) {
+			return false
+		}
+	} else if !wildcardMatch(stringValues(st.Action), action, true) {
+		return false
+	}
+	if st.NotResource != nil {
+		return !wildcardMatch(stringValues(st.NotResource), resource, false)
+	}
+	return st.Resource == nil || wildcardMatch(stringValues(st.Resource), resource, false)
+}
+
+// contextKeys returns the condition keys of the statement.
+func (st simulatedStatement) contextKeys() []string {
+	var keys []string
+	for _, values := range st.Condition {
+		for key := range values {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// evaluate returns the decision of the policies on the action and resource, with the statements it is based on
+// and the condition keys missing from the context. The statements with a condition on a missing key do not apply,
+// and the other conditions are considered met.
+func evaluate(policies []simulatedPolicy, action, resource string, context []string) (string, []document, []string) {
+	var allows, denies []document
+	var missing []string
+	for _, p := range policies {
+		for _, st := range p.statements() {
+			if !st.applies(action, resource) {
+				continue
+			}
+			absent := slices.DeleteFunc(st.contextKeys(), func(k string) bool { return slices.Contains(context, k) })
+			if len(absent) > 0 {
+				missing = append(missing, absent...)
+				continue
+			}
+			source := document{"SourcePolicyId": p.id, "SourcePolicyType": p.kind}
+			if strings.EqualFold(st.Effect, "Deny") {
+				denies = append(denies, source)
+			} else {
+				allows = append(allows, source)
+			}
+		}
+	}
+	slices.Sort(missing)
+	missing = slices.Compact(missing)
+	switch {
+	case len(denies) > 0:
+		return "explicitDeny", denies, missing
+	case len(allows) > 0:
+		return "allowed", allows, missing
+	}
+	return "implicitDeny", []document{}, missing
+}
+
+// inputPolicies returns the policies given in a list parameter, like PolicyInputList.member.1.
+func inputPolicies(q url.Values, param string) ([]simulatedPolicy, *iamError) {
+	var out []simulatedPolicy
+	for i, doc := range members(q, param, "") {
+		if e := validPolicyDocument(doc); e != nil {
+			return nil, e
+		}
+		out = append(out, simulatedPolicy{id: fmt.Sprintf("%s.%d", param, i+1), kind: "none", document: doc})
+	}
+	return out, nil
+}
+
+// principalPolicies returns the inline and attached policies of a principal.
+func (s *Server) principalPolicies(ns *iamNamespace, p *iamPrincipal, kind string) []simulatedPolicy {
+	var out []simulatedPolicy
+	for _, name := range sortedKeys(p.inline) {
+		out = append(out, simulatedPolicy{id: name, kind: kind, document: p.inline[name]})
+	}
+	for _, arn := range p.attached {
+		if policy := s.managedPolicy(ns, arn); policy != nil {
+			out = append(out, *policy)
+		}
+	}
+	return out
+}
+
+// managedPolicy returns the default version of a managed policy, or nil when it does not exist.
+func (s *Server) managedPolicy(ns *iamNamespace, arn string) *simulatedPolicy {
+	p, e := s.policy(ns, arn)
+	if e != nil {
+		return nil
+	}
+	kind := "user-managed"
+	if strings.HasPrefix(arn, "urn:ecs:iam:::policy/") {
+		kind = "aws-managed"
+	}
+	for _, v := range p.versions {
+		if v["IsDefaultVersion"] == true {
+			name, _ := p.info["PolicyName"].(string)
+			doc, _ := v["Document"].(string)
+			return &simulatedPolicy{id: name, kind: kind, document: doc}
+		}
+	}
+	return nil
+}
+
+// sourcePolicies returns the policies and the permissions boundary of the user, group or role with the given ARN.
+// The policies of a user include the ones of its groups.
+func (s *Server) sourcePolicies(ns *iamNamespace, arn string) ([]simulatedPolicy, []simulatedPolicy, *iamError) {
+	boundary := func(arn string) []simulatedPolicy {
+		if policy := s.managedPolicy(ns, arn); policy != nil {
+			return []simulatedPolicy{*policy}
+		}
+		return nil
+	}
+	for _, u := range ns.users {
+		if u.info["Arn"] == arn {
+			policies := s.principalPolicies(ns, &u.iamPrincipal, "user")
+			for _, name := range u.groups {
+				if g, ok := ns.groups[name]; ok {
+					policies = append(policies, s.principalPolicies(ns, &g.iamPrincipal, "group")...)
+				}
+			}
+			return policies, boundary(u.boundary), nil
+		}
+	}
+	for _, g := range ns.groups {
+		if g.info["Arn"] == arn {
+			return s.principalPolicies(ns, &g.iamPrincipal, "group"), nil, nil
+		}
+	}
+	for _, r := range ns.roles {
+		if r.info["Arn"] == arn {
+			return s.principalPolicies(ns, &r.iamPrincipal, "role"), boundary(r.boundary), nil
+		}
+	}
+	return nil, nil, noSuchEntity("The entity %s cannot be found.", arn)
+}
+
+// simulate evaluates the actions of the request on its resources, which default to all resources.
+// An action allowed by the policies is denied when the permissions boundary does not allow it.
+func simulate(policies, boundaries []simulatedPolicy, q url.Values) (any, *iamError) {
+	actions := members(q, "ActionNames", "")
+	if len(actions) == 0 {
+		return nil, validationError("ActionNames is required")
+	}
+	resources := members(q, "ResourceArns", "")
+	if len(resources) == 0 {
+		resources = []string{"*"}
+	}
+	context := members(q, "ContextEntries", ".ContextKeyName")
+
+	var keys []string
+	results := map[string]document{}
+	for _, action := range actions {
+		for _, resource := range resources {
+			decision, matched, missing := evaluate(policies, action, resource, context)
+			if decision == "allowed" && len(boundaries) > 0 {
+				if boundaryDecision, boundaryMatched, _ := evaluate(boundaries, action, resource, context); boundaryDecision != "allowed" {
+					decision, matched = boundaryDecision, boundaryMatched
+				}
+			}
+			key := fmt.Sprintf("%06d", len(keys))
+			keys = append(keys, key)
+			results[key] = document{
+				"EvalActionName":       action,
+				"EvalResourceName":     resource,
+				"EvalDecision":         decision,
+				"MatchedStatements":    matched,
+				"MissingContextValues": missing,
+			}
+		}
+	}
+	keys, result := page(keys, q)
+	evaluations := []document{}
+	for _, k := range keys {
+		evaluations = append(evaluations, results[k])
+	}
+	result["EvaluationResults"] = evaluations
+	return result, nil
+}
+
+func (s *Server) iamSimulatePrincipalPolicy(ns *iamNamespace, q url.Values) (any, *iamError) {
+	arn, e := required(q, "PolicySourceArn")
+	if e != nil {
+		return nil, e
+	}
+	policies, boundaries, e := s.sourcePolicies(ns, arn)
+	if e != nil {
+		return nil, e
+	}
+	input, e := inputPolicies(q, "PolicyInputList")
+	if e != nil {
+		return nil, e
+	}
+	inputBoundaries, e := inputPolicies(q, "PermissionsBoundaryPolicyInputList")
+	if e != nil {
+		return nil, e
+	}
+	if len(inputBoundaries) > 0 {
+		boundaries = inputBoundaries
+	}
+	return simulate(append(policies, input...), boundaries, q)
+}
+
+func (s *Server) iamSimulateCustomPolicy(_ *iamNamespace, q url.Values) (any, *iamError) {
+	policies, e := inputPolicies(q, "PolicyInputList")
+	if e != nil {
+		return nil, e
+	}
+	if len(policies) == 0 {
+		return nil, validationError("PolicyInputList is required")
+	}
+	boundaries, e := inputPolicies(q, "PermissionsBoundaryPolicyInputList")
+	if e != nil {
+		return nil, e
+	}
+	return simulate(policies, boundaries, q)
+}
+
+// contextKeyNames returns the condition keys of the policies, sorted.
+func contextKeyNames(policies []simulatedPolicy) document {
+	keys := []string{}
+	for _, p := range policies {
+		for _, st := range p.statements() {
+			keys = append(keys, st.contextKeys()...)
+		}
+	}
+	slices.Sort(keys)
+	return document{"ContextKeyNames": slices.Compact(keys)}
+}
+
+func (s *Server) iamGetContextKeysForPrincipalPolicy(ns *iamNamespace, q url.Values) (any, *iamError) {
+	arn, e := required(q, "PolicySourceArn")
+	if e != nil {
+		return nil, e
+	}
+	policies, _, e := s.sourcePolicies(ns, arn)
+	if e != nil {
+		return nil, e
+	}
+	input, e := inputPolicies(q, "PolicyInputList")
+	if e != nil {
+		return nil, e
+	}
+	return contextKeyNames(append(policies, input...)), nil
+}
+
+func (s *Server) iamGetContextKeysForCustomPolicy(_ *iamNamespace, q url.Values) (any, *iamError) {
+	policies, e := inputPolicies(q, "PolicyInputList")
+	if e != nil {
+		return nil, e
+	}
+	if len(policies) == 0 {
+		return nil, validationError("PolicyInputList is required")
+	}
+	return contextKeyNames(policies), nil
+}
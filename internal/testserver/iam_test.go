@@ -0,0 +1,298 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testserver
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"terraform-provider-objectscale/internal/clientgen"
+	"testing"
+)
+
+func TestIAMUser(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	created, _, err := c.GenClient.IamApi.IamServiceCreateUser(ctx).UserName("u1").XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if arn := value(created.CreateUserResult.User.Arn); arn != "urn:ecs:iam::ns1:user/u1" {
+		t.Errorf("unexpected ARN %s", arn)
+	}
+	_, resp, err := c.GenClient.IamApi.IamServiceCreateUser(ctx).UserName("u1").XEmcNamespace("ns1").Execute()
+	if err == nil || statusCode(resp) != http.StatusConflict {
+		t.Errorf("expected 409 on duplicate user, got %d: %v", statusCode(resp), err)
+	}
+
+	// a user with an access key cannot be deleted
+	key, _, err := c.GenClient.IamApi.IamServiceCreateAccessKey(ctx).UserName("u1").XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, resp, err = c.GenClient.IamApi.IamServiceDeleteUser(ctx).UserName("u1").XEmcNamespace("ns1").Execute()
+	if err == nil || statusCode(resp) != http.StatusConflict {
+		t.Errorf("expected 409 on delete of a user with access keys, got %d: %v", statusCode(resp), err)
+	}
+	_, _, err = c.GenClient.IamApi.IamServiceDeleteAccessKey(ctx).UserName("u1").
+		AccessKeyId(value(key.CreateAccessKeyResult.AccessKey.AccessKeyId)).XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := c.GenClient.IamApi.IamServiceDeleteUser(ctx).UserName("u1").XEmcNamespace("ns1").Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, resp, err = c.GenClient.IamApi.IamServiceGetUser(ctx).UserName("u1").XEmcNamespace("ns1").Execute()
+	if err == nil || statusCode(resp) != http.StatusNotFound {
+		t.Errorf("expected 404 after delete, got %d: %v", statusCode(resp), err)
+	}
+}
+
+func TestIAMAccessKeyLimit(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	for range maxAccessKeys {
+		if _, _, err := c.GenClient.IamApi.IamServiceCreateAccessKey(ctx).UserName("user_001").XEmcNamespace("ns1").Execute(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	_, resp, err := c.GenClient.IamApi.IamServiceCreateAccessKey(ctx).UserName("user_001").XEmcNamespace("ns1").Execute()
+	if err == nil || statusCode(resp) != http.StatusConflict {
+		t.Errorf("expected 409 on a third access key, got %d: %v", statusCode(resp), err)
+	}
+}
+
+func TestIAMPolicyVersions(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+	doc := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`
+
+	created, _, err := c.GenClient.IamApi.IamServiceCreatePolicy(ctx).PolicyName("p1").PolicyDocument(doc).XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	arn := value(created.CreatePolicyResult.Policy.Arn)
+
+	_, _, err = c.GenClient.IamApi.IamServiceCreatePolicyVersion(ctx).PolicyArn(arn).PolicyDocument(doc).SetAsDefault(true).XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	policy, _, err := c.GenClient.IamApi.IamServiceGetPolicy(ctx).PolicyArn(arn).XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v := value(policy.GetPolicyResult.Policy.DefaultVersionId); v != "v2" {
+		t.Errorf("expected default version v2, got %s", v)
+	}
+
+	// the policy versions are URL-encoded, like on the array
+	version, _, err := c.GenClient.IamApi.IamServiceGetPolicyVersion(ctx).PolicyArn(arn).VersionId("v2").XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, _ := url.QueryUnescape(value(version.GetPolicyVersionResult.PolicyVersion.Document)); got != doc {
+		t.Errorf("unexpected document %s", got)
+	}
+
+	_, resp, err := c.GenClient.IamApi.IamServiceDeletePolicyVersion(ctx).PolicyArn(arn).VersionId("v2").XEmcNamespace("ns1").Execute()
+	if err == nil || statusCode(resp) != http.StatusConflict {
+		t.Errorf("expected 409 on delete of the default version, got %d: %v", statusCode(resp), err)
+	}
+
+	// an attached policy cannot be deleted
+	if _, _, err := c.GenClient.IamApi.IamServiceAttachUserPolicy(ctx).UserName("user_001").PolicyArn(arn).XEmcNamespace("ns1").Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, resp, err = c.GenClient.IamApi.IamServiceDeletePolicy(ctx).PolicyArn(arn).XEmcNamespace("ns1").Execute()
+	if err == nil || statusCode(resp) != http.StatusConflict {
+		t.Errorf("expected 409 on delete of an attached policy, got %d: %v", statusCode(resp), err)
+	}
+}
+
+func TestIAMListEntitiesForPolicy(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+	doc := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`
+
+	created, _, err := c.GenClient.IamApi.IamServiceCreatePolicy(ctx).PolicyName("p1").PolicyDocument(doc).XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	arn := value(created.CreatePolicyResult.Policy.Arn)
+	for _, user := range []string{"user_001", "sample_user_1"} {
+		if _, _, err := c.GenClient.IamApi.IamServiceAttachUserPolicy(ctx).UserName(user).PolicyArn(arn).XEmcNamespace("ns1").Execute(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, _, err := c.GenClient.IamApi.IamServiceAttachGroupPolicy(ctx).GroupName("group_008").PolicyArn(arn).XEmcNamespace("ns1").Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := c.GenClient.IamApi.IamServiceAttachRolePolicy(ctx).RoleName("roleTest1").PolicyArn(arn).XEmcNamespace("ns1").Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the groups come first, then the roles and the users
+	listed, _, err := c.GenClient.IamApi.IamServiceListEntitiesForPolicy(ctx).PolicyArn(arn).MaxItems(2).XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := listed.ListEntitiesForPolicyResult
+	if !value(result.IsTruncated) || len(result.PolicyGroups) != 1 || len(result.PolicyRoles) != 1 || len(result.PolicyUsers) != 0 {
+		t.Fatalf("unexpected first page %+v", result)
+	}
+	listed, _, err = c.GenClient.IamApi.IamServiceListEntitiesForPolicy(ctx).PolicyArn(arn).Marker(value(result.Marker)).XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if users := listed.ListEntitiesForPolicyResult.PolicyUsers; len(users) != 2 || value(users[1].UserName) != "user_001" || value(users[1].UserId) == "" {
+		t.Errorf("unexpected users %+v", users)
+	}
+
+	listed, _, err = c.GenClient.IamApi.IamServiceListEntitiesForPolicy(ctx).PolicyArn(arn).EntityFilter("Role").XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result := listed.ListEntitiesForPolicyResult; len(result.PolicyRoles) != 1 || len(result.PolicyUsers) != 0 || len(result.PolicyGroups) != 0 {
+		t.Errorf("expected only the role, got %+v", result)
+	}
+
+	_, resp, err := c.GenClient.IamApi.IamServiceListEntitiesForPolicy(ctx).PolicyArn("urn:ecs:iam::ns1:policy/missing").XEmcNamespace("ns1").Execute()
+	if err == nil || statusCode(resp) != http.StatusNotFound {
+		t.Errorf("expected 404 on an unknown policy, got %d: %v", statusCode(resp), err)
+	}
+}
+
+func TestIAMErrorBody(t *testing.T) {
+	s, c := newTestClient(t)
+
+	req, _ := http.NewRequest(http.MethodPost, s.URL+"/iam?Action=GetRole&RoleName=missing", nil)
+	for k, v := range c.GenClient.GetConfig().DefaultHeader {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("x-emc-namespace", "ns1")
+	resp, err := c.GenClient.GetConfig().HTTPClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusNotFound || !strings.Contains(string(body), "<Code>NoSuchEntity</Code>") {
+		t.Errorf("expected a NoSuchEntity error, got %d: %s", resp.StatusCode, body)
+	}
+}
+
+func TestIAMFixtures(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	group, _, err := c.GenClient.IamApi.IamServiceGetGroup(ctx).GroupName("group_008").XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := len(group.GetGroupResult.Users); n != 2 {
+		t.Errorf("expected 2 users in group_008, got %d", n)
+	}
+	policies, _, err := c.GenClient.IamApi.IamServiceListRolePolicies(ctx).RoleName("roleTest1").XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := len(policies.ListRolePoliciesResult.PolicyNames); n != 1 {
+		t.Errorf("expected 1 inline policy on roleTest1, got %d", n)
+	}
+}
+
+func TestIAMPolicySimulation(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+	actions := []string{"s3:ListBucket", "s3:PutObject"}
+
+	// user_001 gets s3:ListBucket from the inline policy of group_008
+	principal, _, err := c.GenClient.IamApi.IamServiceSimulatePrincipalPolicy(ctx).PolicySourceArn("urn:ecs:iam::ns1:user/user_001").
+		ActionNamesMemberN(actions).XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	results := principal.SimulatePrincipalPolicyResult.EvaluationResults
+	if len(results) != 2 || value(results[0].EvalDecision) != "allowed" || value(results[1].EvalDecision) != "implicitDeny" {
+		t.Fatalf("unexpected results %+v", results)
+	}
+	if source := results[0].MatchedStatements; len(source) != 1 || value(source[0].SourcePolicyType) != "group" {
+		t.Errorf("unexpected matched statements %+v", source)
+	}
+
+	// an explicit deny of an additional policy overrides the allow
+	deny := `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:List*","Resource":"*"}]}`
+	principal, _, err = c.GenClient.IamApi.IamServiceSimulatePrincipalPolicy(ctx).PolicySourceArn("urn:ecs:iam::ns1:user/user_001").
+		ActionNamesMemberN(actions[:1]).PolicyInputListMemberN([]string{deny}).XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decision := value(principal.SimulatePrincipalPolicyResult.EvaluationResults[0].EvalDecision); decision != "explicitDeny" {
+		t.Errorf("expected an explicit deny, got %s", decision)
+	}
+
+	// the statements with a condition apply only when the condition key is in the context
+	conditional := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::bucket1/*",` +
+		`"Condition":{"IpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}]}`
+	custom, _, err := c.GenClient.IamApi.IamServiceSimulateCustomPolicy(ctx).PolicyInputListMemberN([]string{conditional}).
+		ActionNamesMemberN(actions).ResourceArnsMemberN([]string{"arn:aws:s3:::bucket1/key"}).MaxItems(1).XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := custom.SimulateCustomerPolicyResult
+	if !value(result.IsTruncated) || len(result.EvaluationResults) != 1 {
+		t.Fatalf("expected a truncated page of 1 result, got %+v", result)
+	}
+	if missing := result.EvaluationResults[0].MissingContextValues; len(missing) != 1 || missing[0] != "aws:SourceIp" {
+		t.Errorf("expected the missing aws:SourceIp key, got %v", missing)
+	}
+	sourceIP := "aws:SourceIp"
+	ipType := "ip"
+	custom, _, err = c.GenClient.IamApi.IamServiceSimulateCustomPolicy(ctx).PolicyInputListMemberN([]string{conditional}).
+		ActionNamesMemberN(actions).ResourceArnsMemberN([]string{"arn:aws:s3:::bucket1/key"}).Marker(value(result.Marker)).
+		ContextEntriesMemberN([]clientgen.IamContextEntry{{ContextKeyName: &sourceIP, ContextKeyType: &ipType, ContextKeyValues: []string{"10.0.0.1"}}}).
+		XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if results := custom.SimulateCustomerPolicyResult.EvaluationResults; len(results) != 1 ||
+		value(results[0].EvalActionName) != "s3:PutObject" || value(results[0].EvalDecision) != "allowed" {
+		t.Errorf("unexpected results %+v", results)
+	}
+
+	keys, _, err := c.GenClient.IamApi.IamServiceGetContextKeysForCustomPolicy(ctx).PolicyInputListMemberN([]string{conditional}).
+		XEmcNamespace("ns1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if names := keys.GetContextKeysForCustomPolicyResult.ContextKeyNames; len(names) != 1 || names[0] != "aws:SourceIp" {
+		t.Errorf("unexpected context keys %v", names)
+	}
+
+	_, resp, err := c.GenClient.IamApi.IamServiceSimulatePrincipalPolicy(ctx).PolicySourceArn("urn:ecs:iam::ns1:user/missing").
+		ActionNamesMemberN(actions).XEmcNamespace("ns1").Execute()
+	if err == nil || statusCode(resp) != http.StatusNotFound {
+		t.Errorf("expected 404 on an unknown principal, got %d: %v", statusCode(resp), err)
+	}
+}
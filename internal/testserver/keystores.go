@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"time"
+)
+
+// the keystores of the management API, keyed by path.
+const (
+	vdcKeystore        = "/vdc/keystore"
+	objectCertKeystore = "/object-cert/keystore"
+)
+
+func (s *Server) registerKeystores(mux *http.ServeMux) {
+	for _, path := range []string{vdcKeystore, objectCertKeystore} {
+		mux.HandleFunc("GET "+path, s.getKeystore)
+		mux.HandleFunc("PUT "+path, s.putKeystore)
+	}
+}
+
+func (s *Server) getKeystore(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, document{"chain": s.keystores[r.URL.Path]})
+}
+
+// putKeystore replaces the certificate of a keystore, either with the given key and certificate chain
+// or with a new self-signed certificate.
+func (s *Server) putKeystore(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		KeyAndCertificate *struct {
+			PrivateKey       string `json:"private_key"`
+			CertificateChain string `json:"certificate_chain"`
+		} `json:"key_and_certificate"`
+		SystemSelfsigned bool     `json:"system_selfsigned"`
+		IPAddresses      []string `json:"ip_addresses"`
+	}
+	if !readBody(w, r, &body) {
+		return
+	}
+	if body.SystemSelfsigned {
+		chain, err := selfSignedChain(body.IPAddresses)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, codeInvalidParam, "Invalid IP addresses", err.Error())
+			return
+		}
+		s.keystores[r.URL.Path] = chain
+		writeJSON(w, http.StatusOK, document{"chain": chain})
+		return
+	}
+	kc := body.KeyAndCertificate
+	if kc == nil || kc.PrivateKey == "" || kc.CertificateChain == "" {
+		writeMissingParam(w, "key_and_certificate.private_key and key_and_certificate.certificate_chain")
+		return
+	}
+	if err := validateKeyAndChain(kc.PrivateKey, kc.CertificateChain); err != nil {
+		writeError(w, http.StatusBadRequest, codeInvalidParam, "Invalid certificate or key", err.Error())
+		return
+	}
+	if s.keystores[r.URL.Path] == kc.CertificateChain {
+		writeError(w, http.StatusBadRequest, codeAlreadyExists, "Certificate already deployed",
+			"the certificate chain is already active")
+		return
+	}
+	s.keystores[r.URL.Path] = kc.CertificateChain
+	writeJSON(w, http.StatusOK, document{})
+}
+
+// validateKeyAndChain checks that the private key is a PKCS#1 or PKCS#8 key and the chain holds certificates.
+func validateKeyAndChain(key, chain string) error {
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return errors.New("the private key is not valid PEM")
+	}
+	if _, err := x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+		if _, err := x509.ParsePKCS8PrivateKey(block.Bytes); err != nil {
+			return err
+		}
+	}
+	rest, certs := []byte(chain), 0
+	for {
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return err
+		}
+		certs++
+	}
+	if certs == 0 {
+		return errors.New("the certificate chain holds no PEM certificate")
+	}
+	return nil
+}
+
+// selfSignedChain generates a self-signed certificate for the given IP addresses.
+func selfSignedChain(ipAddresses []string) (string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", err
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "objectscale"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(1, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	for _, ip := range ipAddresses {
+		parsed := net.ParseIP(ip)
+		if parsed == nil {
+			return "", fmt.Errorf("%s is not a valid IP address", ip)
+		}
+		template.IPAddresses = append(template.IPAddresses, parsed)
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), nil
+}
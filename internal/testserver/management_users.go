@@ -0,0 +1,129 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testserver
+
+import (
+	"net/http"
+	"time"
+)
+
+// managementUserRoles are the role flags of a management user, which can be set on create and update.
+var managementUserRoles = []string{"isSystemAdmin", "isSystemMonitor", "isSecurityAdmin"}
+
+func (s *Server) registerManagementUsers(mux *http.ServeMux) {
+	mux.HandleFunc("GET /vdc/users", s.listManagementUsers)
+	mux.HandleFunc("POST /vdc/users", s.createManagementUser)
+	mux.HandleFunc("GET /vdc/users/{userid}", s.getManagementUser)
+	mux.HandleFunc("PUT /vdc/users/{userid}", s.updateManagementUser)
+	mux.HandleFunc("POST /vdc/users/{userid}/deactivate", s.deleteManagementUser)
+}
+
+// addManagementUser stores a management user with the roles of the request.
+// The password is not stored, the management users cannot log into the simulator.
+func (s *Server) addManagementUser(userID string, req document) document {
+	user := document{
+		"userId":            userID,
+		"isSystemAdmin":     false,
+		"isSystemMonitor":   false,
+		"isSecurityAdmin":   false,
+		"is_external_group": false,
+		"is_locked":         false,
+	}
+	setManagementUserRoles(user, req)
+	if external, ok := req["is_external_group"].(bool); ok {
+		user["is_external_group"] = external
+	}
+	if _, ok := req["password"]; ok {
+		user["last_time_password_changed"] = time.Now().UTC().Format(time.RFC3339)
+	}
+	s.managementUsers[userID] = user
+	return user
+}
+
+// setManagementUserRoles copies the role flags of the request into the management user.
+func setManagementUserRoles(user, req document) {
+	for _, role := range managementUserRoles {
+		if v, ok := req[role].(bool); ok {
+			user[role] = v
+		}
+	}
+}
+
+// managementUser returns the management user of the request path, writing a 404 when it does not exist.
+func (s *Server) managementUser(w http.ResponseWriter, r *http.Request) (document, bool) {
+	userID := r.PathValue("userid")
+	user, ok := s.managementUsers[userID]
+	if !ok {
+		writeNotFound(w, "management user", userID)
+	}
+	return user, ok
+}
+
+func (s *Server) listManagementUsers(w http.ResponseWriter, r *http.Request) {
+	items := []document{}
+	for _, userID := range sortedKeys(s.managementUsers) {
+		items = append(items, s.managementUsers[userID])
+	}
+	writeJSON(w, http.StatusOK, document{"mgmt_user_info": items})
+}
+
+func (s *Server) createManagementUser(w http.ResponseWriter, r *http.Request) {
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	userID, _ := req["userId"].(string)
+	if userID == "" {
+		writeMissingParam(w, "userId")
+		return
+	}
+	if _, ok := s.managementUsers[userID]; ok {
+		writeAlreadyExists(w, "management user", userID)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.addManagementUser(userID, req))
+}
+
+func (s *Server) getManagementUser(w http.ResponseWriter, r *http.Request) {
+	if user, ok := s.managementUser(w, r); ok {
+		writeJSON(w, http.StatusOK, user)
+	}
+}
+
+func (s *Server) updateManagementUser(w http.ResponseWriter, r *http.Request) {
+	user, ok := s.managementUser(w, r)
+	if !ok {
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	setManagementUserRoles(user, req)
+	if _, ok := req["password"]; ok {
+		user["last_time_password_changed"] = time.Now().UTC().Format(time.RFC3339)
+	}
+	writeJSON(w, http.StatusOK, document{})
+}
+
+func (s *Server) deleteManagementUser(w http.ResponseWriter, r *http.Request) {
+	if user, ok := s.managementUser(w, r); ok {
+		delete(s.managementUsers, user["userId"].(string))
+		writeJSON(w, http.StatusOK, document{})
+	}
+}
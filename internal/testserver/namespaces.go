@@ -0,0 +1,310 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testserver
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+func (s *Server) registerNamespaces(mux *http.ServeMux) {
+	mux.HandleFunc("GET /object/namespaces", s.listNamespaces)
+	mux.HandleFunc("POST /object/namespaces/namespace", s.createNamespace)
+	mux.HandleFunc("GET /object/namespaces/namespace/{namespace}", s.getNamespace)
+	mux.HandleFunc("PUT /object/namespaces/namespace/{namespace}", s.updateNamespace)
+	mux.HandleFunc("POST /object/namespaces/namespace/{namespace}/deactivate", s.deactivateNamespace)
+	mux.HandleFunc("GET /object/namespaces/namespace/{namespace}/retention", s.listRetentionClasses)
+	mux.HandleFunc("POST /object/namespaces/namespace/{namespace}/retention", s.createRetentionClass)
+	mux.HandleFunc("GET /object/namespaces/namespace/{namespace}/retention/{class}", s.getRetentionClass)
+	mux.HandleFunc("PUT /object/namespaces/namespace/{namespace}/retention/{class}", s.updateRetentionClass)
+	mux.HandleFunc("GET /object/namespaces/namespace/{namespace}/quota", s.getNamespaceQuota)
+	mux.HandleFunc("PUT /object/namespaces/namespace/{namespace}/quota", s.updateNamespaceQuota)
+	mux.HandleFunc("DELETE /object/namespaces/namespace/{namespace}/quota", s.removeNamespaceQuota)
+}
+
+// namespace returns the namespace of the request path, writing a 404 when it does not exist.
+func (s *Server) namespace(w http.ResponseWriter, r *http.Request) (document, bool) {
+	name := r.PathValue("namespace")
+	ns, ok := s.namespaces[name]
+	if !ok {
+		writeNotFound(w, "namespace", name)
+	}
+	return ns, ok
+}
+
+func (s *Server) listNamespaces(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	var keys []string
+	for name := range s.namespaces {
+		if strings.HasPrefix(name, q.Get("name")) {
+			keys = append(keys, name)
+		}
+	}
+	page, next := paginate(keys, q.Get("marker"), q.Get("limit"))
+	items := make([]document, 0, len(page))
+	for _, name := range page {
+		items = append(items, s.namespaces[name])
+	}
+	resp := document{"namespace": items}
+	if next != "" {
+		resp["NextMarker"] = next
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) createNamespace(w http.ResponseWriter, r *http.Request) {
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	name, _ := req["namespace"].(string)
+	if name == "" {
+		writeMissingParam(w, "namespace")
+		return
+	}
+	if _, ok := s.namespaces[name]; ok {
+		writeAlreadyExists(w, "namespace", name)
+		return
+	}
+	ns := s.addNamespace(name)
+	merge(ns, req, map[string]string{
+		"namespace":              "",
+		"default_object_project": "",
+		"root_user_password":     "",
+		"compliance_enabled":     "is_compliance_enabled",
+	})
+	if _, ok := req["root_user_password"]; ok {
+		ns["root_user_name"] = "root"
+	}
+	writeJSON(w, http.StatusOK, ns)
+}
+
+// addNamespace stores a new namespace with the default settings.
+func (s *Server) addNamespace(name string) document {
+	ns := document{
+		"name":                   name,
+		"id":                     name,
+		"link":                   link("/object/namespaces/namespace/" + name),
+		"creation_time":          nowMillis(),
+		"inactive":               false,
+		"global":                 false,
+		"remote":                 false,
+		"internal":               false,
+		"disallowed_vpools_list": []string{},
+		"is_compliance_enabled":  false,
+		"retention_classes":      document{"retention_class": []document{}},
+	}
+	s.namespaces[name] = ns
+	return ns
+}
+
+func (s *Server) getNamespace(w http.ResponseWriter, r *http.Request) {
+	if ns, ok := s.namespace(w, r); ok {
+		writeJSON(w, http.StatusOK, ns)
+	}
+}
+
+func (s *Server) updateNamespace(w http.ResponseWriter, r *http.Request) {
+	ns, ok := s.namespace(w, r)
+	if !ok {
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	for field, list := range map[string]string{
+		"vpools_added_to_allowed_vpools_list":        "allowed_vpools_list",
+		"vpools_removed_from_allowed_vpools_list":    "allowed_vpools_list",
+		"vpools_added_to_disallowed_vpools_list":     "disallowed_vpools_list",
+		"vpools_removed_from_disallowed_vpools_list": "disallowed_vpools_list",
+	} {
+		var changes []string
+		if err := convert(req[field], &changes); err != nil || len(changes) == 0 {
+			continue
+		}
+		var current []string
+		_ = convert(ns[list], &current)
+		for _, vpool := range changes {
+			if strings.Contains(field, "_added_") {
+				if !slices.Contains(current, vpool) {
+					current = append(current, vpool)
+				}
+			} else {
+				current = slices.DeleteFunc(current, func(v string) bool { return v == vpool })
+			}
+		}
+		if current == nil {
+			current = []string{}
+		}
+		ns[list] = current
+	}
+	merge(ns, req, map[string]string{
+		"vpools_added_to_allowed_vpools_list":        "",
+		"vpools_removed_from_allowed_vpools_list":    "",
+		"vpools_added_to_disallowed_vpools_list":     "",
+		"vpools_removed_from_disallowed_vpools_list": "",
+		"current_root_user_password":                 "",
+		"new_root_user_password":                     "",
+	})
+	writeJSON(w, http.StatusOK, document{})
+}
+
+// deactivateNamespace deletes a namespace. Like the array, it refuses to delete a namespace which still has buckets.
+func (s *Server) deactivateNamespace(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.namespace(w, r); !ok {
+		return
+	}
+	name := r.PathValue("namespace")
+	for _, b := range s.buckets {
+		if b.namespace == name {
+			writeError(w, http.StatusBadRequest, codeInvalidParam, "Namespace is not empty",
+				fmt.Sprintf("namespace %s still has buckets, e.g. %s", name, b.name))
+			return
+		}
+	}
+	delete(s.namespaces, name)
+	delete(s.iam, name)
+	writeJSON(w, http.StatusOK, document{})
+}
+
+// retentionClasses returns the retention classes of a namespace.
+func retentionClasses(ns document) []document {
+	var classes struct {
+		RetentionClass []document `json:"retention_class"`
+	}
+	_ = convert(ns["retention_classes"], &classes)
+	return classes.RetentionClass
+}
+
+func (s *Server) listRetentionClasses(w http.ResponseWriter, r *http.Request) {
+	if ns, ok := s.namespace(w, r); ok {
+		writeJSON(w, http.StatusOK, document{"retention_class": retentionClasses(ns)})
+	}
+}
+
+func (s *Server) createRetentionClass(w http.ResponseWriter, r *http.Request) {
+	ns, ok := s.namespace(w, r)
+	if !ok {
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	name, _ := req["name"].(string)
+	if name == "" {
+		writeMissingParam(w, "name")
+		return
+	}
+	classes := retentionClasses(ns)
+	for _, c := range classes {
+		if c["name"] == name {
+			writeAlreadyExists(w, "retention class", name)
+			return
+		}
+	}
+	ns["retention_classes"] = document{"retention_class": append(classes, document{"name": name, "period": req["period"]})}
+	writeJSON(w, http.StatusOK, document{})
+}
+
+// retentionClass returns the retention class of the request path, writing a 404 when it does not exist.
+func (s *Server) retentionClass(w http.ResponseWriter, r *http.Request) (document, bool) {
+	ns, ok := s.namespace(w, r)
+	if !ok {
+		return nil, false
+	}
+	name := r.PathValue("class")
+	for _, c := range retentionClasses(ns) {
+		if c["name"] == name {
+			return c, true
+		}
+	}
+	writeNotFound(w, "retention class", name)
+	return nil, false
+}
+
+func (s *Server) getRetentionClass(w http.ResponseWriter, r *http.Request) {
+	if c, ok := s.retentionClass(w, r); ok {
+		writeJSON(w, http.StatusOK, c)
+	}
+}
+
+func (s *Server) updateRetentionClass(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.retentionClass(w, r); !ok {
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	ns := s.namespaces[r.PathValue("namespace")]
+	classes := retentionClasses(ns)
+	for _, c := range classes {
+		if c["name"] == r.PathValue("class") {
+			c["period"] = req["period"]
+		}
+	}
+	ns["retention_classes"] = document{"retention_class": classes}
+	writeJSON(w, http.StatusOK, document{})
+}
+
+// quotaFields are the fields of a namespace or bucket quota.
+var quotaFields = []string{"blockSize", "notificationSize", "blockSizeInCount", "notificationSizeInCount"}
+
+func (s *Server) getNamespaceQuota(w http.ResponseWriter, r *http.Request) {
+	ns, ok := s.namespace(w, r)
+	if !ok {
+		return
+	}
+	quota := document{"namespace": ns["name"]}
+	for _, f := range quotaFields {
+		quota[f] = ns[f]
+	}
+	writeJSON(w, http.StatusOK, quota)
+}
+
+func (s *Server) updateNamespaceQuota(w http.ResponseWriter, r *http.Request) {
+	ns, ok := s.namespace(w, r)
+	if !ok {
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	for _, f := range quotaFields {
+		if v, ok := req[f]; ok {
+			ns[f] = v
+		}
+	}
+	writeJSON(w, http.StatusOK, document{})
+}
+
+func (s *Server) removeNamespaceQuota(w http.ResponseWriter, r *http.Request) {
+	ns, ok := s.namespace(w, r)
+	if !ok {
+		return
+	}
+	for _, f := range quotaFields {
+		ns[f] = -1
+	}
+	writeJSON(w, http.StatusOK, document{})
+}
@@ -0,0 +1,370 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testserver
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// secretKeyTimeFormat is the format of the timestamps of the secret keys.
+const secretKeyTimeFormat = "2006-01-02 15:04:05.000"
+
+// objectUser is an object user stored by the simulator, with its two secret key slots.
+type objectUser struct {
+	info document
+	keys [2]*secretKey
+}
+
+// secretKey is a secret key of an object user.
+type secretKey struct {
+	id        string
+	key       string
+	timestamp string
+	expiry    string
+}
+
+func (s *Server) registerObjectUsers(mux *http.ServeMux) {
+	mux.HandleFunc("GET /object/users", s.listObjectUsers)
+	mux.HandleFunc("POST /object/users", s.createObjectUser)
+	mux.HandleFunc("GET /object/users/query", s.queryObjectUsers)
+	mux.HandleFunc("GET /object/users/{namespace}", s.listNamespaceObjectUsers)
+	mux.HandleFunc("GET /object/users/{uid}/info", s.getObjectUser)
+	mux.HandleFunc("POST /object/users/deactivate", s.deleteObjectUser)
+	mux.HandleFunc("PUT /object/users/lock", s.lockObjectUser)
+	mux.HandleFunc("GET /object/users/{uid}/tags", s.getObjectUserTags)
+	mux.HandleFunc("POST /object/users/{uid}/tags", s.updateObjectUserTags(false))
+	mux.HandleFunc("PUT /object/users/{uid}/tags", s.updateObjectUserTags(false))
+	mux.HandleFunc("DELETE /object/users/{uid}/tags", s.updateObjectUserTags(true))
+	mux.HandleFunc("GET /object/user-secret-keys/{uid}", s.getSecretKeys)
+	mux.HandleFunc("GET /object/user-secret-keys/{uid}/{namespace}", s.getSecretKeys)
+	mux.HandleFunc("POST /object/user-secret-keys/{uid}", s.createSecretKey)
+	mux.HandleFunc("POST /object/user-secret-keys/{uid}/deactivate", s.deleteSecretKey)
+}
+
+// objectUser returns the object user of the request path, writing a 404 when it does not exist.
+func (s *Server) objectUser(w http.ResponseWriter, r *http.Request) (*objectUser, bool) {
+	uid := r.PathValue("uid")
+	u, ok := s.objectUsers[uid]
+	if !ok || (r.PathValue("namespace") != "" && u.info["namespace"] != r.PathValue("namespace")) {
+		writeNotFound(w, "user", uid)
+		return nil, false
+	}
+	return u, true
+}
+
+// writeObjectUsers writes a page of the object users matching the filter.
+func (s *Server) writeObjectUsers(w http.ResponseWriter, r *http.Request, match func(u *objectUser) bool) {
+	var keys []string
+	for uid, u := range s.objectUsers {
+		if match(u) {
+			keys = append(keys, uid)
+		}
+	}
+	page, next := paginate(keys, r.URL.Query().Get("marker"), r.URL.Query().Get("limit"))
+	items := make([]document, 0, len(page))
+	for _, uid := range page {
+		items = append(items, document{"userid": uid, "namespace": s.objectUsers[uid].info["namespace"]})
+	}
+	resp := document{"blobuser": items}
+	if next != "" {
+		resp["NextMarker"] = next
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) listObjectUsers(w http.ResponseWriter, r *http.Request) {
+	userid := r.URL.Query().Get("userid")
+	s.writeObjectUsers(w, r, func(u *objectUser) bool {
+		return userid == "" || u.info["name"] == userid
+	})
+}
+
+func (s *Server) listNamespaceObjectUsers(w http.ResponseWriter, r *http.Request) {
+	namespace := r.PathValue("namespace")
+	s.writeObjectUsers(w, r, func(u *objectUser) bool {
+		return u.info["namespace"] == namespace
+	})
+}
+
+// queryObjectUsers lists the object users of a namespace, optionally filtered by a tag name and value.
+func (s *Server) queryObjectUsers(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	s.writeObjectUsers(w, r, func(u *objectUser) bool {
+		if q.Get("namespace") != "" && u.info["namespace"] != q.Get("namespace") {
+			return false
+		}
+		if q.Get("tag") == "" {
+			return true
+		}
+		for _, t := range userTags(u) {
+			if t["name"] == q.Get("tag") && (q.Get("value") == "" || t["value"] == q.Get("value")) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (s *Server) createObjectUser(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		User      string     `json:"user"`
+		Namespace string     `json:"namespace"`
+		Tags      []document `json:"tags"`
+	}
+	if !readBody(w, r, &req) {
+		return
+	}
+	if req.User == "" {
+		writeMissingParam(w, "user")
+		return
+	}
+	if req.Namespace == "" {
+		writeMissingParam(w, "namespace")
+		return
+	}
+	if _, ok := s.namespaces[req.Namespace]; !ok {
+		writeNotFound(w, "namespace", req.Namespace)
+		return
+	}
+	if _, ok := s.objectUsers[req.User]; ok {
+		writeAlreadyExists(w, "user", req.User)
+		return
+	}
+	tags := req.Tags
+	if tags == nil {
+		tags = []document{}
+	}
+	s.objectUsers[req.User] = &objectUser{info: document{
+		"name":            req.User,
+		"namespace":       req.Namespace,
+		"locked":          false,
+		"created":         time.Now().UTC().Format(time.RFC1123),
+		"tag":             tags,
+		"centerapassword": "",
+		"swiftpassword":   "",
+	}}
+	writeJSON(w, http.StatusOK, document{"link": link("/object/users/" + req.User)})
+}
+
+func (s *Server) getObjectUser(w http.ResponseWriter, r *http.Request) {
+	if u, ok := s.objectUser(w, r); ok {
+		writeJSON(w, http.StatusOK, u.info)
+	}
+}
+
+func (s *Server) deleteObjectUser(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		User      string `json:"user"`
+		Namespace string `json:"namespace"`
+	}
+	if !readBody(w, r, &req) {
+		return
+	}
+	u, ok := s.objectUsers[req.User]
+	if !ok || (req.Namespace != "" && u.info["namespace"] != req.Namespace) {
+		writeNotFound(w, "user", req.User)
+		return
+	}
+	delete(s.objectUsers, req.User)
+	writeJSON(w, http.StatusOK, document{})
+}
+
+func (s *Server) lockObjectUser(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		User      string `json:"user"`
+		Namespace string `json:"namespace"`
+		IsLocked  any    `json:"isLocked"`
+	}
+	if !readBody(w, r, &req) {
+		return
+	}
+	u, ok := s.objectUsers[req.User]
+	if !ok {
+		writeNotFound(w, "user", req.User)
+		return
+	}
+	locked, err := strconv.ParseBool(fmt.Sprint(req.IsLocked))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, codeInvalidParam, "Invalid parameter", "isLocked must be true or false")
+		return
+	}
+	u.info["locked"] = locked
+	writeJSON(w, http.StatusOK, document{})
+}
+
+// userTags returns the tags of an object user.
+func userTags(u *objectUser) []document {
+	var tags []document
+	_ = convert(u.info["tag"], &tags)
+	return tags
+}
+
+func (s *Server) getObjectUserTags(w http.ResponseWriter, r *http.Request) {
+	if u, ok := s.objectUser(w, r); ok {
+		writeJSON(w, http.StatusOK, document{"user_name": u.info["name"], "tags": userTags(u)})
+	}
+}
+
+// updateObjectUserTags returns a handler adding or replacing the tags of an object user, or removing them.
+func (s *Server) updateObjectUserTags(remove bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		u, ok := s.objectUser(w, r)
+		if !ok {
+			return
+		}
+		var req struct {
+			Tags []document `json:"tags"`
+		}
+		if !readBody(w, r, &req) {
+			return
+		}
+		tags := []document{}
+		for _, t := range userTags(u) {
+			changed := false
+			for _, c := range req.Tags {
+				changed = changed || t["name"] == c["name"]
+			}
+			if !changed {
+				tags = append(tags, t)
+			}
+		}
+		if !remove {
+			tags = append(tags, req.Tags...)
+		}
+		u.info["tag"] = tags
+		writeJSON(w, http.StatusOK, document{})
+	}
+}
+
+func (s *Server) getSecretKeys(w http.ResponseWriter, r *http.Request) {
+	u, ok := s.objectUser(w, r)
+	if !ok {
+		return
+	}
+	resp := document{"link": link("/object/user-secret-keys/" + r.PathValue("uid"))}
+	for i, k := range u.keys {
+		n := strconv.Itoa(i + 1)
+		resp["secret_key_"+n+"_exist"] = k != nil
+		if k == nil {
+			resp["secret_key_"+n] = ""
+			resp["key_timestamp_"+n] = ""
+			resp["key_expiry_timestamp_"+n] = ""
+			continue
+		}
+		resp["secret_key_"+n] = k.key
+		resp["secret_key_"+n+"_id"] = k.id
+		resp["key_timestamp_"+n] = k.timestamp
+		resp["key_expiry_timestamp_"+n] = k.expiry
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// createSecretKey creates a secret key in a free slot. Like the array, an object user has at most two secret keys,
+// and the existing key can be set to expire when the new key is created.
+func (s *Server) createSecretKey(w http.ResponseWriter, r *http.Request) {
+	u, ok := s.objectUser(w, r)
+	if !ok {
+		return
+	}
+	var req struct {
+		ExistingKeyExpiryTimeMins string `json:"existing_key_expiry_time_mins"`
+		Namespace                 string `json:"namespace"`
+		Secretkey                 string `json:"secretkey"`
+	}
+	if !readBody(w, r, &req) {
+		return
+	}
+	slot := -1
+	for i, k := range u.keys {
+		if k == nil {
+			slot = i
+			break
+		}
+	}
+	if slot < 0 {
+		writeError(w, http.StatusBadRequest, codeInvalidParam, "Invalid parameter",
+			fmt.Sprintf("user %s already has two secret keys", r.PathValue("uid")))
+		return
+	}
+	if req.ExistingKeyExpiryTimeMins != "" {
+		mins, err := strconv.Atoi(req.ExistingKeyExpiryTimeMins)
+		if err != nil || mins < 0 {
+			writeError(w, http.StatusBadRequest, codeInvalidParam, "Invalid parameter",
+				"existing_key_expiry_time_mins must be a positive number")
+			return
+		}
+		expiry := time.Now().UTC().Add(time.Duration(mins) * time.Minute).Format(secretKeyTimeFormat)
+		for _, k := range u.keys {
+			if k != nil {
+				k.expiry = expiry
+			}
+		}
+	}
+	key := &secretKey{
+		id:        s.newID("sk-"),
+		key:       req.Secretkey,
+		timestamp: time.Now().UTC().Format(secretKeyTimeFormat),
+	}
+	if key.key == "" {
+		key.key = randomString(simulatedKeyLength)
+	}
+	u.keys[slot] = key
+	writeJSON(w, http.StatusOK, document{
+		"secret_key":           key.key,
+		"secret_key_id":        key.id,
+		"key_timestamp":        key.timestamp,
+		"key_expiry_timestamp": "",
+		"link":                 link("/object/user-secret-keys/" + r.PathValue("uid")),
+	})
+}
+
+// deleteSecretKey deletes the secret key matching the id or the key, or all the secret keys when none is given.
+func (s *Server) deleteSecretKey(w http.ResponseWriter, r *http.Request) {
+	u, ok := s.objectUser(w, r)
+	if !ok {
+		return
+	}
+	var req struct {
+		SecretKey   string `json:"secret_key"`
+		Namespace   string `json:"namespace"`
+		SecretKeyID string `json:"secret_key_id"`
+	}
+	if !readBody(w, r, &req) {
+		return
+	}
+	deleted := false
+	for i, k := range u.keys {
+		if k == nil {
+			continue
+		}
+		if (req.SecretKeyID == "" && req.SecretKey == "") || k.id == req.SecretKeyID ||
+			(req.SecretKeyID == "" && strings.EqualFold(k.key, req.SecretKey)) {
+			u.keys[i] = nil
+			deleted = true
+		}
+	}
+	if !deleted {
+		writeNotFound(w, "secret key of user", r.PathValue("uid"))
+		return
+	}
+	writeJSON(w, http.StatusOK, document{})
+}
@@ -0,0 +1,301 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package testserver implements an in-process simulator of the subset of the ObjectScale management and IAM APIs
+// used by the provider, so that the acceptance tests can run under go test without a real array.
+//
+// The simulator is stateful: the entities created through the API can be read, updated and deleted again,
+// and the errors are returned with the same status codes and bodies as the array.
+// The routes which are not simulated answer with 501 Not Implemented.
+package testserver
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const authTokenHeader = "X-SDS-AUTH-TOKEN"
+
+// error codes of the management API.
+const (
+	codeAuthFailed     = 999
+	codeNotFound       = 1004
+	codeMissingParam   = 1005
+	codeInvalidParam   = 1008
+	codeAlreadyExists  = 1013
+	codeNotImplemented = 1099
+)
+
+const (
+	// defaultPageSize is the page size of the list APIs when no limit is given.
+	defaultPageSize = 1000
+	// simulatedKeyLength is the length of the generated secret keys.
+	simulatedKeyLength = 40
+)
+
+// document is an entity stored by the simulator, in the JSON shape returned by the API.
+type document map[string]any
+
+// Server is an in-process ObjectScale API simulator.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	credentials map[string]string
+	tokens      map[string]string // auth token to username
+	nextID      int
+
+	namespaces      map[string]document
+	buckets         map[string]*bucket // keyed by namespace and name, see bucketKey
+	objectUsers     map[string]*objectUser
+	iam             map[string]*iamNamespace
+	vpools          map[string]document
+	varrays         map[string]document
+	varrayVdcs      map[string]string // storage pool ID to VDC ID
+	vdcs            map[string]document
+	keystores       map[string]string
+	webhookTargets  map[string]document
+	managementUsers map[string]document
+	serviceProvider document // nil until it is created
+}
+
+// New starts a simulator accepting the given username/password credentials,
+// seeded with the fixtures the acceptance tests assume, see seed.
+// The caller must Close the server when done.
+func New(credentials map[string]string) *Server {
+	s := &Server{
+		credentials:     credentials,
+		tokens:          map[string]string{},
+		namespaces:      map[string]document{},
+		buckets:         map[string]*bucket{},
+		objectUsers:     map[string]*objectUser{},
+		iam:             map[string]*iamNamespace{},
+		vpools:          map[string]document{},
+		varrays:         map[string]document{},
+		varrayVdcs:      map[string]string{},
+		vdcs:            map[string]document{},
+		keystores:       map[string]string{},
+		webhookTargets:  map[string]document{},
+		managementUsers: map[string]document{},
+	}
+	s.seed()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /login", s.login)
+	mux.HandleFunc("GET /logout", s.logout)
+	s.registerNamespaces(mux)
+	s.registerBuckets(mux)
+	s.registerIAM(mux)
+	s.registerObjectUsers(mux)
+	s.registerVdc(mux)
+	s.registerKeystores(mux)
+	s.registerWebhookTargets(mux)
+	s.registerManagementUsers(mux)
+	s.registerServiceProvider(mux)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotImplemented, codeNotImplemented,
+			"Not implemented", fmt.Sprintf("%s %s is not implemented by the test server", r.Method, r.URL.Path))
+	})
+
+	s.Server = httptest.NewServer(s.authenticate(mux))
+	return s
+}
+
+// authenticate rejects the requests without a valid auth token, except the login.
+// It also serializes the requests, so that the handlers can access the state freely.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if r.URL.Path != "/login" {
+			if _, ok := s.tokens[r.Header.Get(authTokenHeader)]; !ok {
+				writeError(w, http.StatusUnauthorized, codeAuthFailed, "Authentication failed", "invalid or expired auth token")
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+	user, pass, ok := r.BasicAuth()
+	if expected, known := s.credentials[user]; !ok || !known || expected != pass {
+		writeError(w, http.StatusUnauthorized, codeAuthFailed, "Authentication failed", "invalid username or password")
+		return
+	}
+	token := randomString(32)
+	s.tokens[token] = user
+	w.Header().Set(authTokenHeader, token)
+	writeJSON(w, http.StatusOK, document{"user": user})
+}
+
+// logout ends the session of the caller, or all the sessions of a user when the username is given.
+func (s *Server) logout(w http.ResponseWriter, r *http.Request) {
+	if user := r.URL.Query().Get("username"); user != "" {
+		for token, u := range s.tokens {
+			if u == user {
+				delete(s.tokens, token)
+			}
+		}
+	} else {
+		delete(s.tokens, r.Header.Get(authTokenHeader))
+	}
+	writeJSON(w, http.StatusOK, document{})
+}
+
+// newID returns a new unique identifier with the given prefix.
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s%06d", prefix, s.nextID)
+}
+
+// writeJSON writes the JSON encoding of v as the response body.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error of the management API.
+func writeError(w http.ResponseWriter, status, code int, description, details string) {
+	writeJSON(w, status, document{
+		"code":        code,
+		"description": description,
+		"details":     details,
+		"retryable":   false,
+	})
+}
+
+// writeNotFound writes the error returned by the management API for an unknown entity.
+func writeNotFound(w http.ResponseWriter, kind, name string) {
+	writeError(w, http.StatusNotFound, codeNotFound, "Unable to find entity specified in URL",
+		fmt.Sprintf("%s %s does not exist", kind, name))
+}
+
+// writeAlreadyExists writes the error returned by the management API for a duplicate entity.
+func writeAlreadyExists(w http.ResponseWriter, kind, name string) {
+	writeError(w, http.StatusConflict, codeAlreadyExists, "Entity already exists",
+		fmt.Sprintf("%s %s already exists", kind, name))
+}
+
+// writeMissingParam writes the error returned by the management API for a missing required parameter.
+func writeMissingParam(w http.ResponseWriter, param string) {
+	writeError(w, http.StatusBadRequest, codeMissingParam, "Required parameter is missing or empty",
+		fmt.Sprintf("%s is required", param))
+}
+
+// readBody decodes the JSON request body into v.
+// It writes the error response and returns false when the body is invalid.
+func readBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, codeInvalidParam, "Invalid request body", err.Error())
+		return false
+	}
+	return true
+}
+
+// convert copies a value into another type of the same JSON shape.
+func convert(from, to any) error {
+	b, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, to)
+}
+
+// merge copies the fields of src into dst, optionally renaming them.
+func merge(dst, src document, rename map[string]string) {
+	for k, v := range src {
+		if n, ok := rename[k]; ok {
+			k = n
+		}
+		if k == "" {
+			continue
+		}
+		dst[k] = v
+	}
+}
+
+// paginate returns the page of the sorted keys starting after the marker, and the marker of the next page if any.
+func paginate(keys []string, marker, limit string) ([]string, string) {
+	sort.Strings(keys)
+	size := defaultPageSize
+	if n, err := strconv.Atoi(limit); err == nil && n > 0 {
+		size = n
+	}
+	start := 0
+	if marker != "" {
+		start = sort.SearchStrings(keys, marker)
+		if start < len(keys) && keys[start] == marker {
+			start++
+		}
+	}
+	end := min(start+size, len(keys))
+	if end < len(keys) {
+		return keys[start:end], keys[end-1]
+	}
+	return keys[start:end], ""
+}
+
+// sortedKeys returns the keys of the map, sorted.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// randomString returns a random alphanumeric string of the given length.
+func randomString(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return strings.NewReplacer("+", "a", "/", "b", "=", "c").Replace(base64.StdEncoding.EncodeToString(b))[:n]
+}
+
+// randomHex returns a random hexadecimal string of the given length.
+func randomHex(n int) string {
+	b := make([]byte, (n+1)/2)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)[:n]
+}
+
+// nowMillis returns the current time in milliseconds since the epoch, as returned by the management API.
+func nowMillis() int64 {
+	return time.Now().UnixMilli()
+}
+
+// nowISO returns the current time in the ISO 8601 format returned by the IAM API.
+func nowISO() string {
+	return time.Now().UTC().Format("2006-01-02T15:04:05Z")
+}
+
+// link returns the self link of an entity.
+func link(href string) document {
+	return document{"rel": "self", "href": href}
+}
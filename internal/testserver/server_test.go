@@ -0,0 +1,353 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testserver
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"terraform-provider-objectscale/internal/client"
+	"terraform-provider-objectscale/internal/clientgen"
+	"testing"
+)
+
+// newTestClient starts a simulator and returns a client logged into it.
+func newTestClient(t *testing.T) (*Server, *client.Client) {
+	t.Helper()
+	s := New(map[string]string{"root": "password"})
+	t.Cleanup(s.Close)
+	c, err := client.NewClient(s.URL, "root", "password", true, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return s, c
+}
+
+// value dereferences a field of a response, returning the zero value when it is not set.
+func value[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
+
+// statusCode returns the status code of a response, or 0 when there is none.
+func statusCode(resp *http.Response) int {
+	if resp == nil {
+		return 0
+	}
+	return resp.StatusCode
+}
+
+func TestLogin(t *testing.T) {
+	s := New(map[string]string{"root": "password"})
+	defer s.Close()
+
+	if _, err := client.NewClient(s.URL, "root", "wrong", true, 10); err == nil {
+		t.Errorf("expected login error with wrong password")
+	}
+	c, err := client.NewClient(s.URL, "root", "password", true, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(s.tokens) != 1 {
+		t.Errorf("expected 1 session, got %d", len(s.tokens))
+	}
+	if err := c.Logout(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(s.tokens) != 0 {
+		t.Errorf("expected no session after logout, got %d", len(s.tokens))
+	}
+}
+
+func TestUnauthenticated(t *testing.T) {
+	s := New(map[string]string{"root": "password"})
+	defer s.Close()
+
+	resp, err := http.Get(s.URL + "/object/namespaces")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected 401, got %d", resp.StatusCode)
+	}
+}
+
+func TestNotImplemented(t *testing.T) {
+	_, c := newTestClient(t)
+
+	_, resp, err := c.GenClient.BucketApi.BucketServiceGetBucketQuota(context.Background(), "bucket1").Execute()
+	if err == nil || statusCode(resp) != http.StatusNotImplemented {
+		t.Errorf("expected 501, got %d: %v", statusCode(resp), err)
+	}
+}
+
+func TestNamespace(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	_, _, err := c.GenClient.NamespaceApi.NamespaceServiceCreateNamespace(ctx).
+		NamespaceServiceCreateNamespaceRequest(clientgen.NamespaceServiceCreateNamespaceRequest{Namespace: "ns2"}).
+		Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, resp, err := c.GenClient.NamespaceApi.NamespaceServiceCreateNamespace(ctx).
+		NamespaceServiceCreateNamespaceRequest(clientgen.NamespaceServiceCreateNamespaceRequest{Namespace: "ns2"}).
+		Execute()
+	if err == nil || statusCode(resp) != http.StatusConflict {
+		t.Errorf("expected 409 on duplicate namespace, got %d: %v", statusCode(resp), err)
+	}
+
+	ns, _, err := c.GenClient.NamespaceApi.NamespaceServiceGetNamespace(ctx, "ns2").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value(ns.Name) != "ns2" {
+		t.Errorf("expected ns2, got %s", value(ns.Name))
+	}
+
+	if _, _, err := c.GenClient.NamespaceApi.NamespaceServiceDeactivateNamespace(ctx, "ns2").Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, resp, err = c.GenClient.NamespaceApi.NamespaceServiceGetNamespace(ctx, "ns2").Execute()
+	if err == nil || statusCode(resp) != http.StatusNotFound {
+		t.Errorf("expected 404 after delete, got %d: %v", statusCode(resp), err)
+	}
+}
+
+func TestBucket(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+	ns := "ns1"
+
+	_, _, err := c.GenClient.BucketApi.BucketServiceCreateBucket(ctx).
+		BucketServiceCreateBucketRequest(clientgen.BucketServiceCreateBucketRequest{Name: "bucket1", Namespace: &ns}).
+		Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// a namespace holding a bucket cannot be deleted
+	_, resp, err := c.GenClient.NamespaceApi.NamespaceServiceDeactivateNamespace(ctx, ns).Execute()
+	if err == nil || statusCode(resp) != http.StatusBadRequest {
+		t.Errorf("expected 400 on delete of a namespace with buckets, got %d: %v", statusCode(resp), err)
+	}
+
+	bucket, _, err := c.GenClient.BucketApi.BucketServiceGetBucketInfo(ctx, "bucket1").Namespace(ns).Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value(bucket.Name) != "bucket1" || value(bucket.Namespace) != ns {
+		t.Errorf("unexpected bucket %s/%s", value(bucket.Namespace), value(bucket.Name))
+	}
+
+	if _, _, err := c.GenClient.BucketApi.BucketServiceDeactivateBucket(ctx, "bucket1").Namespace(ns).Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, resp, err = c.GenClient.BucketApi.BucketServiceGetBucketInfo(ctx, "bucket1").Namespace(ns).Execute()
+	if err == nil || statusCode(resp) != http.StatusNotFound {
+		t.Errorf("expected 404 after delete, got %d: %v", statusCode(resp), err)
+	}
+}
+
+func TestObjectUserSecretKeys(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+	ns := "ns1"
+
+	_, _, err := c.GenClient.UserManagementApi.UserManagementServiceAddUser(ctx).
+		UserManagementServiceAddUserRequest(clientgen.UserManagementServiceAddUserRequest{User: "ou1", Namespace: ns}).
+		Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	secret := "customsecret"
+	for _, key := range []*string{&secret, nil} {
+		_, _, err := c.GenClient.UserSecretKeyApi.UserSecretKeyServiceCreateNewKeyForUser(ctx, "ou1").
+			UserSecretKeyServiceCreateNewKeyForUserRequest(clientgen.UserSecretKeyServiceCreateNewKeyForUserRequest{Namespace: &ns, Secretkey: key}).
+			Execute()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	_, resp, err := c.GenClient.UserSecretKeyApi.UserSecretKeyServiceCreateNewKeyForUser(ctx, "ou1").
+		UserSecretKeyServiceCreateNewKeyForUserRequest(clientgen.UserSecretKeyServiceCreateNewKeyForUserRequest{Namespace: &ns}).
+		Execute()
+	if err == nil || statusCode(resp) != http.StatusBadRequest {
+		t.Errorf("expected 400 on a third secret key, got %d: %v", statusCode(resp), err)
+	}
+
+	keys, _, err := c.GenClient.UserSecretKeyApi.UserSecretKeyServiceGetKeysForUser(ctx, "ou1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value(keys.SecretKey1) != secret || len(value(keys.SecretKey2)) != simulatedKeyLength {
+		t.Errorf("unexpected secret keys %q and %q", value(keys.SecretKey1), value(keys.SecretKey2))
+	}
+}
+
+func TestWebhookTarget(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+	api := c.GenClient.WebhookConfigurationApi
+	name, url := "hook1", "https://hooks.example.com"
+
+	target, _, err := api.WebhookConfigurationServiceCreateWebhookConfiguration(ctx).
+		ObjectWebhookTarget(clientgen.ObjectWebhookTarget{Name: &name, Url: &url}).
+		Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	id := value(target.Id)
+	if value(target.Status) != "ENABLED" || value(target.BackoffRetries) != "3" {
+		t.Errorf("unexpected webhook target %+v", target)
+	}
+	_, resp, err := api.WebhookConfigurationServiceCreateWebhookConfiguration(ctx).
+		ObjectWebhookTarget(clientgen.ObjectWebhookTarget{Name: &name, Url: &url}).
+		Execute()
+	if err == nil || statusCode(resp) != http.StatusConflict {
+		t.Errorf("expected 409 on duplicate webhook target, got %d: %v", statusCode(resp), err)
+	}
+
+	list, _, err := api.WebhookConfigurationServiceGetObjectWebhookTargets(ctx).Prefix("hook*").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.ObjectWebhookTargets) != 1 {
+		t.Errorf("expected 1 webhook target, got %d", len(list.ObjectWebhookTargets))
+	}
+
+	disabled := "false"
+	target, _, err = api.WebhookConfigurationServiceUpdateWebhookConfiguration(ctx, id).
+		WebhookConfigurationServiceUpdateWebhookConfigurationRequest(clientgen.WebhookConfigurationServiceUpdateWebhookConfigurationRequest{IsEnabled: &disabled}).
+		Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value(target.Status) != "DISABLED" {
+		t.Errorf("expected DISABLED, got %s", value(target.Status))
+	}
+
+	if _, _, err := api.WebhookConfigurationServiceDeleteWebhookConfigurationByID(ctx, id).Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// the array answers an unknown ID with an empty object
+	target, _, err = api.WebhookConfigurationServiceGetWebhookConfigurationByID(ctx, id).Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if target.Id != nil {
+		t.Errorf("expected an empty webhook target after delete, got %+v", target)
+	}
+}
+
+func TestManagementUser(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+	api := c.GenClient.MgmtUserInfoApi
+
+	user, _, err := api.MgmtUserInfoServiceGetLocalUserInfo(ctx, "testlocaluser1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value(user.UserId) != "testlocaluser1" {
+		t.Errorf("expected testlocaluser1, got %s", value(user.UserId))
+	}
+
+	password, admin := "password", true
+	_, _, err = api.MgmtUserInfoServiceCreateLocalUserInfo(ctx).
+		MgmtUserInfoServiceCreateLocalUserInfoRequest(clientgen.MgmtUserInfoServiceCreateLocalUserInfoRequest{UserId: "mgmt1", Password: &password}).
+		Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, _, err = api.MgmtUserInfoServiceModifyLocalUserInfo(ctx, "mgmt1").
+		MgmtUserInfoServiceModifyLocalUserInfoRequest(clientgen.MgmtUserInfoServiceModifyLocalUserInfoRequest{IsSystemAdmin: &admin}).
+		Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	user, _, err = api.MgmtUserInfoServiceGetLocalUserInfo(ctx, "mgmt1").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !value(user.IsSystemAdmin) || value(user.LastTimePasswordChanged) == "" {
+		t.Errorf("unexpected management user %+v", user)
+	}
+
+	if _, _, err := api.MgmtUserInfoServiceDeleteLocalUserInfo(ctx, "mgmt1").Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, resp, err := api.MgmtUserInfoServiceGetLocalUserInfo(ctx, "mgmt1").Execute()
+	if err == nil || statusCode(resp) != http.StatusNotFound {
+		t.Errorf("expected 404 after delete, got %d: %v", statusCode(resp), err)
+	}
+}
+
+func TestServiceProvider(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+	api := c.GenClient.IamProviderApi
+
+	_, resp, err := api.ServiceProviderGet(ctx).Execute()
+	if err == nil || statusCode(resp) != http.StatusNotFound {
+		t.Errorf("expected 404 before create, got %d: %v", statusCode(resp), err)
+	}
+
+	dns, keystore, alias, password := "sp.example.com", "a2V5c3RvcmU=", "sp", "password"
+	req := clientgen.IamServiceProviderControllerProcessCreateServiceProviderRequest{
+		ServiceProvider: &clientgen.ServiceProvider{Dns: &dns, JavaKeystore: &keystore, KeyAlias: &alias, KeyPassword: &password},
+	}
+	if _, _, err := api.ServiceProviderCreate(ctx).IamServiceProviderControllerProcessCreateServiceProviderRequest(req).Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, resp, err = api.ServiceProviderCreate(ctx).IamServiceProviderControllerProcessCreateServiceProviderRequest(req).Execute()
+	if err == nil || statusCode(resp) != http.StatusConflict {
+		t.Errorf("expected 409 on a second service provider, got %d: %v", statusCode(resp), err)
+	}
+
+	sp, _, err := api.ServiceProviderGet(ctx).Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := sp.GetServiceProviderResult.ServiceProvider
+	if value(got.Dns) != dns || got.JavaKeystore != nil || got.KeyPassword != nil {
+		t.Errorf("unexpected service provider %+v", got)
+	}
+
+	metadata, _, err := api.ServiceProviderGetMetadata(ctx).Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(metadata, "https://"+dns+"/saml") {
+		t.Errorf("unexpected metadata %s", metadata)
+	}
+
+	if _, _, err := api.ServiceProviderDelete(ctx).Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, resp, err = api.ServiceProviderGet(ctx).Execute()
+	if err == nil || statusCode(resp) != http.StatusNotFound {
+		t.Errorf("expected 404 after delete, got %d: %v", statusCode(resp), err)
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testserver
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+)
+
+// serviceProviderMetadata is the SAML metadata of the service provider,
+// formatted with its DNS name, its signing certificate and its DNS name again.
+const serviceProviderMetadata = `<?xml version="1.0" encoding="UTF-8"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://%s/saml">
+  <md:SPSSODescriptor AuthnRequestsSigned="true" WantAssertionsSigned="true" protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">
+        <ds:X509Data><ds:X509Certificate>%s</ds:X509Certificate></ds:X509Data>
+      </ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:NameIDFormat>urn:oasis:names:tc:SAML:2.0:nameid-format:persistent</md:NameIDFormat>
+    <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>
+    <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://%s/saml/acs" index="0"/>
+  </md:SPSSODescriptor>
+</md:EntityDescriptor>
+`
+
+func (s *Server) registerServiceProvider(mux *http.ServeMux) {
+	mux.HandleFunc("GET /ecs-service-provider", s.getServiceProvider)
+	mux.HandleFunc("POST /ecs-service-provider", s.createServiceProvider)
+	mux.HandleFunc("PUT /ecs-service-provider", s.updateServiceProvider)
+	mux.HandleFunc("DELETE /ecs-service-provider", s.deleteServiceProvider)
+	mux.HandleFunc("GET /ecs-service-provider/metadata", s.getServiceProviderMetadata)
+}
+
+// writeNoServiceProvider writes the error returned by the API before the service provider is created.
+func writeNoServiceProvider(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, codeNotFound, "Unable to find entity specified in URL", "the service provider is not configured")
+}
+
+// serviceProviderRequest decodes the service provider of a create or update request.
+// It writes the error response and returns false when the request is invalid.
+func serviceProviderRequest(w http.ResponseWriter, r *http.Request) (document, bool) {
+	var req struct {
+		ServiceProvider document `json:"service_provider"`
+	}
+	if !readBody(w, r, &req) {
+		return nil, false
+	}
+	for _, f := range []string{"dns", "java_keystore", "key_alias", "key_password"} {
+		if v, _ := req.ServiceProvider[f].(string); v == "" {
+			writeMissingParam(w, f)
+			return nil, false
+		}
+	}
+	return req.ServiceProvider, true
+}
+
+// setServiceProvider stores the service provider of the request.
+// The keystore and its password are write-only, they are never returned by the array.
+func (s *Server) setServiceProvider(req document) {
+	now := nowISO()
+	if s.serviceProvider == nil {
+		s.serviceProvider = document{
+			"uuid":        randomHex(32),
+			"unique_id":   s.newID("sp-"),
+			"create_time": now,
+		}
+	}
+	merge(s.serviceProvider, req, map[string]string{"java_keystore": "", "key_password": ""})
+	s.serviceProvider["etag"] = randomHex(16)
+	s.serviceProvider["last_modified"] = now
+}
+
+// writeServiceProvider writes the service provider in the result element of the action.
+func (s *Server) writeServiceProvider(w http.ResponseWriter, action string) {
+	writeJSON(w, http.StatusOK, document{
+		action + "Result":  document{"service_provider": s.serviceProvider},
+		"ResponseMetadata": document{"RequestId": randomHex(32)},
+	})
+}
+
+func (s *Server) getServiceProvider(w http.ResponseWriter, r *http.Request) {
+	if s.serviceProvider == nil {
+		writeNoServiceProvider(w)
+		return
+	}
+	s.writeServiceProvider(w, "GetServiceProvider")
+}
+
+func (s *Server) createServiceProvider(w http.ResponseWriter, r *http.Request) {
+	if s.serviceProvider != nil {
+		writeAlreadyExists(w, "service provider", s.serviceProvider["dns"].(string))
+		return
+	}
+	req, ok := serviceProviderRequest(w, r)
+	if !ok {
+		return
+	}
+	s.setServiceProvider(req)
+	s.writeServiceProvider(w, "CreateServiceProvider")
+}
+
+func (s *Server) updateServiceProvider(w http.ResponseWriter, r *http.Request) {
+	if s.serviceProvider == nil {
+		writeNoServiceProvider(w)
+		return
+	}
+	req, ok := serviceProviderRequest(w, r)
+	if !ok {
+		return
+	}
+	s.setServiceProvider(req)
+	s.writeServiceProvider(w, "UpdateServiceProvider")
+}
+
+func (s *Server) deleteServiceProvider(w http.ResponseWriter, r *http.Request) {
+	if s.serviceProvider == nil {
+		writeNoServiceProvider(w)
+		return
+	}
+	s.serviceProvider = nil
+	writeJSON(w, http.StatusOK, document{"ResponseMetadata": document{"RequestId": randomHex(32)}})
+}
+
+// getServiceProviderMetadata writes the SAML metadata of the service provider,
+// whose signing certificate is the certificate of the object certificate keystore.
+func (s *Server) getServiceProviderMetadata(w http.ResponseWriter, r *http.Request) {
+	if s.serviceProvider == nil {
+		writeNoServiceProvider(w)
+		return
+	}
+	cert := ""
+	if block, _ := pem.Decode([]byte(s.keystores[objectCertKeystore])); block != nil {
+		cert = base64.StdEncoding.EncodeToString(block.Bytes)
+	}
+	dns := s.serviceProvider["dns"]
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	_, _ = fmt.Fprintf(w, serviceProviderMetadata, dns, cert, dns)
+}
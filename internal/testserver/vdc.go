@@ -0,0 +1,428 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testserver
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+// varrayOnline is the status of a healthy storage pool.
+const varrayOnline = 1
+
+func (s *Server) registerVdc(mux *http.ServeMux) {
+	mux.HandleFunc("GET /vdc/data-service/vpools", s.listVpools)
+	mux.HandleFunc("POST /vdc/data-service/vpools", s.createVpool)
+	mux.HandleFunc("GET /vdc/data-service/vpools/{id}", s.getVpool)
+	mux.HandleFunc("PUT /vdc/data-service/vpools/{id}", s.updateVpool)
+	mux.HandleFunc("PUT /vdc/data-service/vpools/{id}/addvarrays", s.addVpoolVarrays)
+	mux.HandleFunc("PUT /vdc/data-service/vpools/{id}/removevarrays", s.removeVpoolVarrays)
+
+	mux.HandleFunc("GET /vdc/data-services/varrays", s.listVarrays)
+	mux.HandleFunc("POST /vdc/data-services/varrays", s.createVarray)
+	mux.HandleFunc("GET /vdc/data-services/varrays/{id}", s.getVarray)
+	mux.HandleFunc("PUT /vdc/data-services/varrays/{id}", s.updateVarray)
+	mux.HandleFunc("DELETE /vdc/data-services/varrays/{id}", s.deleteVarray)
+
+	mux.HandleFunc("GET /object/vdcs/vdc/local", s.getLocalVdc)
+	mux.HandleFunc("GET /object/vdcs/vdc/list", s.listVdcs)
+	mux.HandleFunc("GET /object/vdcs/vdc/{vdcName}", s.getVdcByName)
+	mux.HandleFunc("PUT /object/vdcs/vdc/{vdcName}", s.insertVdc)
+	mux.HandleFunc("GET /object/vdcs/vdcid/{vdcId}", s.getVdcByID)
+	mux.HandleFunc("POST /object/vdcs/vdc/{vdcId}/deactivate", s.deactivateVdc)
+}
+
+// ---- replication groups (vpools)
+
+func (s *Server) vpool(w http.ResponseWriter, r *http.Request) (document, bool) {
+	id := r.PathValue("id")
+	vp, ok := s.vpools[id]
+	if !ok {
+		writeNotFound(w, "replication group", id)
+	}
+	return vp, ok
+}
+
+func (s *Server) listVpools(w http.ResponseWriter, _ *http.Request) {
+	vpools := []document{}
+	for _, id := range sortedKeys(s.vpools) {
+		vpools = append(vpools, s.vpools[id])
+	}
+	writeJSON(w, http.StatusOK, document{"data_service_vpool": vpools})
+}
+
+func (s *Server) createVpool(w http.ResponseWriter, r *http.Request) {
+	var body document
+	if !readBody(w, r, &body) {
+		return
+	}
+	name, _ := body["name"].(string)
+	if name == "" {
+		writeMissingParam(w, "name")
+		return
+	}
+	for _, vp := range s.vpools {
+		if vp["name"] == name {
+			writeAlreadyExists(w, "replication group", name)
+			return
+		}
+	}
+	vp := s.addVpool(name)
+	merge(vp, body, map[string]string{
+		"zone_mappings":          "varrayMappings",
+		"use_replication_target": "useReplicationTarget",
+		"id":                     "",
+	})
+	writeJSON(w, http.StatusOK, vp)
+}
+
+// addVpool stores a new replication group with the default settings.
+func (s *Server) addVpool(name string) document {
+	id := fmt.Sprintf("urn:storageos:ReplicationGroupInfo:%s:global", s.newID("rg-"))
+	vp := document{
+		"name":                 name,
+		"id":                   id,
+		"link":                 link("/vdc/data-service/vpools/" + id),
+		"description":          "",
+		"creation_time":        nowMillis(),
+		"varrayMappings":       []any{},
+		"enable_rebalancing":   true,
+		"isAllowAllNamespaces": true,
+		"isFullRep":            false,
+		"useReplicationTarget": false,
+		"inactive":             false,
+		"global":               true,
+		"remote":               false,
+		"internal":             false,
+	}
+	s.vpools[id] = vp
+	return vp
+}
+
+func (s *Server) getVpool(w http.ResponseWriter, r *http.Request) {
+	if vp, ok := s.vpool(w, r); ok {
+		writeJSON(w, http.StatusOK, vp)
+	}
+}
+
+func (s *Server) updateVpool(w http.ResponseWriter, r *http.Request) {
+	vp, ok := s.vpool(w, r)
+	if !ok {
+		return
+	}
+	var body document
+	if !readBody(w, r, &body) {
+		return
+	}
+	merge(vp, body, map[string]string{"allowAllNamespaces": "isAllowAllNamespaces"})
+	writeJSON(w, http.StatusOK, document{})
+}
+
+// vpoolMappings decodes the varray mappings of an addvarrays or removevarrays request.
+func vpoolMappings(w http.ResponseWriter, r *http.Request) ([]document, bool) {
+	var body struct {
+		Mappings []document `json:"mappings"`
+	}
+	if !readBody(w, r, &body) {
+		return nil, false
+	}
+	if len(body.Mappings) == 0 {
+		writeMissingParam(w, "mappings")
+		return nil, false
+	}
+	return body.Mappings, true
+}
+
+// currentMappings returns the varray mappings of a replication group.
+func currentMappings(vp document) []document {
+	var mappings []document
+	_ = convert(vp["varrayMappings"], &mappings)
+	return mappings
+}
+
+func (s *Server) addVpoolVarrays(w http.ResponseWriter, r *http.Request) {
+	vp, ok := s.vpool(w, r)
+	if !ok {
+		return
+	}
+	added, ok := vpoolMappings(w, r)
+	if !ok {
+		return
+	}
+	vp["varrayMappings"] = append(currentMappings(vp), added...)
+	writeJSON(w, http.StatusOK, document{})
+}
+
+func (s *Server) removeVpoolVarrays(w http.ResponseWriter, r *http.Request) {
+	vp, ok := s.vpool(w, r)
+	if !ok {
+		return
+	}
+	removed, ok := vpoolMappings(w, r)
+	if !ok {
+		return
+	}
+	vp["varrayMappings"] = slices.DeleteFunc(currentMappings(vp), func(m document) bool {
+		return slices.ContainsFunc(removed, func(rm document) bool {
+			return rm["name"] == m["name"] && rm["value"] == m["value"]
+		})
+	})
+	writeJSON(w, http.StatusOK, document{})
+}
+
+// ---- storage pools (varrays)
+
+func (s *Server) varray(w http.ResponseWriter, r *http.Request) (document, bool) {
+	id := r.PathValue("id")
+	va, ok := s.varrays[id]
+	if !ok {
+		writeNotFound(w, "storage pool", id)
+	}
+	return va, ok
+}
+
+// listVarrays lists the storage pools of the given VDC, the local one by default.
+func (s *Server) listVarrays(w http.ResponseWriter, r *http.Request) {
+	vdc := r.URL.Query().Get("vdc-id")
+	if vdc == "" {
+		vdc = s.localVdc()["vdcId"].(string)
+	}
+	varrays := []document{}
+	for _, id := range sortedKeys(s.varrays) {
+		if s.varrayVdcs[id] == vdc {
+			varrays = append(varrays, s.varrays[id])
+		}
+	}
+	writeJSON(w, http.StatusOK, document{"varray": varrays})
+}
+
+func (s *Server) createVarray(w http.ResponseWriter, r *http.Request) {
+	var body document
+	if !readBody(w, r, &body) {
+		return
+	}
+	name, _ := body["name"].(string)
+	if name == "" {
+		writeMissingParam(w, "name")
+		return
+	}
+	// like the array, only unprotected storage pools can be created
+	if protected, _ := body["isProtected"].(bool); protected {
+		writeError(w, http.StatusBadRequest, codeInvalidParam, "Invalid parameter", "protected storage pool is not supported")
+		return
+	}
+	vdc := s.localVdc()["vdcId"].(string)
+	for id, va := range s.varrays {
+		if va["name"] == name && s.varrayVdcs[id] == vdc {
+			writeAlreadyExists(w, "storage pool", name)
+			return
+		}
+	}
+	va := s.addVarray(vdc, name)
+	merge(va, body, map[string]string{"isProtected": "", "numberOfStorageServerInstances": ""})
+	writeJSON(w, http.StatusOK, va)
+}
+
+// addVarray stores a new storage pool of a VDC with the default settings.
+func (s *Server) addVarray(vdc, name string) document {
+	id := fmt.Sprintf("urn:storageos:VirtualArray:%s", s.newID("sp-"))
+	va := document{
+		"id":                   id,
+		"name":                 name,
+		"description":          "",
+		"isColdStorageEnabled": false,
+		"numberOfDataBlocks":   12,
+		"numberOfCodeBlocks":   4,
+		"warningAlertAt":       80,
+		"errorAlertAt":         90,
+		"criticalAlertAt":      95,
+		"status":               varrayOnline,
+		"label":                "",
+		"driveTechnology":      "HDD",
+	}
+	s.varrays[id] = va
+	s.varrayVdcs[id] = vdc
+	return va
+}
+
+func (s *Server) getVarray(w http.ResponseWriter, r *http.Request) {
+	if va, ok := s.varray(w, r); ok {
+		writeJSON(w, http.StatusOK, va)
+	}
+}
+
+func (s *Server) updateVarray(w http.ResponseWriter, r *http.Request) {
+	va, ok := s.varray(w, r)
+	if !ok {
+		return
+	}
+	var body document
+	if !readBody(w, r, &body) {
+		return
+	}
+	merge(va, body, map[string]string{"isProtected": "", "numberOfStorageServerInstances": ""})
+	writeJSON(w, http.StatusOK, va)
+}
+
+// deleteVarray deletes a storage pool. Like the array, a storage pool used by a replication group cannot be deleted.
+func (s *Server) deleteVarray(w http.ResponseWriter, r *http.Request) {
+	va, ok := s.varray(w, r)
+	if !ok {
+		return
+	}
+	for _, vp := range s.vpools {
+		for _, m := range currentMappings(vp) {
+			if m["value"] == va["id"] {
+				writeError(w, http.StatusBadRequest, codeInvalidParam, "Storage pool is in use",
+					fmt.Sprintf("storage pool %s is used by replication group %s", va["name"], vp["name"]))
+				return
+			}
+		}
+	}
+	delete(s.varrays, r.PathValue("id"))
+	delete(s.varrayVdcs, r.PathValue("id"))
+	writeJSON(w, http.StatusOK, document{})
+}
+
+// ---- VDCs
+
+// localVdc returns the VDC the simulator answers for.
+func (s *Server) localVdc() document {
+	for _, vdc := range s.vdcs {
+		if vdc["local"] == true {
+			return vdc
+		}
+	}
+	return nil
+}
+
+// vdcByName returns the VDC with the given name.
+func (s *Server) vdcByName(name string) document {
+	for _, vdc := range s.vdcs {
+		if vdc["vdcName"] == name {
+			return vdc
+		}
+	}
+	return nil
+}
+
+// addVdc stores a new VDC with the given endpoints.
+func (s *Server) addVdc(name, endpoints, secretKey string, local bool) document {
+	id := fmt.Sprintf("urn:storageos:VirtualDataCenterData:%s", s.newID("vdc-"))
+	vdc := document{
+		"vdcId":                 id,
+		"vdcName":               name,
+		"name":                  name,
+		"id":                    id,
+		"link":                  link("/object/vdcs/vdcid/" + id),
+		"interVdcEndPoints":     endpoints,
+		"interVdcCmdEndPoints":  endpoints,
+		"managementEndPoints":   endpoints,
+		"secretKeys":            secretKey,
+		"permanentlyFailed":     false,
+		"local":                 local,
+		"is_encryption_enabled": false,
+		"hosted":                true,
+		"creation_time":         nowMillis(),
+		"inactive":              false,
+		"global":                true,
+		"remote":                false,
+		"internal":              false,
+	}
+	s.vdcs[id] = vdc
+	return vdc
+}
+
+func (s *Server) getLocalVdc(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, s.localVdc())
+}
+
+func (s *Server) listVdcs(w http.ResponseWriter, _ *http.Request) {
+	vdcs := []document{}
+	for _, id := range sortedKeys(s.vdcs) {
+		vdcs = append(vdcs, s.vdcs[id])
+	}
+	writeJSON(w, http.StatusOK, document{"vdc": vdcs})
+}
+
+func (s *Server) getVdcByName(w http.ResponseWriter, r *http.Request) {
+	vdc := s.vdcByName(r.PathValue("vdcName"))
+	if vdc == nil {
+		writeNotFound(w, "VDC", r.PathValue("vdcName"))
+		return
+	}
+	writeJSON(w, http.StatusOK, vdc)
+}
+
+func (s *Server) getVdcByID(w http.ResponseWriter, r *http.Request) {
+	vdc, ok := s.vdcs[r.PathValue("vdcId")]
+	if !ok {
+		writeNotFound(w, "VDC", r.PathValue("vdcId"))
+		return
+	}
+	writeJSON(w, http.StatusOK, vdc)
+}
+
+// insertVdc adds a remote VDC, or updates the endpoints of an existing VDC.
+func (s *Server) insertVdc(w http.ResponseWriter, r *http.Request) {
+	var body document
+	if !readBody(w, r, &body) {
+		return
+	}
+	endpoints, _ := body["interVdcEndPoints"].(string)
+	if endpoints == "" {
+		writeMissingParam(w, "interVdcEndPoints")
+		return
+	}
+	name := r.PathValue("vdcName")
+	vdc := s.vdcByName(name)
+	if vdc == nil {
+		secretKey, _ := body["secretKeys"].(string)
+		vdc = s.addVdc(name, endpoints, secretKey, false)
+	}
+	merge(vdc, body, map[string]string{"vdcName": ""})
+	writeJSON(w, http.StatusOK, document{})
+}
+
+// deactivateVdc removes a remote VDC. Like the array, the local VDC and the VDCs used by a
+// replication group cannot be removed.
+func (s *Server) deactivateVdc(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("vdcId")
+	vdc, ok := s.vdcs[id]
+	if !ok {
+		writeNotFound(w, "VDC", id)
+		return
+	}
+	if vdc["local"] == true {
+		writeError(w, http.StatusBadRequest, codeInvalidParam, "Cannot remove the local VDC",
+			fmt.Sprintf("VDC %s is the local VDC", vdc["vdcName"]))
+		return
+	}
+	for _, vp := range s.vpools {
+		for _, m := range currentMappings(vp) {
+			if m["name"] == id {
+				writeError(w, http.StatusBadRequest, codeInvalidParam, "VDC is in use",
+					fmt.Sprintf("VDC %s is used by replication group %s", vdc["vdcName"], vp["name"]))
+				return
+			}
+		}
+	}
+	delete(s.vdcs, id)
+	writeJSON(w, http.StatusOK, document{})
+}
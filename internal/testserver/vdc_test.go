@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testserver
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-objectscale/internal/clientgen"
+	"testing"
+)
+
+func TestVdcFixtures(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	local, _, err := c.GenClient.ZoneInfoApi.ZoneInfoServiceGetLocalVdc(ctx).Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value(local.VdcName) != "vdc1" {
+		t.Errorf("expected local VDC vdc1, got %s", value(local.VdcName))
+	}
+	for _, name := range []string{"vdc1", "vdc2", "vdc3"} {
+		vdc, _, err := c.GenClient.ZoneInfoApi.ZoneInfoServiceGetVdcByName(ctx, name).Execute()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		pools, _, err := c.GenClient.ObjectVarrayApi.ObjectVarrayServiceGetVirtualArrays(ctx).VdcId(value(vdc.VdcId)).Execute()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(pools.Varray) != 1 || value(pools.Varray[0].Name) != "sp1" {
+			t.Errorf("expected storage pool sp1 in %s, got %v", name, pools.Varray)
+		}
+	}
+
+	rgs, _, err := c.GenClient.DataVpoolApi.DataServiceVpoolServiceGetDataServiceVpools(ctx).Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rgs.DataServiceVpool) != 1 || value(rgs.DataServiceVpool[0].Name) != "rg1" {
+		t.Errorf("expected replication group rg1, got %v", rgs.DataServiceVpool)
+	}
+}
+
+func TestReplicationGroup(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	vdc, _, err := c.GenClient.ZoneInfoApi.ZoneInfoServiceGetVdcByName(ctx, "vdc2").Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pools, _, err := c.GenClient.ObjectVarrayApi.ObjectVarrayServiceGetVirtualArrays(ctx).VdcId(value(vdc.VdcId)).Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rg, _, err := c.GenClient.DataVpoolApi.DataServiceVpoolServiceCreateDataServiceVpool(ctx).
+		DataServiceVpoolServiceCreateDataServiceVpoolRequest(clientgen.DataServiceVpoolServiceCreateDataServiceVpoolRequest{
+			Name: "rg2",
+			ZoneMappings: []clientgen.DataServiceVpoolServiceGetDataServiceVpoolsResponseDataServiceVpoolInnerVarrayMappingsInner{
+				{Name: vdc.VdcId, Value: pools.Varray[0].Id},
+			},
+		}).
+		Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rg.VarrayMappings) != 1 {
+		t.Errorf("expected 1 zone mapping, got %d", len(rg.VarrayMappings))
+	}
+
+	// a storage pool used by a replication group cannot be deleted
+	_, resp, err := c.GenClient.ObjectVarrayApi.ObjectVarrayServiceDeleteVirtualArray(ctx, value(pools.Varray[0].Id)).Execute()
+	if err == nil || statusCode(resp) != http.StatusBadRequest {
+		t.Errorf("expected 400 on delete of a storage pool in use, got %d: %v", statusCode(resp), err)
+	}
+}
+
+func TestKeystore(t *testing.T) {
+	s, c := newTestClient(t)
+
+	chain, err := selfSignedChain([]string{"10.0.0.1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := validateKeyAndChain("not a key", chain); err == nil {
+		t.Errorf("expected an error with an invalid private key")
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, s.URL+objectCertKeystore, nil)
+	for k, v := range c.GenClient.GetConfig().DefaultHeader {
+		req.Header.Set(k, v)
+	}
+	resp, err := c.GenClient.GetConfig().HTTPClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || s.keystores[objectCertKeystore] == "" {
+		t.Errorf("expected a seeded object certificate, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2026 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testserver
+
+import (
+	"net/http"
+	"strings"
+)
+
+// webhookTargetFields are the fields of a webhook target which can be set through the API.
+// The auth token is write-only, it is never returned by the array.
+var webhookTargetFields = []string{"name", "url", "pre_backoff_retries", "backoff_retries", "comment", "ca_certificate", "is_enabled"}
+
+func (s *Server) registerWebhookTargets(mux *http.ServeMux) {
+	mux.HandleFunc("GET /rest/v1/object-webhook-targets", s.listWebhookTargets)
+	mux.HandleFunc("POST /rest/v1/object-webhook-targets", s.createWebhookTarget)
+	mux.HandleFunc("GET /rest/v1/object-webhook-targets/{id}", s.getWebhookTarget)
+	mux.HandleFunc("PATCH /rest/v1/object-webhook-targets/{id}", s.updateWebhookTarget)
+	mux.HandleFunc("DELETE /rest/v1/object-webhook-targets/{id}", s.deleteWebhookTarget)
+}
+
+// setWebhookTargetFields copies the settable fields of the request into the webhook target, and updates its status.
+// The numbers and booleans are strings in the webhook target APIs.
+func setWebhookTargetFields(target, req document) {
+	for _, f := range webhookTargetFields {
+		if v, ok := req[f].(string); ok {
+			target[f] = v
+		}
+	}
+	target["status"] = "ENABLED"
+	if target["is_enabled"] == "false" {
+		target["status"] = "DISABLED"
+	}
+}
+
+// listWebhookTargets lists the webhook targets, optionally filtered by a name prefix ending with the * wildcard.
+// The list is paginated with resume tokens instead of markers.
+func (s *Server) listWebhookTargets(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	prefix := strings.TrimSuffix(q.Get("prefix"), "*")
+	var keys []string
+	for id, target := range s.webhookTargets {
+		if name, _ := target["name"].(string); strings.HasPrefix(name, prefix) {
+			keys = append(keys, id)
+		}
+	}
+	page, next := paginate(keys, q.Get("resume-token"), q.Get("limit"))
+	items := make([]document, 0, len(page))
+	for _, id := range page {
+		items = append(items, s.webhookTargets[id])
+	}
+	resp := document{"object_webhook_targets": items}
+	if next != "" {
+		resp["next_resume_token"] = next
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) createWebhookTarget(w http.ResponseWriter, r *http.Request) {
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	name, _ := req["name"].(string)
+	if name == "" {
+		writeMissingParam(w, "name")
+		return
+	}
+	if url, _ := req["url"].(string); url == "" {
+		writeMissingParam(w, "url")
+		return
+	}
+	for _, target := range s.webhookTargets {
+		if target["name"] == name {
+			writeAlreadyExists(w, "webhook target", name)
+			return
+		}
+	}
+
+	id := s.newID("urn:ecs:webhook:")
+	target := document{
+		"id":                  id,
+		"pre_backoff_retries": "3",
+		"backoff_retries":     "3",
+		"is_enabled":          "true",
+	}
+	setWebhookTargetFields(target, req)
+	s.webhookTargets[id] = target
+	writeJSON(w, http.StatusOK, target)
+}
+
+// getWebhookTarget answers like the array, with an empty object for an unknown ID.
+func (s *Server) getWebhookTarget(w http.ResponseWriter, r *http.Request) {
+	target, ok := s.webhookTargets[r.PathValue("id")]
+	if !ok {
+		writeJSON(w, http.StatusOK, document{})
+		return
+	}
+	writeJSON(w, http.StatusOK, target)
+}
+
+func (s *Server) updateWebhookTarget(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	target, ok := s.webhookTargets[id]
+	if !ok {
+		writeNotFound(w, "webhook target", id)
+		return
+	}
+	var req document
+	if !readBody(w, r, &req) {
+		return
+	}
+	if name, ok := req["name"]; ok && name != target["name"] {
+		writeError(w, http.StatusBadRequest, codeInvalidParam, "Invalid parameter", "the name of a webhook target cannot be changed")
+		return
+	}
+	setWebhookTargetFields(target, req)
+	writeJSON(w, http.StatusOK, target)
+}
+
+func (s *Server) deleteWebhookTarget(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.webhookTargets[id]; !ok {
+		writeNotFound(w, "webhook target", id)
+		return
+	}
+	delete(s.webhookTargets, id)
+	writeJSON(w, http.StatusOK, document{})
+}
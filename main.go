@@ -22,6 +22,7 @@ import (
 	"flag"
 	"log"
 
+	"terraform-provider-objectscale/internal/client"
 	"terraform-provider-objectscale/internal/provider"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -35,7 +36,7 @@ import (
 
 // Run the docs generation tool, check its repository for more information on how it works and how docs
 // can be customized.
-//go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs@v0.15.0
+//go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs@v0.21.0
 
 // Run the copyright generation tool
 //go:generate go run tools/copyright.go
@@ -62,6 +63,9 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 
+	// Serve returns once terraform is done with the provider, so end the login sessions it opened
+	client.LogoutAll(context.Background())
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}
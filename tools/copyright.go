@@ -31,6 +31,7 @@ import (
 const (
 	factTypeResource   = "resource"
 	factTypeDatasource = "data"
+	factTypeEphemeral  = "ephemeral"
 )
 
 type Fact struct {
@@ -48,10 +49,13 @@ var facts = map[string]map[string]map[string]Fact{
 		"iam_user_access_key":   {factTypeResource: {}},
 		"iam_management_user":   {factTypeResource: {}, factTypeDatasource: {}},
 		"iam_group_membership":  {factTypeResource: {}},
+		"iam_access_key":        {factTypeEphemeral: {}},
+		"iam_policy_document":   {factTypeDatasource: {}}, // no resource
+		"iam_policy_simulation": {factTypeDatasource: {}}, // no resource
 	},
 	"Object User": {
 		"object_user":            {factTypeResource: {}, factTypeDatasource: {}},
-		"object_user_secret_key": {factTypeResource: {}}, // no datasource
+		"object_user_secret_key": {factTypeResource: {}, factTypeEphemeral: {}}, // no datasource
 	},
 	"Management User": {
 		"management_user": {factTypeResource: {}, factTypeDatasource: {}},
@@ -63,6 +67,9 @@ var facts = map[string]map[string]map[string]Fact{
 		"bucket": {factTypeResource: {
 			Note: "> **Warning:** Deleting a bucket using this resource will also delete all data contained within the bucket. Ensure you have backed up any important data before performing a destroy operation.",
 		}, factTypeDatasource: {}},
+		"bucket_copy_policy":    {factTypeResource: {}, factTypeDatasource: {}},
+		"bucket_notification":   {factTypeResource: {}},
+		"object_webhook_target": {factTypeResource: {}, factTypeDatasource: {}},
 	},
 	"Data Protection": {
 		"replication_group": {
@@ -87,8 +94,8 @@ var facts = map[string]map[string]map[string]Fact{
 		},
 	},
 	"Storage Topology & Capacity Domains": {
-		"storage_pool": {factTypeDatasource: {}}, // resource not developed yet
-		"vdc":          {factTypeDatasource: {}}, // resource not developed yet
+		"storage_pool": {factTypeResource: {}, factTypeDatasource: {}},
+		"vdc":          {factTypeResource: {}, factTypeDatasource: {}},
 	},
 }
 
@@ -121,9 +128,10 @@ type FactNormalized struct {
 	SubCategory string
 }
 
-func normalizeFacts(in map[string]map[string]map[string]Fact) (resources, datasources map[string]FactNormalized) {
+func normalizeFacts(in map[string]map[string]map[string]Fact) (resources, datasources, ephemerals map[string]FactNormalized) {
 	resources = make(map[string]FactNormalized)
 	datasources = make(map[string]FactNormalized)
+	ephemerals = make(map[string]FactNormalized)
 	for subCategory, citem := range in {
 		for name, nitem := range citem {
 			for factType, fact := range nitem {
@@ -131,11 +139,13 @@ func normalizeFacts(in map[string]map[string]map[string]Fact) (resources, dataso
 					resources[name] = FactNormalized{Fact: fact, SubCategory: subCategory}
 				} else if factType == factTypeDatasource {
 					datasources[name] = FactNormalized{Fact: fact, SubCategory: subCategory}
+				} else if factType == factTypeEphemeral {
+					ephemerals[name] = FactNormalized{Fact: fact, SubCategory: subCategory}
 				}
 			}
 		}
 	}
-	return resources, datasources
+	return resources, datasources, ephemerals
 }
 
 // main function to traveser docs folder and update copyright year.
@@ -165,7 +175,7 @@ func main() {
 		dirName := pathHierarchy[len(pathHierarchy)-2]
 
 		var fnote, subCategory string
-		resourceFacts, datasourceFacts := normalizeFacts(facts)
+		resourceFacts, datasourceFacts, ephemeralFacts := normalizeFacts(facts)
 		// if dir is datasource
 		if dirName == "data-sources" {
 			// if note exist
@@ -192,6 +202,19 @@ func main() {
 			}
 		}
 
+		// if dir is ephemeral resource
+		if dirName == "ephemeral-resources" {
+			// if note exist
+			if note, ok := ephemeralFacts[fileName]; ok {
+				// add note
+				if note.Note != "" {
+					fnote = "\n\n" + note.Note
+				}
+				// add subcategory
+				subCategory = note.SubCategory
+			}
+		}
+
 		// replace <subcategory>
 		replacedFile = strings.ReplaceAll(replacedFile, "<subcategory>", subCategory)
 		// replace <note>